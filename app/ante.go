@@ -20,7 +20,6 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
-	"github.com/CosmWasm/wasmd/precompile/registry"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
@@ -36,7 +35,11 @@ import (
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
 )
 
-const maxBypassMinFeeMsgGasUsage = 1_000_000
+const (
+	maxBypassMinFeeMsgGasUsage = 1_000_000
+	// maxGaslessTxGasUsage is the gas limit up to which transactions executing gasless contracts are sponsored
+	maxGaslessTxGasUsage = 1_000_000
+)
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
 // channel keeper.
@@ -50,7 +53,6 @@ type HandlerOptions struct {
 	FeeMarketKeeper       feemarketkeeper.Keeper
 	WasmConfig            *wasmTypes.WasmConfig
 	WasmKeeper            *wasmkeeper.Keeper
-	ContractKeeper        *wasmkeeper.PermissionedKeeper
 	TXCounterStoreService corestoretypes.KVStoreService
 	TxCounterStoreKey     storetypes.StoreKey
 	MaxTxGasWanted        uint64
@@ -85,9 +87,6 @@ func (options *HandlerOptions) Validate() error {
 	if options.WasmKeeper == nil {
 		return errors.New("wasm keeper is required for ante builder")
 	}
-	if options.ContractKeeper == nil {
-		return errors.New("contract keeper is required for ante builder")
-	}
 
 	return nil
 }
@@ -103,8 +102,6 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ctx sdk.Context, tx sdk.Tx, sim bool,
 	) (newCtx sdk.Context, err error) {
 
-		registry.InitializePrecompiles(options.ContractKeeper, options.WasmKeeper, options.EvmKeeper, options.BankKeeper, options.AccountKeeper)
-
 		var anteHandler sdk.AnteHandler
 
 		defer Recover(ctx.Logger(), &err)
@@ -186,9 +183,18 @@ func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		// nil so that it only checks with the min gas price of the chain, not the custom fee checker. For cosmos messages, the default tx fee checker is enough
-		globalfeeante.NewFeeDecorator(options.BypassMinFeeMsgTypes, options.GlobalFeeKeeper, options.StakingKeeper, maxBypassMinFeeMsgGasUsage),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+		// transactions only executing gasless contracts have their min fee waived or their fee charged to a sponsor
+		wasmkeeper.NewGaslessFeeDecorator(
+			options.WasmKeeper,
+			options.AccountKeeper,
+			options.BankKeeper,
+			maxGaslessTxGasUsage,
+			// the fee charged to a sponsor is capped with the global min gas prices, the local ones only apply to checked transactions
+			func(ctx sdk.Context) sdk.DecCoins { return options.GlobalFeeKeeper.GetParams(ctx).MinimumGasPrices },
+			globalfeeante.NewFeeDecorator(options.BypassMinFeeMsgTypes, options.GlobalFeeKeeper, options.StakingKeeper, maxBypassMinFeeMsgGasUsage),
+			// nil so that it only checks with the min gas price of the chain, not the custom fee checker. For cosmos messages, the default tx fee checker is enough
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+		),
 		// we use evmante.NewSetPubKeyDecorator so that for eth_secp256k1 accs, we can validate the signer using the evm-cosmos mapping logic
 		evmante.NewSetPubKeyDecorator(options.AccountKeeper, options.EvmKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
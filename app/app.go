@@ -131,6 +131,8 @@ import (
 	srvflags "github.com/evmos/ethermint/server/flags"
 	"github.com/spf13/cast"
 
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/registry"
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -147,11 +149,18 @@ import (
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 
+	evmbindings "github.com/CosmWasm/wasmd/x/evm/bindings"
+	evmibchooks "github.com/CosmWasm/wasmd/x/evm/ibchooks"
+
 	"github.com/CosmWasm/wasmd/x/tokenfactory"
 	"github.com/CosmWasm/wasmd/x/tokenfactory/bindings"
 	tokenfactorykeeper "github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
 
+	"github.com/CosmWasm/wasmd/x/precompile"
+	precompilekeeper "github.com/CosmWasm/wasmd/x/precompile/keeper"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+
 	simappparams "cosmossdk.io/simapp/params"
 	evmv1 "github.com/evmos/ethermint/api/ethermint/evm/v1"
 	evmante "github.com/evmos/ethermint/app/ante"
@@ -289,14 +298,19 @@ type WasmApp struct {
 	IBCHooksKeeper      ibchookskeeper.Keeper
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	TokenFactoryKeeper  tokenfactorykeeper.Keeper
+	PrecompileKeeper    precompilekeeper.Keeper
 
 	EvmKeeper       *evmkeeper.Keeper
 	Erc20Keeper     erc20keeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
 	GlobalFeeKeeper globalfeekeeper.Keeper
 
+	// stateful precompiles served to the EVM
+	Precompiles *registry.Registry
+
 	// Middleware wrapper
 	Ics20WasmHooks   *ibchooks.WasmHooks
+	Ics20EvmHooks    evmibchooks.EvmHooks
 	HooksICS4Wrapper ibchooks.ICS4Middleware
 
 	// the module manager
@@ -405,11 +419,11 @@ func NewWasmApp(
 		// non sdk store keys
 		capabilitytypes.StoreKey, ibcexported.StoreKey, ibctransfertypes.StoreKey, ibcfeetypes.StoreKey,
 		wasmtypes.StoreKey, icahosttypes.StoreKey,
-		icacontrollertypes.StoreKey, clocktypes.StoreKey, globalfeetypes.StoreKey, ibchookstypes.StoreKey, packetforwardtypes.StoreKey, tokenfactorytypes.StoreKey,
+		icacontrollertypes.StoreKey, clocktypes.StoreKey, globalfeetypes.StoreKey, ibchookstypes.StoreKey, packetforwardtypes.StoreKey, tokenfactorytypes.StoreKey, precompiletypes.StoreKey,
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey,
 	)
 
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey, wasmtypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// register streaming services
@@ -617,7 +631,7 @@ func NewWasmApp(
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[evmtypes.StoreKey]), tkeys[evmtypes.TransientKey], Authority,
 		app.AccountKeeper, evmBankKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
-		nil, geth.NewEVM, tracer, evmSs,
+		nil, pcommon.TrackCallFrames(geth.NewEVM), tracer, evmSs,
 	)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
@@ -685,10 +699,12 @@ func NewWasmApp(
 
 	wasmHooks := ibchooks.NewWasmHooks(&app.IBCHooksKeeper, nil, sdk.GetConfig().GetBech32AccountAddrPrefix()) // The contract keeper needs to be set later
 	app.Ics20WasmHooks = &wasmHooks
+	// memos with an "evm" key call EVM contracts, all others are left to the wasm hooks
+	app.Ics20EvmHooks = evmibchooks.NewEvmHooks(app.Ics20WasmHooks, app.EvmKeeper, app.BankKeeper, sdk.GetConfig().GetBech32AccountAddrPrefix())
 
 	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
 		app.IBCKeeper.ChannelKeeper,
-		app.Ics20WasmHooks,
+		app.Ics20EvmHooks,
 	)
 
 	// IBC Fee Module keeper
@@ -759,6 +775,9 @@ func NewWasmApp(
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
+	// the evm bindings pass on the custom messages and queries they don't handle, so their
+	// decorators are applied after the token factory ones
+	wasmOpts = append(evmbindings.RegisterCustomPlugins(app.EvmKeeper, &app.PrecompileKeeper), wasmOpts...)
 	wasmOpts = append(bindings.RegisterCustomPlugins(&app.BankKeeper, &app.TokenFactoryKeeper), wasmOpts...)
 	wasmOpts = append(RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
@@ -767,6 +786,7 @@ func NewWasmApp(
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[wasmtypes.TStoreKey]),
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
@@ -780,7 +800,7 @@ func NewWasmApp(
 		app.GRPCQueryRouter(),
 		wasmDir,
 		wasmConfig,
-		append(wasmkeeper.BuiltInCapabilities(), "token_factory"),
+		append(wasmkeeper.BuiltInCapabilities(), "token_factory", "evm"),
 		AuthorityAddr,
 		wasmOpts...,
 	)
@@ -808,6 +828,34 @@ func NewWasmApp(
 		EnabledCapabilities,
 	)
 
+	app.PrecompileKeeper = precompilekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[precompiletypes.StoreKey]),
+		app.EvmKeeper,
+		&app.WasmKeeper,
+		AuthorityAddr,
+	)
+
+	// precompiles are built once and bound to the evm keeper, every EVM it runs resolves them from here
+	app.Precompiles = registry.NewRegistry(
+		app.PrecompileKeeper,
+		app.ContractKeeper,
+		&app.WasmKeeper,
+		app.EvmKeeper,
+		app.BankKeeper,
+		app.AccountKeeper,
+		stakingkeeper.NewMsgServerImpl(app.StakingKeeper),
+		stakingkeeper.NewQuerier(app.StakingKeeper),
+		distrkeeper.NewMsgServerImpl(app.DistrKeeper),
+		distrkeeper.NewQuerier(app.DistrKeeper),
+		govkeeper.NewMsgServerImpl(&app.GovKeeper),
+		govkeeper.NewQueryServer(&app.GovKeeper),
+		&app.TransferKeeper,
+		tokenfactorykeeper.NewMsgServerImpl(app.TokenFactoryKeeper),
+		app.TokenFactoryKeeper,
+	)
+	registry.Bind(app.EvmKeeper, app.Precompiles)
+
 	// Create fee enabled wasm ibc Stack
 	var wasmStack porttypes.IBCModule
 	wasmStack = wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper)
@@ -901,6 +949,7 @@ func NewWasmApp(
 		ibchooks.NewAppModule(app.AccountKeeper),
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
+		precompile.NewAppModule(app.PrecompileKeeper, app.Precompiles),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.GetSubspace(erc20types.ModuleName)),
@@ -924,6 +973,7 @@ func NewWasmApp(
 			ibchookstypes.ModuleName:      ibchooks.AppModuleBasic{},
 			packetforwardtypes.ModuleName: packetforward.AppModuleBasic{},
 			tokenfactorytypes.ModuleName:  tokenfactory.AppModuleBasic{},
+			precompiletypes.ModuleName:    precompile.AppModuleBasic{},
 			evmtypes.ModuleName:           evm.AppModuleBasic{},
 			feemarkettypes.ModuleName:     feemarket.AppModuleBasic{},
 			erc20types.ModuleName:         erc20.AppModuleBasic{},
@@ -1027,6 +1077,7 @@ func NewWasmApp(
 		feemarkettypes.ModuleName,
 		evmtypes.ModuleName,
 		erc20types.ModuleName,
+		precompiletypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -1159,7 +1210,6 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtype
 			FeeMarketKeeper:       app.FeeMarketKeeper,
 			WasmConfig:            &wasmConfig,
 			WasmKeeper:            &app.WasmKeeper,
-			ContractKeeper:        app.ContractKeeper,
 			TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
 			CircuitKeeper:         &app.CircuitKeeper,
 			DisabledAuthzMsgs: []string{
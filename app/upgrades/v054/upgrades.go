@@ -0,0 +1,39 @@
+package v054
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/CosmWasm/wasmd/app/upgrades"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+)
+
+// UpgradeName defines the on-chain upgrade name
+const UpgradeName = "v0.54.0"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{
+			precompiletypes.StoreKey,
+		},
+	},
+}
+
+// CreateUpgradeHandler adds the x/precompile module, whose genesis is initialised with the
+// default allow-list because it is missing from fromVM, and migrates the existing modules. The
+// wasm migration from version 4 to 5 gives the gasless contracts the default gasless config.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
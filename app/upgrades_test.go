@@ -0,0 +1,45 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/app/upgrades"
+	v054 "github.com/CosmWasm/wasmd/app/upgrades/v054"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestV054Upgrade(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.NewContext(false)
+
+	// the store of a chain upgrading to the precompile module is empty
+	ctx.KVStore(gapp.GetKey(precompiletypes.StoreKey)).Delete(precompiletypes.ParamsKey)
+	require.False(t, gapp.PrecompileKeeper.IsPrecompileActive(ctx, registry.BankContractAddress))
+
+	// gasless contracts of wasm consensus version 4 were stored with a single byte
+	gasless := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	ctx.KVStore(gapp.GetKey(wasmtypes.StoreKey)).Set(wasmtypes.GetGaslessContractIndexPrefix(gasless), []byte{1})
+
+	fromVM := gapp.ModuleManager.GetVersionMap()
+	delete(fromVM, precompiletypes.ModuleName)
+	fromVM[wasmtypes.ModuleName] = 4
+
+	handler := v054.CreateUpgradeHandler(gapp.ModuleManager, gapp.Configurator(), &upgrades.AppKeepers{})
+	toVM, err := handler(ctx, upgradetypes.Plan{Name: v054.UpgradeName}, fromVM)
+	require.NoError(t, err)
+
+	assert.Equal(t, gapp.ModuleManager.GetVersionMap(), toVM)
+	assert.Equal(t, precompiletypes.DefaultParams(), gapp.PrecompileKeeper.GetParams(ctx))
+	assert.True(t, gapp.PrecompileKeeper.IsPrecompileActive(ctx, registry.BankContractAddress))
+	config, ok := gapp.WasmKeeper.GetGaslessConfig(ctx, gasless)
+	require.True(t, ok)
+	assert.Equal(t, wasmtypes.DefaultGaslessConfig(), config)
+}
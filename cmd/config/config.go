@@ -1,8 +1,31 @@
 package config
 
+import "math/big"
+
 var (
 	Bech32Prefix = "orai"
 	MinimalDenom = "orai"
 	CosmosDenom  = Bech32Prefix
 	EvmDenom     = "aorai" // atto orai. This will be converted automatically by evmutil of kava
 )
+
+const (
+	// CosmosDecimals is the exponent of CosmosDenom.
+	CosmosDecimals = 6
+	// EvmDecimals is the exponent of EvmDenom, the unit of balances and msg.value in the EVM.
+	EvmDecimals = 18
+)
+
+// ConversionMultiplier is the number of EvmDenom in one CosmosDenom.
+var ConversionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(EvmDecimals-CosmosDecimals), nil)
+
+// ToCosmosAmount converts an EvmDenom amount to CosmosDenom, returning the EvmDenom remainder
+// that is too small to be represented.
+func ToCosmosAmount(amount *big.Int) (cosmosAmount *big.Int, remainder *big.Int) {
+	return new(big.Int).QuoRem(amount, ConversionMultiplier, new(big.Int))
+}
+
+// ToEvmAmount converts a CosmosDenom amount to EvmDenom.
+func ToEvmAmount(amount *big.Int) *big.Int {
+	return new(big.Int).Mul(amount, ConversionMultiplier)
+}
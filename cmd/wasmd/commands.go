@@ -205,7 +205,7 @@ func newApp(
 
 	var wasmOpts []wasmkeeper.Option
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
-		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
+		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer), wasmkeeper.WithGaslessMetrics(prometheus.DefaultRegisterer))
 	}
 
 	return app.NewWasmApp(
@@ -0,0 +1,135 @@
+package common
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+var _ vm.EVMLogger = (*CallFrameTracer)(nil)
+
+// ErrDelegateCall is returned by the precompile methods that act on behalf of their caller when
+// they are reached through a DELEGATECALL.
+var ErrDelegateCall = errors.New("cannot delegatecall precompile")
+
+// CallFrameTracer records the opcode of every call frame the EVM enters. Under DELEGATECALL a
+// precompile receives the caller of the delegating contract as its caller, so a contract could
+// act on behalf of whoever calls it. The frames let precompiles tell those calls apart.
+// Every hook is forwarded to the wrapped tracer when that one is enabled.
+type CallFrameTracer struct {
+	tracer vm.EVMLogger
+	debug  bool
+	frames []vm.OpCode
+}
+
+// TrackCallFrames wraps constructor so that the EVMs it creates record their call frames
+// with a CallFrameTracer.
+func TrackCallFrames(constructor evm.Constructor) evm.Constructor {
+	return func(
+		blockCtx vm.BlockContext,
+		txCtx vm.TxContext,
+		stateDB vm.StateDB,
+		chainConfig *params.ChainConfig,
+		config vm.Config,
+		customPrecompiles evm.PrecompiledContracts,
+	) evm.EVM {
+		config.Tracer = NewCallFrameTracer(config.Tracer, config.Debug)
+		config.Debug = true
+		return constructor(blockCtx, txCtx, stateDB, chainConfig, config, customPrecompiles)
+	}
+}
+
+// NewCallFrameTracer returns a CallFrameTracer forwarding to tracer when debug is set.
+func NewCallFrameTracer(tracer vm.EVMLogger, debug bool) *CallFrameTracer {
+	return &CallFrameTracer{tracer: tracer, debug: debug && tracer != nil}
+}
+
+// IsDelegateCall reports whether the precompile running in accessibleState was reached through a
+// DELEGATECALL. EVMs that do not track their call frames are assumed to call it directly.
+func IsDelegateCall(accessibleState contract.AccessibleState) bool {
+	evm, ok := accessibleState.(*vm.EVM)
+	if !ok {
+		return false
+	}
+	tracer, ok := evm.Config.Tracer.(*CallFrameTracer)
+	if !ok || len(tracer.frames) == 0 {
+		return false
+	}
+	return tracer.frames[len(tracer.frames)-1] == vm.DELEGATECALL
+}
+
+// ValidateNonDelegateCall rejects precompile calls made through a DELEGATECALL.
+func ValidateNonDelegateCall(accessibleState contract.AccessibleState) error {
+	if IsDelegateCall(accessibleState) {
+		return ErrDelegateCall
+	}
+	return nil
+}
+
+func (t *CallFrameTracer) CaptureTxStart(gasLimit uint64) {
+	if t.debug {
+		t.tracer.CaptureTxStart(gasLimit)
+	}
+}
+
+func (t *CallFrameTracer) CaptureTxEnd(restGas uint64) {
+	if t.debug {
+		t.tracer.CaptureTxEnd(restGas)
+	}
+}
+
+func (t *CallFrameTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	op := vm.CALL
+	if create {
+		op = vm.CREATE
+	}
+	t.frames = append(t.frames, op)
+	if t.debug {
+		t.tracer.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+func (t *CallFrameTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	t.pop()
+	if t.debug {
+		t.tracer.CaptureEnd(output, gasUsed, d, err)
+	}
+}
+
+func (t *CallFrameTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.frames = append(t.frames, typ)
+	if t.debug {
+		t.tracer.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+func (t *CallFrameTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.pop()
+	if t.debug {
+		t.tracer.CaptureExit(output, gasUsed, err)
+	}
+}
+
+func (t *CallFrameTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if t.debug {
+		t.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+func (t *CallFrameTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if t.debug {
+		t.tracer.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+func (t *CallFrameTracer) pop() {
+	if len(t.frames) > 0 {
+		t.frames = t.frames[:len(t.frames)-1]
+	}
+}
@@ -2,10 +2,18 @@ package common
 
 import (
 	"context"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
+
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 type EVMKeeper interface {
@@ -16,15 +24,24 @@ type EVMKeeper interface {
 		msgSigner string,
 		msgPubKey string,
 	) error
+	DeleteAddressMapping(ctx sdk.Context, cosmosAddress sdk.AccAddress, evmAddress common.Address)
+	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
 }
 
 type WasmdKeeper interface {
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
+	Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error)
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+	Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error)
+	UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error
+	ClearContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
 }
 
 type WasmdViewKeeper interface {
 	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
 }
 
 type BankKeeper interface {
@@ -42,3 +59,62 @@ type AccountKeeper interface {
 	RemoveAccount(ctx context.Context, acc sdk.AccountI)
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 }
+
+type StakingMsgServer interface {
+	Delegate(ctx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error)
+	Undelegate(ctx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error)
+	BeginRedelegate(ctx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error)
+}
+
+type StakingQuerier interface {
+	Delegation(ctx context.Context, req *stakingtypes.QueryDelegationRequest) (*stakingtypes.QueryDelegationResponse, error)
+	Validator(ctx context.Context, req *stakingtypes.QueryValidatorRequest) (*stakingtypes.QueryValidatorResponse, error)
+	Params(ctx context.Context, req *stakingtypes.QueryParamsRequest) (*stakingtypes.QueryParamsResponse, error)
+}
+
+type DistributionMsgServer interface {
+	WithdrawDelegatorReward(ctx context.Context, msg *distrtypes.MsgWithdrawDelegatorReward) (*distrtypes.MsgWithdrawDelegatorRewardResponse, error)
+	SetWithdrawAddress(ctx context.Context, msg *distrtypes.MsgSetWithdrawAddress) (*distrtypes.MsgSetWithdrawAddressResponse, error)
+}
+
+type DistributionQuerier interface {
+	DelegationRewards(ctx context.Context, req *distrtypes.QueryDelegationRewardsRequest) (*distrtypes.QueryDelegationRewardsResponse, error)
+	DelegatorValidators(ctx context.Context, req *distrtypes.QueryDelegatorValidatorsRequest) (*distrtypes.QueryDelegatorValidatorsResponse, error)
+	DelegatorWithdrawAddress(ctx context.Context, req *distrtypes.QueryDelegatorWithdrawAddressRequest) (*distrtypes.QueryDelegatorWithdrawAddressResponse, error)
+	CommunityPool(ctx context.Context, req *distrtypes.QueryCommunityPoolRequest) (*distrtypes.QueryCommunityPoolResponse, error)
+}
+
+type GovMsgServer interface {
+	Vote(ctx context.Context, msg *govv1.MsgVote) (*govv1.MsgVoteResponse, error)
+	VoteWeighted(ctx context.Context, msg *govv1.MsgVoteWeighted) (*govv1.MsgVoteWeightedResponse, error)
+	Deposit(ctx context.Context, msg *govv1.MsgDeposit) (*govv1.MsgDepositResponse, error)
+}
+
+type GovQuerier interface {
+	Proposal(ctx context.Context, req *govv1.QueryProposalRequest) (*govv1.QueryProposalResponse, error)
+	TallyResult(ctx context.Context, req *govv1.QueryTallyResultRequest) (*govv1.QueryTallyResultResponse, error)
+	Params(ctx context.Context, req *govv1.QueryParamsRequest) (*govv1.QueryParamsResponse, error)
+}
+
+type IBCTransferKeeper interface {
+	Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error)
+}
+
+type TokenFactoryMsgServer interface {
+	CreateDenom(ctx context.Context, msg *tokenfactorytypes.MsgCreateDenom) (*tokenfactorytypes.MsgCreateDenomResponse, error)
+	Mint(ctx context.Context, msg *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error)
+	Burn(ctx context.Context, msg *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error)
+	ChangeAdmin(ctx context.Context, msg *tokenfactorytypes.MsgChangeAdmin) (*tokenfactorytypes.MsgChangeAdminResponse, error)
+	SetDenomMetadata(ctx context.Context, msg *tokenfactorytypes.MsgSetDenomMetadata) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error)
+	ForceTransfer(ctx context.Context, msg *tokenfactorytypes.MsgForceTransfer) (*tokenfactorytypes.MsgForceTransferResponse, error)
+}
+
+type TokenFactoryQuerier interface {
+	DenomAuthorityMetadata(ctx context.Context, req *tokenfactorytypes.QueryDenomAuthorityMetadataRequest) (*tokenfactorytypes.QueryDenomAuthorityMetadataResponse, error)
+	DenomsFromCreator(ctx context.Context, req *tokenfactorytypes.QueryDenomsFromCreatorRequest) (*tokenfactorytypes.QueryDenomsFromCreatorResponse, error)
+	Params(ctx context.Context, req *tokenfactorytypes.QueryParamsRequest) (*tokenfactorytypes.QueryParamsResponse, error)
+}
+
+type PrecompileActivationKeeper interface {
+	IsPrecompileActive(ctx sdk.Context, address common.Address) bool
+}
@@ -0,0 +1,101 @@
+package common
+
+import (
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+const (
+	// QueryBaseGas is the static cost charged for every view or pure precompile method.
+	QueryBaseGas uint64 = 1_000
+	// TxBaseGas is the static cost charged for every state changing precompile method.
+	TxBaseGas uint64 = 5_000
+)
+
+type precompileCallKey struct{}
+
+// IsPrecompileCall reports whether ctx belongs to a call from the EVM into a precompile, so
+// modules it reaches can tell that an EVM transaction with uncommitted state is running above.
+func IsPrecompileCall(ctx sdk.Context) bool {
+	called, _ := ctx.Value(precompileCallKey{}).(bool)
+	return called
+}
+
+// PrecompileMethod is a precompile method body. It receives a context whose gas meter only
+// tracks the gas used by this call, so it must not compute the remaining gas itself.
+type PrecompileMethod func(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error)
+
+// BaseGas returns the static cost charged for method before any cosmos gas is accounted.
+func BaseGas(method abi.Method) uint64 {
+	if method.IsConstant() {
+		return QueryBaseGas
+	}
+	return TxBaseGas
+}
+
+// NewPrecompileFunction binds run to the selector of method, metered by WithGasMeter.
+func NewPrecompileFunction(method abi.Method, run PrecompileMethod) *contract.StatefulPrecompileFunction {
+	return contract.NewStatefulPrecompileFunction(method.ID, WithGasMeter(BaseGas(method), run))
+}
+
+// WithGasMeter wraps run so that a call is charged baseGas plus the cosmos gas it consumes,
+// and never more than suppliedGas.
+//
+// run executes against a child gas meter limited to what is left of suppliedGas after baseGas,
+// so the charge only covers this call and not whatever the transaction consumed before it.
+// Keepers that derive their own limits from the context meter, such as wasm applying its gas
+// multiplier to compute the contract's VM gas, are bounded by the EVM gas of the call.
+// The parent meter is left untouched, the caller pays through the returned remaining gas.
+func WithGasMeter(baseGas uint64, run PrecompileMethod) contract.RunStatefulPrecompileFunc {
+	return func(
+		accessibleState contract.AccessibleState,
+		caller common.Address,
+		addr common.Address,
+		packedInput []byte,
+		suppliedGas uint64,
+		readOnly bool,
+		value *big.Int,
+	) (ret []byte, remainingGas uint64, rerr error) {
+		ctx, rerr := GetPrecompileCtx(accessibleState)
+		if rerr != nil {
+			return nil, 0, rerr
+		}
+		if suppliedGas < baseGas {
+			return nil, 0, vm.ErrOutOfGas
+		}
+
+		gasMeter := storetypes.NewGasMeter(suppliedGas - baseGas)
+		defer func() {
+			if r := recover(); r != nil {
+				switch r.(type) {
+				case storetypes.ErrorOutOfGas, storetypes.ErrorGasOverflow:
+				default:
+					panic(r)
+				}
+				ret, remainingGas, rerr = nil, 0, vm.ErrOutOfGas
+			}
+		}()
+
+		ret, rerr = run(ctx.WithGasMeter(gasMeter).WithValue(precompileCallKey{}, true), accessibleState, caller, addr, packedInput, readOnly, value)
+		// methods recover their own panics, so an out of gas panic only shows up as a meter
+		// consumed past its limit
+		if gasMeter.GasConsumed() > gasMeter.Limit() {
+			return nil, 0, vm.ErrOutOfGas
+		}
+		return ret, suppliedGas - baseGas - gasMeter.GasConsumed(), rerr
+	}
+}
@@ -0,0 +1,81 @@
+package common_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/CosmWasm/wasmd/app"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+)
+
+func consuming(gas uint64, err error) pcommon.PrecompileMethod {
+	return func(ctx sdk.Context, _ contract.AccessibleState, _ common.Address, _ common.Address, _ []byte, _ bool, _ *big.Int) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(gas, "test")
+		return []byte{1}, err
+	}
+}
+
+func TestIsPrecompileCall(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	require.False(t, pcommon.IsPrecompileCall(ctx))
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	var called bool
+	run := pcommon.WithGasMeter(pcommon.TxBaseGas, func(ctx sdk.Context, _ contract.AccessibleState, _ common.Address, _ common.Address, _ []byte, _ bool, _ *big.Int) ([]byte, error) {
+		called = pcommon.IsPrecompileCall(ctx)
+		return nil, nil
+	})
+	_, _, err := run(&evm, common.Address{}, common.Address{}, nil, 100_000, false, nil)
+	require.NoError(t, err)
+	require.True(t, called)
+}
+
+func TestWithGasMeter(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	// gas consumed earlier in the transaction must not be charged to the call
+	parentMeter := storetypes.NewGasMeter(10_000_000)
+	parentMeter.ConsumeGas(1_000_000, "previous calls")
+	ctx = ctx.WithGasMeter(parentMeter)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	addr := common.HexToAddress("0x1")
+	methodErr := errors.New("method failed")
+
+	for _, tc := range []struct {
+		name        string
+		suppliedGas uint64
+		run         pcommon.PrecompileMethod
+		expectedRet []byte
+		expectedGas uint64
+		expectedErr error
+	}{
+		{"charges base and consumed gas", 100_000, consuming(30_000, nil), []byte{1}, 100_000 - pcommon.TxBaseGas - 30_000, nil},
+		{"consumes all supplied gas", pcommon.TxBaseGas + 30_000, consuming(30_000, nil), []byte{1}, 0, nil},
+		{"keeps method error", 100_000, consuming(30_000, methodErr), []byte{1}, 100_000 - pcommon.TxBaseGas - 30_000, methodErr},
+		{"out of gas in method", pcommon.TxBaseGas + 29_999, consuming(30_000, nil), nil, 0, vm.ErrOutOfGas},
+		{"supplied gas below base", pcommon.TxBaseGas - 1, consuming(0, nil), nil, 0, vm.ErrOutOfGas},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			run := pcommon.WithGasMeter(pcommon.TxBaseGas, tc.run)
+			ret, remainingGas, err := run(&evm, addr, addr, nil, tc.suppliedGas, false, nil)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedGas, remainingGas)
+			require.Equal(t, uint64(1_000_000), parentMeter.GasConsumed())
+		})
+	}
+}
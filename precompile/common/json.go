@@ -0,0 +1,132 @@
+package common
+
+import (
+	gjson "encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// pathSegment is a single step of a path, either an object key or an array index.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath splits a path such as `a.b[2].c` into its segments. Keys are separated by dots and
+// array elements are selected with a bracketed index, `[0].a` selects into a top level array.
+func parsePath(path string) ([]pathSegment, error) {
+	if path == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	var segments []pathSegment
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated index in path %q", path)
+			}
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index %q in path %q", path[i+1:i+end], path)
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			i += end + 1
+			if i < len(path) && path[i] != '.' && path[i] != '[' {
+				return nil, fmt.Errorf("unexpected %q after index in path %q", path[i], path)
+			}
+			if i < len(path) && path[i] == '.' {
+				i++
+				if i == len(path) {
+					return nil, fmt.Errorf("empty key in path %q", path)
+				}
+			}
+		default:
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty key in path %q", path)
+			}
+			segments = append(segments, pathSegment{key: path[i : i+end]})
+			i += end
+			if i < len(path) && path[i] == '.' {
+				i++
+				if i == len(path) {
+					return nil, fmt.Errorf("empty key in path %q", path)
+				}
+			}
+		}
+	}
+	return segments, nil
+}
+
+// ExtractJSON returns the raw JSON value found at path in input. A top level key that matches path
+// exactly takes precedence, so keys containing dots or brackets stay reachable.
+func ExtractJSON(input []byte, path string) (gjson.RawMessage, error) {
+	topLevel := map[string]gjson.RawMessage{}
+	if err := gjson.Unmarshal(input, &topLevel); err == nil {
+		if result, ok := topLevel[path]; ok {
+			return result, nil
+		}
+	}
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current := gjson.RawMessage(input)
+	for _, segment := range segments {
+		if segment.isIndex {
+			elements := []gjson.RawMessage{}
+			if err := gjson.Unmarshal(current, &elements); err != nil {
+				return nil, fmt.Errorf("cannot index into non array value at [%d] in path %s", segment.index, path)
+			}
+			if segment.index >= len(elements) {
+				return nil, fmt.Errorf("index %d out of range in path %s", segment.index, path)
+			}
+			current = elements[segment.index]
+			continue
+		}
+
+		fields := map[string]gjson.RawMessage{}
+		if err := gjson.Unmarshal(current, &fields); err != nil {
+			return nil, fmt.Errorf("cannot select key %s from non object value in path %s", segment.key, path)
+		}
+		result, ok := fields[segment.key]
+		if !ok {
+			return nil, fmt.Errorf("input does not contain key %s", path)
+		}
+		current = result
+	}
+	return current, nil
+}
+
+// ResolveEvmAddress parses address given either as 0x hex or as bech32. An associated cosmos
+// account resolves to its evm address, any other 20 byte account to the same bytes.
+func ResolveEvmAddress(ctx sdk.Context, evmKeeper EVMKeeper, address string) (common.Address, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address), nil
+	}
+
+	cosmosAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("value %s is neither a hex nor a bech32 address", address)
+	}
+	evmAddress, err := evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+	if err == nil {
+		return *evmAddress, nil
+	}
+	if len(cosmosAddress) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("cosmos address %s has no evm address", address)
+	}
+	return common.BytesToAddress(cosmosAddress), nil
+}
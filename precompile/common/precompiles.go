@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"math/big"
 
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
@@ -34,3 +38,41 @@ func GetPrecompileCtx(accessibleState contract.AccessibleState) (sdk.Context, er
 	}
 	return ctxer.Ctx(), nil
 }
+
+// RevertReason ABI encodes reason as the Error(string) revert data solidity callers decode.
+func RevertReason(reason string) []byte {
+	stringTy, _ := abi.NewType("string", "", nil)
+	packed, _ := abi.Arguments{{Type: stringTy}}.Pack(reason)
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], packed...)
+}
+
+// SyncNativeBalance runs msg, which moves coins of account in the bank, and applies the change of
+// account's balance of the cosmos denom backing EVM balances to evmAddress in the StateDB. The
+// StateDB caches the balances of the accounts the transaction touched and would otherwise write
+// them back over the bank when it commits, undoing what msg paid out or minting back what it took.
+// What msg takes from account must be covered by the EVM balance of evmAddress.
+func SyncNativeBalance(ctx sdk.Context, bankKeeper BankKeeper, stateDB contract.StateDB, evmAddress common.Address, account sdk.AccAddress, msg func(ctx sdk.Context) error) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := msg(cacheCtx); err != nil {
+		return err
+	}
+
+	change := bankKeeper.GetBalance(cacheCtx, account, appconfig.CosmosDenom).Amount.Sub(bankKeeper.GetBalance(ctx, account, appconfig.CosmosDenom).Amount)
+	if change.IsZero() {
+		writeCache()
+		return nil
+	}
+	// loads the account into the StateDB before its bank balance changes
+	balance := stateDB.GetBalance(evmAddress)
+	evmChange := appconfig.ToEvmAmount(change.Abs().BigInt())
+	if change.IsNegative() && balance.Cmp(evmChange) < 0 {
+		return fmt.Errorf("insufficient funds: %s%s is smaller than %s%s", balance, appconfig.EvmDenom, evmChange, appconfig.EvmDenom)
+	}
+	writeCache()
+	if change.IsNegative() {
+		stateDB.SubBalance(evmAddress, evmChange)
+	} else {
+		stateDB.AddBalance(evmAddress, evmChange)
+	}
+	return nil
+}
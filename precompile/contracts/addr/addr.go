@@ -1,6 +1,7 @@
 package addr
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/base64"
 	"encoding/hex"
@@ -12,10 +13,12 @@ import (
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/btcsuite/btcd/btcec/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/precompile/contract"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -29,10 +32,26 @@ var (
 )
 
 const (
-	GetCosmosAddressMethod = "getCosmosAddr"
-	GetEvmAddressMethod    = "getEvmAddr"
-	AssociateMethod        = "associate"
-	AssociatePubKeyMethod  = "associatePubKey"
+	GetCosmosAddressMethod      = "getCosmosAddr"
+	GetEvmAddressMethod         = "getEvmAddr"
+	AssociateMethod             = "associate"
+	AssociatePubKeyMethod       = "associatePubKey"
+	AssociatePersonalSignMethod = "associatePersonalSign"
+	AssociateTypedDataMethod    = "associateTypedData"
+	DisassociateMethod          = "disassociate"
+	GetAssociationNonceMethod   = "getAssociationNonce"
+)
+
+const (
+	AddressAssociatedEvent    = "AddressAssociated"
+	AddressDisassociatedEvent = "AddressDisassociated"
+)
+
+var (
+	eip712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	associateTypeHash    = crypto.Keccak256Hash([]byte("Associate(address account,uint256 nonce)"))
+	eip712DomainName     = crypto.Keccak256Hash([]byte("AddressAssociation"))
+	eip712DomainVersion  = crypto.Keccak256Hash([]byte("1"))
 )
 
 type PrecompileExecutor struct {
@@ -50,22 +69,38 @@ func NewContract(evmKeeper pcommon.EVMKeeper) contract.StatefulPrecompiledContra
 	executor := &PrecompileExecutor{evmKeeper: evmKeeper}
 
 	functions := []*contract.StatefulPrecompileFunction{
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[GetCosmosAddressMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[GetCosmosAddressMethod],
 			executor.getCosmosAddr,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[GetEvmAddressMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[GetEvmAddressMethod],
 			executor.getEvmAddr,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[AssociateMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AssociateMethod],
 			executor.associate,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[AssociatePubKeyMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AssociatePubKeyMethod],
 			executor.associatePublicKey,
 		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AssociatePersonalSignMethod],
+			executor.associatePersonalSign,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AssociateTypedDataMethod],
+			executor.associateTypedData,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DisassociateMethod],
+			executor.disassociate,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[GetAssociationNonceMethod],
+			executor.getAssociationNonce,
+		),
 	}
 
 	// Construct the contract with functions.
@@ -78,23 +113,17 @@ func NewContract(evmKeeper pcommon.EVMKeeper) contract.StatefulPrecompiledContra
 	return precompile
 }
 
-func (p PrecompileExecutor) getCosmosAddr(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) getCosmosAddr(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
 			ctx.Logger().Error("Error querying getCosmosAddr using precompile: ", rerr.Error())
 			return
@@ -122,27 +151,20 @@ func (p PrecompileExecutor) getCosmosAddr(accessibleState contract.AccessibleSta
 	cosmosAddress := p.evmKeeper.GetCosmosAddressMapping(ctx, evmAddress)
 
 	ret, rerr = method.Outputs.Pack(cosmosAddress.String())
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) getEvmAddr(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) getEvmAddr(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying getEvmAddr using precompile: ", rerr.Error())
 			return
@@ -179,27 +201,20 @@ func (p PrecompileExecutor) getEvmAddr(accessibleState contract.AccessibleState,
 	}
 
 	ret, rerr = method.Outputs.Pack(evmAddress)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) associate(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) associate(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error associating using precompile: ", rerr.Error())
 			return
@@ -266,34 +281,27 @@ func (p PrecompileExecutor) associate(accessibleState contract.AccessibleState,
 		return
 	}
 
-	cosmosAddress, evmAddress, err := p.associateAddresses(ctx, caller, pubKeyBytes)
+	cosmosAddress, evmAddress, err := p.associateAddresses(ctx, accessibleState.GetStateDB(), callingContract, caller, pubKeyBytes)
 	if err != nil {
 		rerr = err
 		return
 	}
 
 	ret, rerr = method.Outputs.Pack(cosmosAddress.String(), evmAddress)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) associatePublicKey(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) associatePublicKey(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error associating public key using precompile: ", rerr.Error())
 			return
@@ -331,18 +339,17 @@ func (p PrecompileExecutor) associatePublicKey(accessibleState contract.Accessib
 		return
 	}
 
-	cosmosAddress, evmAddress, err := p.associateAddresses(ctx, caller, pubKeyBytes)
+	cosmosAddress, evmAddress, err := p.associateAddresses(ctx, accessibleState.GetStateDB(), callingContract, caller, pubKeyBytes)
 	if err != nil {
 		rerr = err
 		return
 	}
 
 	ret, rerr = method.Outputs.Pack(cosmosAddress.String(), evmAddress)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, caller common.Address, pubkey []byte) (sdk.AccAddress, *common.Address, error) {
+func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, stateDB contract.StateDB, addr common.Address, caller common.Address, pubkey []byte) (sdk.AccAddress, *common.Address, error) {
 	evmAddress, err := evmtypes.PubkeyBytesToEVMAddress(pubkey)
 	if err != nil {
 		return nil, nil, err
@@ -352,12 +359,351 @@ func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, caller common.Ad
 		return nil, nil, fmt.Errorf("Caller address %s does not match with EVM address %s computed from the public key %s\n", caller.Hex(), evmAddress.Hex(), base64.StdEncoding.EncodeToString(pubkey))
 	}
 
+	return p.setAddressMapping(ctx, stateDB, addr, pubkey)
+}
+
+func (p PrecompileExecutor) associatePersonalSign(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error associating with personal_sign using precompile: ", rerr.Error())
+			return
+		}
+	}()
+
+	return p.associateSigned(ctx, accessibleState, callingContract, packedInput, readOnly, value, AssociatePersonalSignMethod,
+		func(chainID *big.Int, account common.Address, nonce *big.Int) common.Hash {
+			return common.BytesToHash(accounts.TextHash([]byte(PersonalSignMessage(chainID, account, nonce))))
+		})
+}
+
+func (p PrecompileExecutor) associateTypedData(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error associating with typed data using precompile: ", rerr.Error())
+			return
+		}
+	}()
+
+	return p.associateSigned(ctx, accessibleState, callingContract, packedInput, readOnly, value, AssociateTypedDataMethod,
+		func(chainID *big.Int, account common.Address, nonce *big.Int) common.Hash {
+			return TypedDataHash(chainID, callingContract, account, nonce)
+		})
+}
+
+// associateSigned associates the addresses of the key that signed the digest of (chain id,
+// account, nonce). Anyone may submit the signature, the nonce stops it from being replayed.
+func (p PrecompileExecutor) associateSigned(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+	methodName string,
+	digest func(chainID *big.Int, account common.Address, nonce *big.Int) common.Hash) (ret []byte, rerr error) {
+
+	if readOnly {
+		rerr = errors.New("cannot call associate precompile from staticcall")
+		return
+	}
+
+	method := ABI.Methods[methodName]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	account := args[0].(common.Address)
+	nonce := args[1].(*big.Int)
+	signature := args[2].([]byte)
+
+	stateDB := accessibleState.GetStateDB()
+	expectedNonce := associationNonce(stateDB, addr, account)
+	if nonce.Cmp(expectedNonce) != 0 {
+		rerr = fmt.Errorf("invalid nonce %s for %s, expected %s", nonce, account.Hex(), expectedNonce)
+		return
+	}
+
+	chainID, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		rerr = err
+		return
+	}
+	pubKeyBytes, err := recoverSignature(digest(chainID, account, nonce), signature)
+	if err != nil {
+		rerr = err
+		return
+	}
+	signer, err := evmtypes.PubkeyBytesToEVMAddress(pubKeyBytes)
+	if err != nil {
+		rerr = err
+		return
+	}
+	if *signer != account {
+		rerr = fmt.Errorf("signature is from %s, not from %s", signer.Hex(), account.Hex())
+		return
+	}
+
+	stateDB.SetState(addr, associationNonceSlot(account), common.BigToHash(new(big.Int).Add(nonce, big.NewInt(1))))
+	cosmosAddress, evmAddress, err := p.setAddressMapping(ctx, stateDB, addr, pubKeyBytes)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(cosmosAddress.String(), evmAddress)
+	return
+}
+
+// disassociate removes the mapping of the caller. The cosmos account keeps its balances, the evm
+// address is backed by the account with the same bytes again.
+func (p PrecompileExecutor) disassociate(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error disassociating using precompile: ", rerr.Error())
+			return
+		}
+	}()
+
+	if readOnly {
+		rerr = errors.New("cannot call disassociate precompile from staticcall")
+		return
+	}
+	// a contract must not drop the association of whoever calls it
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods[DisassociateMethod]
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	cosmosAddress := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	if bytes.Equal(cosmosAddress, caller.Bytes()) {
+		rerr = fmt.Errorf("address %s is not associated", caller.Hex())
+		return
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	// a new signature is needed to associate again
+	nonce := associationNonce(stateDB, callingContract, caller)
+	stateDB.SetState(callingContract, associationNonceSlot(caller), common.BigToHash(new(big.Int).Add(nonce, big.NewInt(1))))
+	err := syncAccount(ctx, stateDB, p.evmKeeper, caller, func() error {
+		p.evmKeeper.DeleteAddressMapping(ctx, cosmosAddress, caller)
+		return nil
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	if err := emitAddressEvent(ctx, stateDB, callingContract, AddressDisassociatedEvent, caller, cosmosAddress); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(cosmosAddress.String(), caller)
+	return
+}
+
+func (p PrecompileExecutor) getAssociationNonce(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying getAssociationNonce using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[GetAssociationNonceMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	nonce := associationNonce(accessibleState.GetStateDB(), callingContract, args[0].(common.Address))
+	ret, rerr = method.Outputs.Pack(nonce)
+	return
+}
+
+// setAddressMapping associates the cosmos and evm addresses derived from pubkey, unless they
+// already are.
+func (p PrecompileExecutor) setAddressMapping(ctx sdk.Context, stateDB contract.StateDB, addr common.Address, pubkey []byte) (sdk.AccAddress, *common.Address, error) {
+	evmAddress, err := evmtypes.PubkeyBytesToEVMAddress(pubkey)
+	if err != nil {
+		return nil, nil, err
+	}
 	cosmosAddress, err := evmtypes.PubkeyBytesToCosmosAddress(pubkey)
 	if err != nil {
 		return nil, nil, err
 	}
-	err = p.evmKeeper.SetMappingEvmAddressInner(ctx, cosmosAddress.String(), base64.StdEncoding.EncodeToString(pubkey))
-	return cosmosAddress, evmAddress, err
+	if _, err := p.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress); err == nil {
+		return cosmosAddress, evmAddress, nil
+	}
+
+	err = syncAccount(ctx, stateDB, p.evmKeeper, *evmAddress, func() error {
+		return p.evmKeeper.SetMappingEvmAddressInner(ctx, cosmosAddress.String(), base64.StdEncoding.EncodeToString(pubkey))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := emitAddressEvent(ctx, stateDB, addr, AddressAssociatedEvent, *evmAddress, cosmosAddress); err != nil {
+		return nil, nil, err
+	}
+	return cosmosAddress, evmAddress, nil
+}
+
+// syncAccount runs change, which moves account to another cosmos account, and updates the
+// StateDB view of account to match. Otherwise committing the StateDB would write the balance
+// and nonce it cached from the previous cosmos account over the new one. Balance changes the
+// StateDB has not committed yet move along with the account.
+func syncAccount(ctx sdk.Context, stateDB contract.StateDB, evmKeeper pcommon.EVMKeeper, account common.Address, change func() error) error {
+	pending := new(big.Int).Sub(stateDB.GetBalance(account), evmKeeper.GetBalance(ctx, account))
+	nonce := stateDB.GetNonce(account)
+
+	if err := change(); err != nil {
+		return err
+	}
+
+	balance := new(big.Int).Add(evmKeeper.GetBalance(ctx, account), pending)
+	if balance.Sign() < 0 {
+		return fmt.Errorf("account %s has uncommitted balance changes", account.Hex())
+	}
+	switch delta := new(big.Int).Sub(balance, stateDB.GetBalance(account)); delta.Sign() {
+	case 1:
+		stateDB.AddBalance(account, delta)
+	case -1:
+		stateDB.SubBalance(account, new(big.Int).Neg(delta))
+	}
+	// the nonce must never go down, or transactions signed for the other account could be replayed
+	if n := evmKeeper.GetNonce(ctx, account); n > nonce {
+		nonce = n
+	}
+	stateDB.SetNonce(account, nonce)
+	return nil
+}
+
+func emitAddressEvent(ctx sdk.Context, stateDB contract.StateDB, addr common.Address, name string, evmAddress common.Address, cosmosAddress sdk.AccAddress) error {
+	event := ABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(cosmosAddress.String())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     addr,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(evmAddress.Bytes())},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func associationNonceSlot(account common.Address) common.Hash {
+	return crypto.Keccak256Hash(account.Bytes())
+}
+
+// associationNonce returns the nonce the next association signature of account must carry. It
+// is kept in the storage of the precompile, so it is reverted along with the EVM call.
+func associationNonce(stateDB contract.StateDB, addr common.Address, account common.Address) *big.Int {
+	return stateDB.GetState(addr, associationNonceSlot(account)).Big()
+}
+
+// PersonalSignMessage returns the message account signs with personal_sign (EIP-191) to be
+// associated on the chain with the given EIP-155 chain id.
+func PersonalSignMessage(chainID *big.Int, account common.Address, nonce *big.Int) string {
+	return fmt.Sprintf("Associate %s with its Cosmos address.\nChain ID: %s\nNonce: %s", account.Hex(), chainID, nonce)
+}
+
+// TypedDataHash returns the EIP-712 digest of Associate(address account,uint256 nonce) under the
+// AddressAssociation domain of the precompile at verifyingContract.
+func TypedDataHash(chainID *big.Int, verifyingContract common.Address, account common.Address, nonce *big.Int) common.Hash {
+	domainSeparator := crypto.Keccak256Hash(
+		eip712DomainTypeHash.Bytes(),
+		eip712DomainName.Bytes(),
+		eip712DomainVersion.Bytes(),
+		common.BigToHash(chainID).Bytes(),
+		common.BytesToHash(verifyingContract.Bytes()).Bytes(),
+	)
+	structHash := crypto.Keccak256Hash(
+		associateTypeHash.Bytes(),
+		common.BytesToHash(account.Bytes()).Bytes(),
+		common.BigToHash(nonce).Bytes(),
+	)
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// recoverSignature returns the compressed public key that produced the 65 byte [R || S || V]
+// signature of hash. V may be either 0/1 or 27/28 as produced by wallets.
+func recoverSignature(hash common.Hash, signature []byte) ([]byte, error) {
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(signature))
+	}
+	v := signature[crypto.RecoveryIDOffset]
+	if v < 27 {
+		v += 27
+	}
+	return RecoverPubkey(hash, new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:64]), big.NewInt(int64(v)), true)
 }
 
 func decodeHexString(hexString string) ([]byte, error) {
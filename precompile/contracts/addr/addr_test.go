@@ -7,13 +7,20 @@ import (
 	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/CosmWasm/wasmd/precompile/contracts/addr"
+	"github.com/CosmWasm/wasmd/precompile/registry"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -234,9 +241,19 @@ func TestGetEvmAddr(t *testing.T) {
 	}
 }
 
+// newAssociateContext returns a context with the chain id signatures are bound to and the evm
+// denom the accounts being associated are synced in.
+func newAssociateContext(t *testing.T, tApp *app.WasmApp) sdk.Context {
+	ctx := tApp.NewContext(true).WithChainID(app.SimAppChainID)
+	params := tApp.EvmKeeper.GetParams(ctx)
+	params.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, params))
+	return ctx
+}
+
 func TestAssociatePubKey(t *testing.T) {
 	tApp := app.Setup(t)
-	ctx := tApp.NewContext(true)
+	ctx := newAssociateContext(t, tApp)
 
 	method := addr.ABI.Methods[addr.AssociatePubKeyMethod]
 
@@ -367,7 +384,7 @@ func TestAssociatePubKey(t *testing.T) {
 
 func TestAssociate(t *testing.T) {
 	tApp := app.Setup(t)
-	ctx := tApp.NewContext(true)
+	ctx := newAssociateContext(t, tApp)
 
 	method := addr.ABI.Methods[addr.AssociateMethod]
 
@@ -526,3 +543,179 @@ func TestAssociate(t *testing.T) {
 		})
 	}
 }
+
+func signAssociation(t *testing.T, privKey cryptotypes.PrivKey, hash common.Hash) []byte {
+	key, err := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	require.NoError(t, err)
+	sig, err := crypto.Sign(hash.Bytes(), key)
+	require.NoError(t, err)
+	// wallets return v as 27 or 28
+	sig[64] += 27
+	return sig
+}
+
+func TestAssociateSigned(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := newAssociateContext(t, tApp)
+	chainID, err := ethermint.ParseChainID(ctx.ChainID())
+	require.NoError(t, err)
+
+	p := addr.NewContract(tApp.EvmKeeper)
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	// signatures may be submitted by anyone
+	_, relayerEvmAddress := MockAddressPair()
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		digest func(account common.Address, nonce *big.Int) common.Hash
+	}{
+		{
+			name:   "personal_sign",
+			method: addr.AssociatePersonalSignMethod,
+			digest: func(account common.Address, nonce *big.Int) common.Hash {
+				return common.BytesToHash(accounts.TextHash([]byte(addr.PersonalSignMessage(chainID, account, nonce))))
+			},
+		},
+		{
+			name:   "typed data",
+			method: addr.AssociateTypedDataMethod,
+			digest: func(account common.Address, nonce *big.Int) common.Hash {
+				return addr.TypedDataHash(chainID, registry.AddrContractAddress, account, nonce)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey := MockPrivateKey()
+			cosmosAddress, evmAddress := PrivateKeyToAddresses(privKey)
+			method := addr.ABI.Methods[tc.method]
+			run := func(account common.Address, nonce *big.Int, sig []byte) ([]byte, error) {
+				inputs, err := method.Inputs.Pack(account, nonce, sig)
+				require.NoError(t, err)
+				ret, _, err := p.Run(&evm, relayerEvmAddress, registry.AddrContractAddress, append(method.ID, inputs...), suppliedGas, false, big.NewInt(0))
+				return ret, err
+			}
+
+			sig := signAssociation(t, privKey, tc.digest(evmAddress, big.NewInt(0)))
+			_, err := run(evmAddress, big.NewInt(1), signAssociation(t, privKey, tc.digest(evmAddress, big.NewInt(1))))
+			require.ErrorContains(t, err, "invalid nonce")
+			_, otherEvmAddress := MockAddressPair()
+			_, err = run(otherEvmAddress, big.NewInt(0), sig)
+			require.ErrorContains(t, err, "signature is from")
+			_, err = run(evmAddress, big.NewInt(0), sig[:64])
+			require.ErrorContains(t, err, "signature must be 65 bytes")
+
+			logs := len(stateDB.Logs())
+			ret, err := run(evmAddress, big.NewInt(0), sig)
+			require.NoError(t, err)
+			expected, _ := method.Outputs.Pack(cosmosAddress.String(), evmAddress)
+			require.Equal(t, expected, ret)
+			mappedEvmAddress, err := tApp.EvmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+			require.NoError(t, err)
+			require.Equal(t, evmAddress, *mappedEvmAddress)
+			require.Len(t, stateDB.Logs(), logs+1)
+			log := stateDB.Logs()[logs]
+			require.Equal(t, []common.Hash{addr.ABI.Events[addr.AddressAssociatedEvent].ID, common.BytesToHash(evmAddress.Bytes())}, log.Topics)
+
+			// the signature cannot be replayed
+			_, err = run(evmAddress, big.NewInt(0), sig)
+			require.ErrorContains(t, err, "invalid nonce")
+
+			nonceMethod := addr.ABI.Methods[addr.GetAssociationNonceMethod]
+			inputs, err := nonceMethod.Inputs.Pack(evmAddress)
+			require.NoError(t, err)
+			ret, _, err = p.Run(&evm, relayerEvmAddress, registry.AddrContractAddress, append(nonceMethod.ID, inputs...), suppliedGas, true, nil)
+			require.NoError(t, err)
+			nonce, err := nonceMethod.Outputs.Unpack(ret)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1), nonce[0])
+		})
+	}
+}
+
+func TestDisassociate(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := newAssociateContext(t, tApp)
+
+	p := addr.NewContract(tApp.EvmKeeper)
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	privKey := MockPrivateKey()
+	cosmosAddress, evmAddress := PrivateKeyToAddresses(privKey)
+	method := addr.ABI.Methods[addr.DisassociateMethod]
+
+	_, _, err := p.Run(&evm, evmAddress, registry.AddrContractAddress, method.ID, suppliedGas, false, big.NewInt(0))
+	require.ErrorContains(t, err, "is not associated")
+
+	associateMethod := addr.ABI.Methods[addr.AssociatePubKeyMethod]
+	inputs, err := associateMethod.Inputs.Pack(hex.EncodeToString(privKey.PubKey().Bytes()))
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, evmAddress, registry.AddrContractAddress, append(associateMethod.ID, inputs...), suppliedGas, false, big.NewInt(0))
+	require.NoError(t, err)
+
+	_, _, err = p.Run(&evm, evmAddress, registry.AddrContractAddress, method.ID, suppliedGas, true, big.NewInt(0))
+	require.ErrorContains(t, err, "staticcall")
+
+	// a contract cannot disassociate its caller through DELEGATECALL
+	tracer := pcommon.NewCallFrameTracer(nil, false)
+	tracer.CaptureEnter(vm.DELEGATECALL, common.HexToAddress("0xde1e"), registry.AddrContractAddress, nil, 0, nil)
+	delegateEVM := vm.EVM{StateDB: stateDB, Config: vm.Config{Debug: true, Tracer: tracer}}
+	_, _, err = p.Run(&delegateEVM, evmAddress, registry.AddrContractAddress, method.ID, suppliedGas, false, big.NewInt(0))
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+	mapped, err := tApp.EvmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+	require.NoError(t, err)
+	require.Equal(t, evmAddress, *mapped)
+
+	ret, _, err := p.Run(&evm, evmAddress, registry.AddrContractAddress, method.ID, suppliedGas, false, big.NewInt(0))
+	require.NoError(t, err)
+	expected, _ := method.Outputs.Pack(cosmosAddress.String(), evmAddress)
+	require.Equal(t, expected, ret)
+
+	_, err = tApp.EvmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+	require.Error(t, err)
+	require.Equal(t, sdk.AccAddress(evmAddress.Bytes()), tApp.EvmKeeper.GetCosmosAddressMapping(ctx, evmAddress))
+	logs := stateDB.Logs()
+	require.Equal(t, addr.ABI.Events[addr.AddressDisassociatedEvent].ID, logs[len(logs)-1].Topics[0])
+	// an old association signature cannot be replayed to associate again
+	require.Equal(t, common.BigToHash(big.NewInt(1)), stateDB.GetState(registry.AddrContractAddress, crypto.Keccak256Hash(evmAddress.Bytes())))
+}
+
+// TestAssociateKeepsBalances asserts that associating an account the StateDB already loaded
+// neither burns the balance of the cosmos account nor lowers its sequence once committed.
+func TestAssociateKeepsBalances(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := newAssociateContext(t, tApp)
+
+	privKey := MockPrivateKey()
+	cosmosAddress, evmAddress := PrivateKeyToAddresses(privKey)
+	castAddress := sdk.AccAddress(evmAddress.Bytes())
+	for _, fund := range []struct {
+		address sdk.AccAddress
+		amount  int64
+	}{{cosmosAddress, 100}, {castAddress, 30}} {
+		coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(fund.amount)))
+		require.NoError(t, tApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+		require.NoError(t, tApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, fund.address, coins))
+	}
+	cosmosAccount := tApp.AccountKeeper.GetAccount(ctx, cosmosAddress)
+	require.NoError(t, cosmosAccount.SetSequence(7))
+	tApp.AccountKeeper.SetAccount(ctx, cosmosAccount)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	// the sender of an evm transaction is loaded and dirty before the precompile runs
+	stateDB.SetNonce(evmAddress, 1)
+
+	p := addr.NewContract(tApp.EvmKeeper)
+	method := addr.ABI.Methods[addr.AssociatePubKeyMethod]
+	inputs, err := method.Inputs.Pack(hex.EncodeToString(privKey.PubKey().Bytes()))
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, evmAddress, registry.AddrContractAddress, append(method.ID, inputs...), suppliedGas, false, big.NewInt(0))
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, sdkmath.NewInt(130), tApp.BankKeeper.GetBalance(ctx, cosmosAddress, appconfig.CosmosDenom).Amount)
+	require.True(t, tApp.BankKeeper.GetBalance(ctx, castAddress, appconfig.CosmosDenom).IsZero())
+	require.Equal(t, uint64(7), tApp.AccountKeeper.GetAccount(ctx, cosmosAddress).GetSequence())
+}
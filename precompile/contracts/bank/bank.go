@@ -4,14 +4,19 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
@@ -27,12 +32,16 @@ var (
 
 const (
 	SendMethod        = "send"
+	MultiSendMethod   = "multiSend"
+	SendNativeMethod  = "sendNative"
 	BalanceMethod     = "balance"
 	AllBalancesMethod = "allBalances"
 	NameMethod        = "name"
 	SymbolMethod      = "symbol"
 	DecimalsMethod    = "decimals"
 	SupplyMethod      = "supply"
+
+	TransferEvent = "Transfer"
 )
 
 type CoinBalance struct {
@@ -59,32 +68,40 @@ func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, acc
 	}
 
 	functions := []*contract.StatefulPrecompileFunction{
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[SendMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SendMethod],
 			executor.send,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[BalanceMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[MultiSendMethod],
+			executor.multiSend,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SendNativeMethod],
+			executor.sendNative,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[BalanceMethod],
 			executor.balance,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[AllBalancesMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AllBalancesMethod],
 			executor.allBalances,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[NameMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[NameMethod],
 			executor.name,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[SymbolMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SymbolMethod],
 			executor.symbol,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[DecimalsMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DecimalsMethod],
 			executor.decimals,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[SupplyMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SupplyMethod],
 			executor.supply,
 		),
 	}
@@ -99,23 +116,17 @@ func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, acc
 	return precompile
 }
 
-func (p PrecompileExecutor) send(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) send(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
 			return
 		}
@@ -157,35 +168,229 @@ func (p PrecompileExecutor) send(accessibleState contract.AccessibleState,
 		return
 	}
 
-	senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
-	receiverCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, receiverEvmAddr)
-	if err := p.bankKeeper.SendCoins(ctx, senderCosmosAddr, receiverCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))); err != nil {
+	if err := p.transfer(ctx, accessibleState.GetStateDB(), caller, receiverEvmAddr, denom, amount); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(true)
+	return
+}
+
+func (p PrecompileExecutor) multiSend(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[MultiSendMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call multiSend from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
 		rerr = err
 		return
 	}
 
+	receivers := args[0].([]common.Address)
+	denom := args[1].(string)
+	if denom == "" {
+		rerr = errors.New("invalid denom")
+		return
+	}
+	amounts := args[2].([]*big.Int)
+	if len(receivers) == 0 || len(receivers) != len(amounts) {
+		rerr = fmt.Errorf("got %d receivers for %d amounts", len(receivers), len(amounts))
+		return
+	}
+
+	// check the total up front, so a batch never stops halfway for lack of funds
+	total := new(big.Int)
+	for _, amount := range amounts {
+		total.Add(total, amount)
+	}
+	stateDB := accessibleState.GetStateDB()
+	if balance := p.spendableBalance(ctx, stateDB, caller, denom); balance.Cmp(total) < 0 {
+		rerr = fmt.Errorf("insufficient funds: %s%s is smaller than %s%s", balance, denom, total, denom)
+		return
+	}
+	for i, receiver := range receivers {
+		if amounts[i].Sign() == 0 {
+			continue
+		}
+		if err := p.transfer(ctx, stateDB, caller, receiver, denom, amounts[i]); err != nil {
+			rerr = fmt.Errorf("send to %s: %w", receiver.Hex(), err)
+			return
+		}
+	}
+
 	ret, rerr = method.Outputs.Pack(true)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) balance(accessibleState contract.AccessibleState,
+// sendNative forwards msg.value to a cosmos account. The value is in aorai like every EVM
+// amount and is received as orai, so it must be a whole number of orai.
+func (p PrecompileExecutor) sendNative(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	method := ABI.Methods[SendNativeMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call sendNative from staticcall")
+		return
+	}
+	if value == nil || value.Sign() == 0 {
+		rerr = errors.New("set msg.value to send a non-zero amount")
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
 
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
+	receiverEvmAddr, err := pcommon.ResolveEvmAddress(ctx, p.evmKeeper, args[0].(string))
+	if err != nil {
+		rerr = err
 		return
 	}
 
+	amount, remainder := appconfig.ToCosmosAmount(value)
+	if remainder.Sign() != 0 {
+		rerr = fmt.Errorf("msg.value must be a multiple of %s%s", appconfig.ConversionMultiplier, appconfig.EvmDenom)
+		return
+	}
+
+	// the EVM moved msg.value to the precompile before calling it
+	stateDB := accessibleState.GetStateDB()
+	stateDB.SubBalance(callingContract, value)
+	stateDB.AddBalance(receiverEvmAddr, value)
+	if err := emitTransfer(ctx, stateDB, caller, receiverEvmAddr, appconfig.CosmosDenom, amount); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(true)
+	return
+}
+
+// transfer sends amount of denom from sender to receiver. The native denom is moved in the
+// StateDB rather than the bank: the StateDB caches the balances of the accounts the transaction
+// touched and would overwrite a bank transfer of them when it commits. Since the StateDB does not
+// consume cosmos gas, such a transfer is charged what the EVM charges for a CALL moving value.
+func (p PrecompileExecutor) transfer(ctx sdk.Context, stateDB contract.StateDB, sender common.Address, receiver common.Address, denom string, amount *big.Int) error {
+	switch denom {
+	case appconfig.EvmDenom, appconfig.CosmosDenom:
+		evmAmount := amount
+		if denom == appconfig.CosmosDenom {
+			evmAmount = appconfig.ToEvmAmount(amount)
+		}
+		if balance := stateDB.GetBalance(sender); balance.Cmp(evmAmount) < 0 {
+			return fmt.Errorf("insufficient funds: %s%s is smaller than %s%s", balance, appconfig.EvmDenom, evmAmount, appconfig.EvmDenom)
+		}
+		gas := params.CallValueTransferGas
+		if stateDB.Empty(receiver) {
+			gas += params.CallNewAccountGas
+		}
+		ctx.GasMeter().ConsumeGas(gas, "native transfer")
+		stateDB.SubBalance(sender, evmAmount)
+		stateDB.AddBalance(receiver, evmAmount)
+	default:
+		senderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, sender)
+		receiverCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, receiver)
+		if err := p.bankKeeper.SendCoins(ctx, senderCosmosAddr, receiverCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))); err != nil {
+			return err
+		}
+	}
+	return emitTransfer(ctx, stateDB, sender, receiver, denom, amount)
+}
+
+// spendableBalance returns the amount of denom the account can send, the native denom is read
+// from the StateDB for the same reason transfer moves it there.
+func (p PrecompileExecutor) spendableBalance(ctx sdk.Context, stateDB contract.StateDB, account common.Address, denom string) *big.Int {
+	switch denom {
+	case appconfig.EvmDenom:
+		return stateDB.GetBalance(account)
+	case appconfig.CosmosDenom:
+		balance, _ := appconfig.ToCosmosAmount(stateDB.GetBalance(account))
+		return balance
+	default:
+		cosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, account)
+		return p.bankKeeper.SpendableCoins(ctx, cosmosAddr).AmountOf(denom).BigInt()
+	}
+}
+
+// emitTransfer logs the transfer on the denom's erc20 pointer address, so indexers see the same
+// Transfer whether the coins were moved through the bank precompile or through the pointer itself.
+// The log is charged at the price of a LOG3.
+func emitTransfer(ctx sdk.Context, stateDB contract.StateDB, sender common.Address, receiver common.Address, denom string, amount *big.Int) error {
+	event := ABI.Events[TransferEvent]
+	data, err := event.Inputs.NonIndexed().Pack(amount)
+	if err != nil {
+		return err
+	}
+	topics := []common.Hash{event.ID, common.BytesToHash(sender.Bytes()), common.BytesToHash(receiver.Bytes())}
+	ctx.GasMeter().ConsumeGas(params.LogGas+uint64(len(topics))*params.LogTopicGas+uint64(len(data))*params.LogDataGas, "transfer log")
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     erc20.PointerAddress(denom),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (p PrecompileExecutor) balance(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying balance using precompile: ", rerr.Error())
 			return
@@ -221,27 +426,20 @@ func (p PrecompileExecutor) balance(accessibleState contract.AccessibleState,
 	balance := p.bankKeeper.GetBalance(ctx, cosmosAddr, denom)
 
 	ret, rerr = method.Outputs.Pack(balance.Amount.BigInt())
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) allBalances(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) allBalances(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying allBalances using precompile: ", rerr.Error())
 			return
@@ -280,27 +478,20 @@ func (p PrecompileExecutor) allBalances(accessibleState contract.AccessibleState
 	}
 
 	ret, rerr = method.Outputs.Pack(coinBalances)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) name(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) name(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying name using precompile: ", rerr.Error())
 			return
@@ -315,27 +506,20 @@ func (p PrecompileExecutor) name(accessibleState contract.AccessibleState,
 	}
 
 	ret, rerr = method.Outputs.Pack(metadata.Name)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) symbol(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) symbol(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying symbol using precompile: ", rerr.Error())
 			return
@@ -350,27 +534,20 @@ func (p PrecompileExecutor) symbol(accessibleState contract.AccessibleState,
 	}
 
 	ret, rerr = method.Outputs.Pack(metadata.Symbol)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
-func (p PrecompileExecutor) decimals(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) decimals(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying decimals using precompile: ", rerr.Error())
 			return
@@ -378,28 +555,58 @@ func (p PrecompileExecutor) decimals(accessibleState contract.AccessibleState,
 	}()
 	method := ABI.Methods[DecimalsMethod]
 
-	ret, rerr = method.Outputs.Pack(uint8(0))
-	remainingGas, rerr = contract.DeductGas(suppliedGas, 0)
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	// the exponent of the display unit, the native denoms are known without metadata
+	var decimals uint32
+	denom := args[0].(string)
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
+	switch {
+	case found:
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				decimals = unit.Exponent
+			}
+		}
+	case denom == appconfig.CosmosDenom:
+		decimals = appconfig.CosmosDecimals
+	case denom == appconfig.EvmDenom:
+		decimals = appconfig.EvmDecimals
+	}
+	if decimals > math.MaxUint8 {
+		rerr = fmt.Errorf("decimals %d of denom %s do not fit in uint8", decimals, denom)
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(uint8(decimals))
 	return
 }
 
-func (p PrecompileExecutor) supply(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) supply(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s\n", err)
 			ctx.Logger().Error("Error querying supply using precompile: ", rerr.Error())
 			return
@@ -426,7 +633,6 @@ func (p PrecompileExecutor) supply(accessibleState contract.AccessibleState,
 	denom := args[0].(string)
 	coin := p.bankKeeper.GetSupply(ctx, denom)
 	ret, rerr = method.Outputs.Pack(coin.Amount.BigInt())
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
 	return
 }
 
@@ -6,13 +6,17 @@ import (
 	"testing"
 
 	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/CosmWasm/wasmd/precompile/contracts/bank"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
 	"github.com/CosmWasm/wasmd/precompile/registry"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/go-bip39"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/stretchr/testify/require"
 
@@ -70,8 +74,9 @@ func TestSend(t *testing.T) {
 	tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, sentCoins)
 	tApp.GetBankKeeper().SetParams(ctx, banktypes.DefaultParams())
 
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
 	evm := vm.EVM{
-		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		StateDB: stateDB,
 	}
 	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, accountKeeper)
 	method := bank.ABI.Methods[bank.SendMethod]
@@ -79,6 +84,7 @@ func TestSend(t *testing.T) {
 
 	args, err := method.Inputs.Pack(mockReceiverEVMAddr, denom, sentCoins[0].Amount.BigInt())
 	require.Nil(t, err)
+	snapshot := stateDB.Snapshot()
 	res, _, err := p.Run(&evm, mockEVMAddr, registry.AddrContractAddress,
 		append(method.ID, args...),
 		suppliedGas,
@@ -93,6 +99,18 @@ func TestSend(t *testing.T) {
 
 	balance := bankKeeper.GetBalance(ctx, receiveCosmosAddr, denom)
 	require.Equal(t, balance.Amount, sdkmath.NewInt(10))
+
+	// the send shows up as an erc20 Transfer on the denom's pointer address
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	event := bank.ABI.Events[bank.TransferEvent]
+	require.Equal(t, erc20.PointerAddress(denom), logs[0].Address)
+	require.Equal(t, []common.Hash{event.ID, common.BytesToHash(mockEVMAddr.Bytes()), common.BytesToHash(mockReceiverEVMAddr.Bytes())}, logs[0].Topics)
+	require.Equal(t, common.BigToHash(big.NewInt(10)).Bytes(), logs[0].Data)
+
+	// and is dropped again when the surrounding call reverts
+	stateDB.RevertToSnapshot(snapshot)
+	require.Empty(t, stateDB.Logs())
 }
 
 func TestBalance(t *testing.T) {
@@ -166,3 +184,197 @@ func TestSupply(t *testing.T) {
 	require.Equal(t, 1, len(output))
 	require.Equal(t, output[0].(*big.Int), big.NewInt(mintCoins[0].Amount.Int64()))
 }
+
+func setEvmDenom(t *testing.T, tApp *app.WasmApp, ctx sdk.Context) {
+	params := tApp.EvmKeeper.GetParams(ctx)
+	params.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, params))
+}
+
+func TestMultiSend(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	setEvmDenom(t, tApp, ctx)
+	bankKeeper := tApp.GetBankKeeper()
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.GetAccountKeeper())
+	method := bank.ABI.Methods[bank.MultiSendMethod]
+	suppliedGas := uint64(10_000_000)
+
+	for _, denom := range []string{"ukava", appconfig.CosmosDenom} {
+		t.Run(denom, func(t *testing.T) {
+			senderAddr, senderEVMAddr := MockAddressPair()
+			tApp.EvmKeeper.SetAddressMapping(ctx, senderAddr, senderEVMAddr)
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+			require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+			require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, senderAddr, coins))
+
+			receivers := make([]common.Address, 3)
+			receiverAddrs := make([]sdk.AccAddress, 3)
+			for i := range receivers {
+				receiverAddrs[i], receivers[i] = MockAddressPair()
+				tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddrs[i], receivers[i])
+			}
+			amounts := []*big.Int{big.NewInt(10), big.NewInt(0), big.NewInt(30)}
+
+			stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := vm.EVM{StateDB: stateDB}
+			// the sender of an evm transaction is loaded and dirty before the precompile runs
+			stateDB.SetNonce(senderEVMAddr, 1)
+
+			args, err := method.Inputs.Pack(receivers[:2], denom, amounts)
+			require.NoError(t, err)
+			_, _, err = p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+			require.ErrorContains(t, err, "got 2 receivers for 3 amounts")
+
+			args, err = method.Inputs.Pack(receivers, denom, []*big.Int{big.NewInt(10), big.NewInt(0), big.NewInt(100)})
+			require.NoError(t, err)
+			_, _, err = p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+			require.ErrorContains(t, err, "insufficient funds")
+
+			args, err = method.Inputs.Pack(receivers, denom, amounts)
+			require.NoError(t, err)
+			res, _, err := p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+			require.NoError(t, err)
+			output, err := method.Outputs.Unpack(res)
+			require.NoError(t, err)
+			require.Equal(t, true, output[0])
+			require.NoError(t, stateDB.Commit())
+
+			require.Equal(t, sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, senderAddr, denom).Amount)
+			for i, receiverAddr := range receiverAddrs {
+				require.Equal(t, sdkmath.NewIntFromBigInt(amounts[i]), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+			}
+
+			// zero amounts are skipped, the other sends are logged as erc20 Transfers
+			logs := stateDB.Logs()
+			require.Len(t, logs, 2)
+			require.Equal(t, erc20.PointerAddress(denom), logs[1].Address)
+			require.Equal(t, common.BytesToHash(receivers[2].Bytes()), logs[1].Topics[2])
+			require.Equal(t, common.BigToHash(big.NewInt(30)).Bytes(), logs[1].Data)
+		})
+	}
+}
+
+// TestMultiSendGas asserts that the native denom legs of a multiSend, which only touch the
+// StateDB, are charged per receiver.
+func TestMultiSendGas(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	setEvmDenom(t, tApp, ctx)
+	bankKeeper := tApp.GetBankKeeper()
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.GetAccountKeeper())
+	method := bank.ABI.Methods[bank.MultiSendMethod]
+	suppliedGas := uint64(10_000_000)
+
+	senderAddr, senderEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, senderAddr, senderEVMAddr)
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, senderAddr, coins))
+
+	var lastGasUsed uint64
+	for _, n := range []int{1, 2, 4} {
+		receivers := make([]common.Address, n)
+		amounts := make([]*big.Int, n)
+		for i := range receivers {
+			_, receivers[i] = MockAddressPair()
+			amounts[i] = big.NewInt(1)
+		}
+		stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+		evm := vm.EVM{StateDB: stateDB}
+		args, err := method.Inputs.Pack(receivers, appconfig.CosmosDenom, amounts)
+		require.NoError(t, err)
+		_, remainingGas, err := p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+		require.NoError(t, err)
+
+		gasUsed := suppliedGas - remainingGas
+		require.GreaterOrEqual(t, gasUsed, pcommon.TxBaseGas+uint64(n)*(params.CallValueTransferGas+params.CallNewAccountGas))
+		require.Greater(t, gasUsed, lastGasUsed)
+		lastGasUsed = gasUsed
+	}
+
+	// a receiver which already holds a balance is not charged for a new account
+	receivers := []common.Address{senderEVMAddr}
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	args, err := method.Inputs.Pack(receivers, appconfig.CosmosDenom, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	_, remainingGas, err := p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.NoError(t, err)
+	require.Less(t, suppliedGas-remainingGas, pcommon.TxBaseGas+params.CallValueTransferGas+params.CallNewAccountGas)
+}
+
+func TestSendNative(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	setEvmDenom(t, tApp, ctx)
+	bankKeeper := tApp.GetBankKeeper()
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.GetAccountKeeper())
+	method := bank.ABI.Methods[bank.SendNativeMethod]
+	suppliedGas := uint64(10_000_000)
+	_, senderEVMAddr := MockAddressPair()
+	receiverAddr, _ := MockAddressPair()
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	args, err := method.Inputs.Pack(receiverAddr.String())
+	require.NoError(t, err)
+
+	_, _, err = p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "non-zero amount")
+	_, _, err = p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, big.NewInt(1))
+	require.ErrorContains(t, err, "multiple of")
+
+	// 2.5 orai, which the EVM moved to the precompile before calling it
+	value := appconfig.ToEvmAmount(big.NewInt(2_500_000))
+	stateDB.AddBalance(registry.BankContractAddress, value)
+	res, _, err := p.Run(&evm, senderEVMAddr, registry.BankContractAddress, append(method.ID, args...), suppliedGas, false, value)
+	require.NoError(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.NoError(t, err)
+	require.Equal(t, true, output[0])
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, sdkmath.NewInt(2_500_000), bankKeeper.GetBalance(ctx, receiverAddr, appconfig.CosmosDenom).Amount)
+	require.True(t, bankKeeper.GetBalance(ctx, receiverAddr, appconfig.EvmDenom).IsZero())
+	require.True(t, bankKeeper.GetBalance(ctx, sdk.AccAddress(registry.BankContractAddress.Bytes()), appconfig.CosmosDenom).IsZero())
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, erc20.PointerAddress(appconfig.CosmosDenom), logs[0].Address)
+	require.Equal(t, common.BigToHash(big.NewInt(2_500_000)).Bytes(), logs[0].Data)
+}
+
+func TestDecimals(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	bankKeeper := tApp.GetBankKeeper()
+	bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	})
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := bank.NewContract(tApp.EvmKeeper, bankKeeper, tApp.GetAccountKeeper())
+	method := bank.ABI.Methods[bank.DecimalsMethod]
+
+	for denom, expected := range map[string]uint8{
+		"uatom":               6,
+		appconfig.CosmosDenom: 6,
+		appconfig.EvmDenom:    18,
+		"unknown":             0,
+	} {
+		args, err := method.Inputs.Pack(denom)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, registry.BankContractAddress, registry.BankContractAddress, append(method.ID, args...), 10_000_000, true, nil)
+		require.NoError(t, err)
+		output, err := method.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, expected, output[0], denom)
+	}
+}
@@ -0,0 +1,766 @@
+package cw20
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of cw20 contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	NameMethod         = "name"
+	SymbolMethod       = "symbol"
+	DecimalsMethod     = "decimals"
+	TotalSupplyMethod  = "totalSupply"
+	BalanceOfMethod    = "balanceOf"
+	AllowanceMethod    = "allowance"
+	ApproveMethod      = "approve"
+	TransferMethod     = "transfer"
+	TransferFromMethod = "transferFrom"
+
+	TransferEvent = "Transfer"
+	ApprovalEvent = "Approval"
+)
+
+// pointerAddressPrefix namespaces the keccak preimage of pointer addresses so they
+// cannot collide with addresses derived for other purposes.
+const pointerAddressPrefix = "cw20-pointer/"
+
+var (
+	// contractLengthSlot holds the byte length of the CW20 address stored in a pointer account,
+	// the address itself is stored right padded in contractSlot.
+	contractLengthSlot = common.Hash{}
+	contractSlot       = common.BigToHash(big.NewInt(1))
+)
+
+type PrecompileExecutor struct {
+	wasmdKeeper     pcommon.WasmdKeeper
+	wasmdViewKeeper pcommon.WasmdViewKeeper
+	evmKeeper       pcommon.EVMKeeper
+}
+
+// NewContract returns a new cw20 stateful precompiled contract.
+//
+//	A CW20 contract registered through governance gets a pointer contract at a deterministic
+//	address (see PointerAddress). The pointer runs the same forwarding code as the bank denom
+//	pointers of the erc20 precompile, and this precompile serves the ERC20 interface by executing
+//	and querying the CW20 contract as the cosmos account mapped to the original msg.sender.
+func NewContract(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdViewKeeper, evmKeeper pcommon.EVMKeeper) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		wasmdKeeper:     wasmdKeeper,
+		wasmdViewKeeper: wasmdViewKeeper,
+		evmKeeper:       evmKeeper,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[NameMethod],
+			executor.name,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SymbolMethod],
+			executor.symbol,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DecimalsMethod],
+			executor.decimals,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TotalSupplyMethod],
+			executor.totalSupply,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[BalanceOfMethod],
+			executor.balanceOf,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AllowanceMethod],
+			executor.allowance,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ApproveMethod],
+			executor.approve,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TransferMethod],
+			executor.transfer,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TransferFromMethod],
+			executor.transferFrom,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate cw20 precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+// PointerAddress returns the deterministic EVM address of the pointer contract for the CW20
+// contract cw20Addr.
+func PointerAddress(cw20Addr sdk.AccAddress) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(pointerAddressPrefix), cw20Addr)[12:])
+}
+
+// DeployPointer deploys the pointer of cw20Addr forwarding to the precompile at precompile and
+// returns its address.
+func DeployPointer(stateDB contract.StateDB, precompile common.Address, cw20Addr sdk.AccAddress) (common.Address, error) {
+	if len(cw20Addr) == 0 || len(cw20Addr) > common.HashLength {
+		return common.Address{}, fmt.Errorf("invalid cw20 address length %d", len(cw20Addr))
+	}
+
+	pointer := PointerAddress(cw20Addr)
+	if stateDB.GetCodeSize(pointer) != 0 {
+		return common.Address{}, fmt.Errorf("pointer for cw20 %s already exists at %s", cw20Addr, pointer.Hex())
+	}
+
+	if !stateDB.Exist(pointer) {
+		stateDB.CreateAccount(pointer)
+	}
+	stateDB.SetNonce(pointer, 1)
+	stateDB.SetCode(pointer, erc20.PointerCode(precompile))
+	stateDB.SetState(pointer, contractLengthSlot, common.BigToHash(big.NewInt(int64(len(cw20Addr)))))
+	stateDB.SetState(pointer, contractSlot, common.BytesToHash(common.RightPadBytes(cw20Addr, common.HashLength)))
+	return pointer, nil
+}
+
+func (p PrecompileExecutor) name(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cw20 name using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[NameMethod]
+
+	cw20Addr, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	info, err := p.tokenInfo(ctx, cw20Addr)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(info.Name)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) symbol(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cw20 symbol using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[SymbolMethod]
+
+	cw20Addr, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	info, err := p.tokenInfo(ctx, cw20Addr)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(info.Symbol)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) decimals(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cw20 decimals using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DecimalsMethod]
+
+	cw20Addr, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	info, err := p.tokenInfo(ctx, cw20Addr)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(info.Decimals)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) totalSupply(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cw20 total supply using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TotalSupplyMethod]
+
+	cw20Addr, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	info, err := p.tokenInfo(ctx, cw20Addr)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(info.TotalSupply.BigInt())
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) balanceOf(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cw20 balance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[BalanceOfMethod]
+
+	cw20Addr, _, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	account := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	var res struct {
+		Balance sdkmath.Int `json:"balance"`
+	}
+	if err := p.query(ctx, cw20Addr, map[string]interface{}{"balance": map[string]string{"address": account.String()}}, &res); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(res.Balance.BigInt())
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) allowance(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cw20 allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[AllowanceMethod]
+
+	cw20Addr, _, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	owner := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	spender := p.evmKeeper.GetCosmosAddressMapping(ctx, args[1].(common.Address))
+	amount, err := p.getAllowance(ctx, cw20Addr, owner, spender)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(amount.BigInt())
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) approve(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error approving cw20 allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ApproveMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call approve from staticcall")
+		return
+	}
+
+	cw20Addr, owner, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	spender := args[0].(common.Address)
+	amount, err := cw20Amount(args[1].(*big.Int))
+	if err != nil {
+		rerr = err
+		return
+	}
+	if spender == (common.Address{}) {
+		rerr = errors.New("cannot approve the zero address")
+		return
+	}
+
+	// CW20 only changes allowances relatively, so the difference to the current one is applied
+	ownerCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, owner)
+	spenderCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, spender)
+	current, err := p.getAllowance(ctx, cw20Addr, ownerCosmosAddr, spenderCosmosAddr)
+	if err != nil {
+		rerr = err
+		return
+	}
+	switch {
+	case amount.GT(current):
+		err = p.execute(ctx, cw20Addr, ownerCosmosAddr, map[string]interface{}{
+			"increase_allowance": map[string]string{"spender": spenderCosmosAddr.String(), "amount": amount.Sub(current).String()},
+		})
+	case amount.LT(current):
+		err = p.execute(ctx, cw20Addr, ownerCosmosAddr, map[string]interface{}{
+			"decrease_allowance": map[string]string{"spender": spenderCosmosAddr.String(), "amount": current.Sub(amount).String()},
+		})
+	}
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := emitLog(ctx, accessibleState.GetStateDB(), caller, ApprovalEvent, owner, spender, amount.BigInt()); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) transfer(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error transferring cw20 using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TransferMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call transfer from staticcall")
+		return
+	}
+
+	cw20Addr, sender, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	to := args[0].(common.Address)
+	amount, err := cw20Amount(args[1].(*big.Int))
+	if err != nil {
+		rerr = err
+		return
+	}
+	if to == (common.Address{}) {
+		rerr = errors.New("cannot transfer to the zero address")
+		return
+	}
+
+	if amount.IsPositive() {
+		if err := p.execute(ctx, cw20Addr, p.evmKeeper.GetCosmosAddressMapping(ctx, sender), map[string]interface{}{
+			"transfer": map[string]string{"recipient": p.evmKeeper.GetCosmosAddressMapping(ctx, to).String(), "amount": amount.String()},
+		}); err != nil {
+			rerr = err
+			return
+		}
+	}
+
+	if err := emitLog(ctx, accessibleState.GetStateDB(), caller, TransferEvent, sender, to, amount.BigInt()); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) transferFrom(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error transferring cw20 from allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TransferFromMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call transferFrom from staticcall")
+		return
+	}
+
+	cw20Addr, spender, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	from := args[0].(common.Address)
+	to := args[1].(common.Address)
+	amount, err := cw20Amount(args[2].(*big.Int))
+	if err != nil {
+		rerr = err
+		return
+	}
+	if to == (common.Address{}) {
+		rerr = errors.New("cannot transfer to the zero address")
+		return
+	}
+
+	// the CW20 contract checks and spends the allowance of the spender
+	if amount.IsPositive() {
+		if err := p.execute(ctx, cw20Addr, p.evmKeeper.GetCosmosAddressMapping(ctx, spender), map[string]interface{}{
+			"transfer_from": map[string]string{
+				"owner":     p.evmKeeper.GetCosmosAddressMapping(ctx, from).String(),
+				"recipient": p.evmKeeper.GetCosmosAddressMapping(ctx, to).String(),
+				"amount":    amount.String(),
+			},
+		}); err != nil {
+			rerr = err
+			return
+		}
+	}
+
+	if err := emitLog(ctx, accessibleState.GetStateDB(), caller, TransferEvent, from, to, amount.BigInt()); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+// tokenInfoResponse is the response of the CW20 token_info query.
+type tokenInfoResponse struct {
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+	Decimals    uint8       `json:"decimals"`
+	TotalSupply sdkmath.Int `json:"total_supply"`
+}
+
+func (p PrecompileExecutor) tokenInfo(ctx sdk.Context, cw20Addr sdk.AccAddress) (tokenInfoResponse, error) {
+	var res tokenInfoResponse
+	err := p.query(ctx, cw20Addr, map[string]interface{}{"token_info": struct{}{}}, &res)
+	return res, err
+}
+
+func (p PrecompileExecutor) getAllowance(ctx sdk.Context, cw20Addr, owner, spender sdk.AccAddress) (sdkmath.Int, error) {
+	var res struct {
+		Allowance sdkmath.Int `json:"allowance"`
+	}
+	if err := p.query(ctx, cw20Addr, map[string]interface{}{
+		"allowance": map[string]string{"owner": owner.String(), "spender": spender.String()},
+	}, &res); err != nil {
+		return sdkmath.Int{}, err
+	}
+	return res.Allowance, nil
+}
+
+// query runs the smart query req on the CW20 contract and decodes its response into res.
+func (p PrecompileExecutor) query(ctx sdk.Context, cw20Addr sdk.AccAddress, req interface{}, res interface{}) error {
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	bz, err = p.wasmdViewKeeper.QuerySmart(ctx, cw20Addr, bz)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(bz, res); err != nil {
+		return fmt.Errorf("contract %s is not a cw20 token: %w", cw20Addr, err)
+	}
+	return nil
+}
+
+// execute executes msg on the CW20 contract as sender. The wasm state changes are only written
+// when the execution succeeds.
+func (p PrecompileExecutor) execute(ctx sdk.Context, cw20Addr, sender sdk.AccAddress, msg interface{}) error {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	em := sdk.NewEventManager()
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := p.wasmdKeeper.Execute(cacheCtx.WithEventManager(em), cw20Addr, sender, bz, sdk.NewCoins()); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(em.Events())
+	return nil
+}
+
+// cw20Amount converts an ERC20 amount to the Uint128 amounts of CW20.
+func cw20Amount(amount *big.Int) (sdkmath.Int, error) {
+	if amount.Sign() < 0 || amount.BitLen() > 128 {
+		return sdkmath.Int{}, fmt.Errorf("amount %s does not fit in a cw20 amount", amount)
+	}
+	return sdkmath.NewIntFromBigInt(amount), nil
+}
+
+// resolvePointerCall checks that caller is a pointer deployed for this precompile and splits the
+// forwarded input into the pointer's CW20 contract, the original msg.sender and the ABI encoded
+// arguments.
+func resolvePointerCall(accessibleState contract.AccessibleState, caller common.Address, addr common.Address, packedInput []byte, value *big.Int) (sdk.AccAddress, common.Address, []byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	if !bytes.Equal(stateDB.GetCode(caller), erc20.PointerCode(addr)) {
+		return nil, common.Address{}, nil, errors.New("cw20 methods must be called through a pointer contract")
+	}
+
+	cw20Addr := GetPointee(stateDB, caller)
+	if len(cw20Addr) == 0 || PointerAddress(cw20Addr) != caller {
+		return nil, common.Address{}, nil, fmt.Errorf("pointer %s does not match its cw20 %s", caller.Hex(), cw20Addr)
+	}
+
+	if len(packedInput) < common.HashLength {
+		return nil, common.Address{}, nil, errors.New("missing pointer caller")
+	}
+	split := len(packedInput) - common.HashLength
+	sender := common.BytesToAddress(packedInput[split:])
+
+	return cw20Addr, sender, packedInput[:split], nil
+}
+
+// GetPointee returns the CW20 contract stored in the pointer account, if any.
+func GetPointee(stateDB contract.StateDB, pointer common.Address) sdk.AccAddress {
+	length := stateDB.GetState(pointer, contractLengthSlot).Big()
+	if !length.IsUint64() || length.Uint64() > common.HashLength {
+		return nil
+	}
+	return sdk.AccAddress(stateDB.GetState(pointer, contractSlot).Bytes()[:length.Uint64()])
+}
+
+func emitLog(ctx sdk.Context, stateDB contract.StateDB, pointer common.Address, eventName string, from, to common.Address, amount *big.Int) error {
+	event := ABI.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     pointer,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
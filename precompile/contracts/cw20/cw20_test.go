@@ -0,0 +1,198 @@
+package cw20_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/cw20"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	precompilekeeper "github.com/CosmWasm/wasmd/x/precompile/keeper"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(key.PublicKey)
+}
+
+// callPointer runs method on the precompile the same way the pointer bytecode does: with the
+// pointer as caller and the original sender appended to the calldata.
+func callPointer(t *testing.T, p contract.StatefulPrecompiledContract, evm *vm.EVM, pointer common.Address, sender common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	m := cw20.ABI.Methods[method]
+	packed, err := m.Inputs.Pack(args...)
+	require.NoError(t, err)
+	input := append(append(m.ID, packed...), common.LeftPadBytes(sender.Bytes(), 32)...)
+	res, _, err := p.Run(evm, pointer, registry.Cw20ContractAddress, input, uint64(10_000_000), false, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.Outputs.Unpack(res)
+}
+
+// setupCw20 aligns the evm params with the denom served by EvmBankKeeper, so pointers can be
+// committed, and instantiates the cw20_base fixture with a balance of 100 for owner.
+func setupCw20(t *testing.T, tApp *app.WasmApp, ctx sdk.Context, owner sdk.AccAddress) sdk.AccAddress {
+	params := tApp.EvmKeeper.GetParams(ctx)
+	params.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, params))
+	require.NoError(t, tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams()))
+	code, err := os.ReadFile("../../../benchmarks/testdata/cw20_base.wasm")
+	require.NoError(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, owner, code, nil)
+	require.NoError(t, err)
+	initMsg := fmt.Sprintf(`{"name":"Cw20 Token","symbol":"CWT","decimals":6,"initial_balances":[{"address":"%s","amount":"100"}]}`, owner)
+	cw20Addr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, owner, nil, []byte(initMsg), "cw20", nil)
+	require.NoError(t, err)
+	return cw20Addr
+}
+
+func TestRegisterPointer(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(false, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	owner, _ := MockAddressPair()
+	cw20Addr := setupCw20(t, tApp, ctx, owner)
+	msgServer := precompilekeeper.NewMsgServerImpl(tApp.PrecompileKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only governance can register pointers
+	_, err := msgServer.RegisterPointer(ctx, &precompiletypes.MsgRegisterPointer{Authority: owner.String(), Type: precompiletypes.PointerTypeCW20, Pointee: cw20Addr.String()})
+	require.Error(t, err)
+
+	res, err := msgServer.RegisterPointer(ctx, &precompiletypes.MsgRegisterPointer{Authority: authority, Type: precompiletypes.PointerTypeCW20, Pointee: cw20Addr.String()})
+	require.NoError(t, err)
+	pointer := cw20.PointerAddress(cw20Addr)
+	require.Equal(t, pointer.Hex(), res.Pointer)
+	require.Equal(t, erc20.PointerCode(registry.Cw20ContractAddress), tApp.EvmKeeper.GetCode(ctx, common.BytesToHash(tApp.EvmKeeper.GetAccount(ctx, pointer).CodeHash)))
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	require.Equal(t, cw20Addr, cw20.GetPointee(stateDB, pointer))
+
+	queryServer := precompilekeeper.NewQueryServer(tApp.PrecompileKeeper, tApp.Precompiles)
+	pointerRes, err := queryServer.Pointer(ctx, &precompiletypes.QueryPointerRequest{Type: precompiletypes.PointerTypeCW20, Pointee: cw20Addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, precompiletypes.Pointer{Type: precompiletypes.PointerTypeCW20, Pointee: cw20Addr.String(), Pointer: pointer.Hex()}, pointerRes.Pointer)
+	pointeeRes, err := queryServer.Pointee(ctx, &precompiletypes.QueryPointeeRequest{Pointer: pointer.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, pointerRes.Pointer, pointeeRes.Pointer)
+
+	// registering twice fails
+	_, err = msgServer.RegisterPointer(ctx, &precompiletypes.MsgRegisterPointer{Authority: authority, Type: precompiletypes.PointerTypeCW20, Pointee: cw20Addr.String()})
+	require.ErrorIs(t, err, precompiletypes.ErrPointerExists)
+
+	// accounts that are not cw20 contracts cannot get a pointer
+	_, err = msgServer.RegisterPointer(ctx, &precompiletypes.MsgRegisterPointer{Authority: authority, Type: precompiletypes.PointerTypeCW20, Pointee: owner.String()})
+	require.ErrorIs(t, err, precompiletypes.ErrInvalidPointer)
+
+	// erc20 pointers must point at an evm contract
+	_, evmAddr := MockAddressPair()
+	_, err = msgServer.RegisterPointer(ctx, &precompiletypes.MsgRegisterPointer{Authority: authority, Type: precompiletypes.PointerTypeERC20, Pointee: evmAddr.Hex(), Pointer: cw20Addr.String()})
+	require.ErrorIs(t, err, precompiletypes.ErrInvalidPointer)
+
+	genesis := tApp.PrecompileKeeper.ExportGenesis(ctx)
+	require.Equal(t, []precompiletypes.Pointer{pointerRes.Pointer}, genesis.Pointers)
+	require.NoError(t, genesis.Validate())
+}
+
+func TestTransferAndAllowance(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(false, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	ownerAddr, ownerEVMAddr := MockAddressPair()
+	spenderAddr, spenderEVMAddr := MockAddressPair()
+	receiverAddr, receiverEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, ownerAddr, ownerEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, spenderAddr, spenderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddr, receiverEVMAddr)
+	cw20Addr := setupCw20(t, tApp, ctx, ownerAddr)
+	_, err := tApp.PrecompileKeeper.RegisterPointer(ctx, precompiletypes.PointerTypeCW20, cw20Addr.String(), "")
+	require.NoError(t, err)
+	pointer := cw20.PointerAddress(cw20Addr)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := cw20.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper)
+
+	// calling cw20 methods directly, without a pointer, is rejected
+	balanceOf := cw20.ABI.Methods[cw20.BalanceOfMethod]
+	args, err := balanceOf.Inputs.Pack(ownerEVMAddr)
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, ownerEVMAddr, registry.Cw20ContractAddress, append(balanceOf.ID, args...), uint64(10_000_000), true, nil)
+	require.ErrorContains(t, err, "pointer")
+
+	output, err := callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.NameMethod)
+	require.NoError(t, err)
+	require.Equal(t, "Cw20 Token", output[0].(string))
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.DecimalsMethod)
+	require.NoError(t, err)
+	require.Equal(t, uint8(6), output[0].(uint8))
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.TotalSupplyMethod)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), output[0].(*big.Int))
+
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.TransferMethod, receiverEVMAddr, big.NewInt(10))
+	require.NoError(t, err)
+	require.True(t, output[0].(bool))
+	for addr, expected := range map[common.Address]int64{ownerEVMAddr: 90, receiverEVMAddr: 10} {
+		output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.BalanceOfMethod, addr)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(expected), output[0].(*big.Int))
+	}
+
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.ApproveMethod, spenderEVMAddr, big.NewInt(30))
+	require.NoError(t, err)
+	require.True(t, output[0].(bool))
+	// lowering an approval decreases the cw20 allowance
+	_, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.ApproveMethod, spenderEVMAddr, big.NewInt(20))
+	require.NoError(t, err)
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.AllowanceMethod, ownerEVMAddr, spenderEVMAddr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(20), output[0].(*big.Int))
+
+	_, err = callPointer(t, p, &evm, pointer, spenderEVMAddr, cw20.TransferFromMethod, ownerEVMAddr, receiverEVMAddr, big.NewInt(25))
+	require.Error(t, err)
+	_, err = callPointer(t, p, &evm, pointer, spenderEVMAddr, cw20.TransferFromMethod, ownerEVMAddr, receiverEVMAddr, big.NewInt(15))
+	require.NoError(t, err)
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.BalanceOfMethod, receiverEVMAddr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(25), output[0].(*big.Int))
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, cw20.AllowanceMethod, ownerEVMAddr, spenderEVMAddr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), output[0].(*big.Int))
+
+	// transfers beyond the balance fail
+	_, err = callPointer(t, p, &evm, pointer, receiverEVMAddr, cw20.TransferMethod, ownerEVMAddr, big.NewInt(26))
+	require.Error(t, err)
+}
@@ -0,0 +1,408 @@
+package distribution
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of distribution contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	WithdrawDelegationRewardsMethod = "withdrawDelegationRewards"
+	WithdrawAllRewardsMethod        = "withdrawAllRewards"
+	SetWithdrawAddressMethod        = "setWithdrawAddress"
+	RewardsMethod                   = "rewards"
+	CommunityPoolMethod             = "communityPool"
+)
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type PrecompileExecutor struct {
+	evmKeeper      pcommon.EVMKeeper
+	bankKeeper     pcommon.BankKeeper
+	distrMsgServer pcommon.DistributionMsgServer
+	distrQuerier   pcommon.DistributionQuerier
+}
+
+// NewContract returns a new distribution stateful precompiled contract.
+//
+//	Rewards are withdrawn through the x/distribution msg server for the cosmos address mapped to
+//	the EVM caller, so they are paid to that account (or to its configured withdraw address).
+//	Rewards paid in the cosmos denom backing EVM balances are mirrored in the StateDB.
+//	Transactions cannot be sent through DELEGATECALL, which would let a contract act for its caller.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, distrMsgServer pcommon.DistributionMsgServer, distrQuerier pcommon.DistributionQuerier) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:      evmKeeper,
+		bankKeeper:     bankKeeper,
+		distrMsgServer: distrMsgServer,
+		distrQuerier:   distrQuerier,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[WithdrawDelegationRewardsMethod],
+			executor.withdrawDelegationRewards,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[WithdrawAllRewardsMethod],
+			executor.withdrawAllRewards,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SetWithdrawAddressMethod],
+			executor.setWithdrawAddress,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[RewardsMethod],
+			executor.rewards,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[CommunityPoolMethod],
+			executor.communityPool,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate distribution precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) withdrawDelegationRewards(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error withdrawing delegation rewards using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[WithdrawDelegationRewardsMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call withdrawDelegationRewards from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	withdrawn, err := p.withdrawRewards(ctx, accessibleState.GetStateDB(), delegator, []string{args[0].(string)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(toCoins(withdrawn))
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) withdrawAllRewards(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error withdrawing all rewards using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[WithdrawAllRewardsMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call withdrawAllRewards from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	validators, err := p.distrQuerier.DelegatorValidators(ctx, &distrtypes.QueryDelegatorValidatorsRequest{
+		DelegatorAddress: delegator.String(),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	withdrawn, err := p.withdrawRewards(ctx, accessibleState.GetStateDB(), delegator, validators.Validators)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(toCoins(withdrawn))
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) setWithdrawAddress(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error setting withdraw address using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[SetWithdrawAddressMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call setWithdrawAddress from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	withdrawAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	if _, err := p.distrMsgServer.SetWithdrawAddress(ctx, &distrtypes.MsgSetWithdrawAddress{
+		DelegatorAddress: delegator.String(),
+		WithdrawAddress:  withdrawAddr.String(),
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) rewards(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying rewards using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[RewardsMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	// the query increments the validator period, keep those writes out of a view call
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := p.distrQuerier.DelegationRewards(cacheCtx, &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: args[1].(string),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	// only whole units can be withdrawn, the decimal remainder stays in the pool
+	rewards, _ := res.Rewards.TruncateDecimal()
+	ret, err = method.Outputs.Pack(toCoins(rewards))
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) communityPool(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying community pool using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[CommunityPoolMethod]
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	res, err := p.distrQuerier.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	pool, _ := res.Pool.TruncateDecimal()
+	ret, err = method.Outputs.Pack(toCoins(pool))
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+// withdrawRewards withdraws the rewards of delegator from validators and mirrors the payout to its
+// withdraw address in the StateDB.
+func (p PrecompileExecutor) withdrawRewards(ctx sdk.Context, stateDB contract.StateDB, delegator sdk.AccAddress, validators []string) (sdk.Coins, error) {
+	res, err := p.distrQuerier.DelegatorWithdrawAddress(ctx, &distrtypes.QueryDelegatorWithdrawAddressRequest{
+		DelegatorAddress: delegator.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	withdrawAddr, err := sdk.AccAddressFromBech32(res.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+	recipient := common.BytesToAddress(withdrawAddr)
+	if evmAddress, err := p.evmKeeper.GetEvmAddressMapping(ctx, withdrawAddr); err == nil {
+		recipient = *evmAddress
+	}
+
+	withdrawn := sdk.NewCoins()
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, stateDB, recipient, withdrawAddr, func(ctx sdk.Context) error {
+		for _, validator := range validators {
+			res, err := p.distrMsgServer.WithdrawDelegatorReward(ctx, &distrtypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: delegator.String(),
+				ValidatorAddress: validator,
+			})
+			if err != nil {
+				return err
+			}
+			withdrawn = withdrawn.Add(res.Amount...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return withdrawn, nil
+}
+
+func toCoins(coins sdk.Coins) []Coin {
+	res := make([]Coin, 0, len(coins))
+	for _, coin := range coins {
+		// withdrawals report a zero coin when nothing was paid out
+		if coin.IsZero() {
+			continue
+		}
+		res = append(res, Coin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		})
+	}
+	return res
+}
@@ -0,0 +1,276 @@
+package distribution_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/distribution"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	// Generate a new Sei private key
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+type coin = struct {
+	Amount *big.Int `json:"amount"`
+	Denom  string   `json:"denom"`
+}
+
+func TestWithdrawRewards(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	withdrawAddr, withdrawEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, withdrawAddr, withdrawEVMAddr)
+
+	bondDenom, err := tApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000)))
+	bankKeeper := tApp.GetBankKeeper()
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins.Add(coins...)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, distrtypes.ModuleName, coins))
+
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0]
+	_, err = stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper).Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: mockAddr.String(),
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           coins[0],
+	})
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	require.NoError(t, err)
+	validator, err = tApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, tApp.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, sdkmath.NewInt(1000)))))
+	// rewards are only paid for delegations started in an earlier block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := distribution.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), distrkeeper.NewMsgServerImpl(tApp.DistrKeeper), distrkeeper.NewQuerier(tApp.DistrKeeper))
+	suppliedGas := uint64(10_000_000)
+
+	// pending rewards
+	method := distribution.ABI.Methods[distribution.RewardsMethod]
+	args, err := method.Inputs.Pack(mockEVMAddr, validator.OperatorAddress)
+	require.Nil(t, err)
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	rewards := output[0].([]coin)
+	require.Len(t, rewards, 1)
+	require.Equal(t, bondDenom, rewards[0].Denom)
+	require.True(t, rewards[0].Amount.Sign() > 0)
+
+	// send the rewards to another mapped account
+	method = distribution.ABI.Methods[distribution.SetWithdrawAddressMethod]
+	args, err = method.Inputs.Pack(withdrawEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+
+	method = distribution.ABI.Methods[distribution.WithdrawAllRewardsMethod]
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, method.ID, suppliedGas, false, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	withdrawn := output[0].([]coin)
+	require.Equal(t, rewards, withdrawn)
+	require.Equal(t, sdkmath.NewIntFromBigInt(withdrawn[0].Amount), bankKeeper.GetBalance(ctx, withdrawAddr, bondDenom).Amount)
+
+	// nothing left to withdraw
+	method = distribution.ABI.Methods[distribution.WithdrawDelegationRewardsMethod]
+	args, err = method.Inputs.Pack(validator.OperatorAddress)
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Empty(t, output[0].([]coin))
+}
+
+func TestCommunityPool(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+
+	pool := sdk.NewCoins(sdk.NewCoin("orai", sdkmath.NewInt(500)))
+	bankKeeper := tApp.GetBankKeeper()
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, pool))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, distrtypes.ModuleName, pool))
+	feePool, err := tApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(pool...)...)
+	require.NoError(t, tApp.DistrKeeper.FeePool.Set(ctx, feePool))
+
+	p := distribution.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), distrkeeper.NewMsgServerImpl(tApp.DistrKeeper), distrkeeper.NewQuerier(tApp.DistrKeeper))
+	method := distribution.ABI.Methods[distribution.CommunityPoolMethod]
+	res, _, err := p.Run(&evm, registry.DistributionContractAddress, registry.DistributionContractAddress,
+		method.ID,
+		uint64(10_000_000),
+		true,
+		nil,
+	)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Contains(t, output[0].([]coin), coin{Amount: big.NewInt(500), Denom: "orai"})
+}
+
+func TestWithdrawRewardsToDirtyCaller(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+
+	contractAddr, contractEVMAddr := MockAddressPair()
+	funderAddr, funderEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, contractAddr, contractEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, funderAddr, funderEVMAddr)
+	bondDenom, err := tApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	bankKeeper := tApp.GetBankKeeper()
+	funds := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)), sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000)))
+	for _, addr := range []sdk.AccAddress{contractAddr, funderAddr} {
+		require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, funds))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, funds))
+	}
+	rewards := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, rewards))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, distrtypes.ModuleName, rewards))
+
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0]
+	_, err = stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper).Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: contractAddr.String(),
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000)),
+	})
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	require.NoError(t, err)
+	validator, err = tApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, tApp.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	supply := bankKeeper.GetSupply(ctx, appconfig.CosmosDenom)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	p := distribution.NewContract(tApp.EvmKeeper, bankKeeper, distrkeeper.NewMsgServerImpl(tApp.DistrKeeper), distrkeeper.NewQuerier(tApp.DistrKeeper))
+
+	// the payable contract received msg.value earlier in the call, so its balance is dirty in the StateDB
+	stateDB.SubBalance(funderEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+	stateDB.AddBalance(contractEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+
+	method := distribution.ABI.Methods[distribution.WithdrawAllRewardsMethod]
+	res, _, err := p.Run(&evm, contractEVMAddr, registry.DistributionContractAddress, method.ID, uint64(10_000_000), false, nil)
+	require.NoError(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.NoError(t, err)
+	withdrawn := output[0].([]coin)
+	require.Len(t, withdrawn, 1)
+	require.Equal(t, appconfig.CosmosDenom, withdrawn[0].Denom)
+	paid := sdkmath.NewIntFromBigInt(withdrawn[0].Amount)
+	require.True(t, paid.IsPositive())
+	require.Equal(t, appconfig.ToEvmAmount(paid.AddRaw(1005).BigInt()), stateDB.GetBalance(contractEVMAddr))
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, paid.AddRaw(1005), bankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(995), bankKeeper.GetBalance(ctx, funderAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, supply, bankKeeper.GetSupply(ctx, appconfig.CosmosDenom))
+}
+
+func TestDelegateCallRejected(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+
+	// the precompile runs in a frame entered through DELEGATECALL
+	tracer := pcommon.NewCallFrameTracer(nil, false)
+	tracer.CaptureEnter(vm.DELEGATECALL, common.HexToAddress("0xde1e"), registry.DistributionContractAddress, nil, 0, nil)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		Config:  vm.Config{Debug: true, Tracer: tracer},
+	}
+	p := distribution.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), distrkeeper.NewMsgServerImpl(tApp.DistrKeeper), distrkeeper.NewQuerier(tApp.DistrKeeper))
+	suppliedGas := uint64(10_000_000)
+
+	method := distribution.ABI.Methods[distribution.SetWithdrawAddressMethod]
+	args, err := method.Inputs.Pack(common.HexToAddress("0xde1e"))
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+	withdrawAddr, err := tApp.DistrKeeper.GetDelegatorWithdrawAddr(ctx, mockAddr)
+	require.NoError(t, err)
+	require.Equal(t, mockAddr, withdrawAddr)
+
+	method = distribution.ABI.Methods[distribution.WithdrawDelegationRewardsMethod]
+	args, err = method.Inputs.Pack(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.DistributionContractAddress, distribution.ABI.Methods[distribution.WithdrawAllRewardsMethod].ID, suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+}
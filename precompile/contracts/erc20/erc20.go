@@ -0,0 +1,868 @@
+package erc20
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of erc20 contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	RegisterPointerMethod = "registerPointer"
+	PointerMethod         = "pointer"
+	NameMethod            = "name"
+	SymbolMethod          = "symbol"
+	DecimalsMethod        = "decimals"
+	TotalSupplyMethod     = "totalSupply"
+	BalanceOfMethod       = "balanceOf"
+	AllowanceMethod       = "allowance"
+	ApproveMethod         = "approve"
+	TransferMethod        = "transfer"
+	TransferFromMethod    = "transferFrom"
+
+	TransferEvent          = "Transfer"
+	ApprovalEvent          = "Approval"
+	PointerRegisteredEvent = "PointerRegistered"
+)
+
+// pointerAddressPrefix namespaces the keccak preimage of pointer addresses so they
+// cannot collide with addresses derived for other purposes.
+const pointerAddressPrefix = "erc20-pointer/"
+
+// denomLengthSlot holds the byte length of the denom stored in a pointer account.
+// The denom itself is stored in the following slots, 32 bytes per slot.
+var denomLengthSlot = common.Hash{}
+
+type PrecompileExecutor struct {
+	evmKeeper  pcommon.EVMKeeper
+	bankKeeper pcommon.BankKeeper
+}
+
+// NewContract returns a new erc20 stateful precompiled contract.
+//
+//	Each bank denom can get a pointer contract at a deterministic address (see PointerAddress).
+//	The pointer is a minimal proxy that forwards its calldata, with the original msg.sender appended,
+//	to this precompile, which serves the ERC20 interface on top of x/bank. Allowances are kept in the
+//	pointer's EVM storage, balances and supply are read from and written to BankKeeper, except the
+//	balances of the cosmos denom backing EVM balances, which are kept in the StateDB.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:  evmKeeper,
+		bankKeeper: bankKeeper,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[RegisterPointerMethod],
+			executor.registerPointer,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[PointerMethod],
+			executor.pointer,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[NameMethod],
+			executor.name,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SymbolMethod],
+			executor.symbol,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DecimalsMethod],
+			executor.decimals,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TotalSupplyMethod],
+			executor.totalSupply,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[BalanceOfMethod],
+			executor.balanceOf,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[AllowanceMethod],
+			executor.allowance,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ApproveMethod],
+			executor.approve,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TransferMethod],
+			executor.transfer,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TransferFromMethod],
+			executor.transferFrom,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate erc20 precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+// PointerAddress returns the deterministic EVM address of the pointer contract for denom.
+func PointerAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(pointerAddressPrefix), []byte(denom))[12:])
+}
+
+// PointerCode returns the runtime bytecode deployed at every pointer address. It copies the
+// calldata to memory, appends CALLER as a 32-byte word, CALLs precompile with the received value
+// and all remaining gas, then returns or reverts with whatever the precompile returned.
+func PointerCode(precompile common.Address) []byte {
+	code := []byte{
+		0x36,       // CALLDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0x60, 0x00, // PUSH1 0
+		0x37,       // CALLDATACOPY
+		0x33,       // CALLER
+		0x36,       // CALLDATASIZE
+		0x52,       // MSTORE
+		0x60, 0x00, // PUSH1 0 (retSize)
+		0x60, 0x00, // PUSH1 0 (retOffset)
+		0x60, 0x20, // PUSH1 32
+		0x36,       // CALLDATASIZE
+		0x01,       // ADD (argsSize)
+		0x60, 0x00, // PUSH1 0 (argsOffset)
+		0x34, // CALLVALUE
+		0x73, // PUSH20 precompile
+	}
+	code = append(code, precompile.Bytes()...)
+	code = append(code,
+		0x5a,       // GAS
+		0xf1,       // CALL
+		0x3d,       // RETURNDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0x60, 0x00, // PUSH1 0
+		0x3e,       // RETURNDATACOPY
+		0x60, 0x38, // PUSH1 success
+		0x57,       // JUMPI
+		0x3d,       // RETURNDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0xfd,       // REVERT
+		0x5b,       // JUMPDEST success
+		0x3d,       // RETURNDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	)
+	return code
+}
+
+func (p PrecompileExecutor) registerPointer(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error registering erc20 pointer using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[RegisterPointerMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call registerPointer from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		rerr = err
+		return
+	}
+	// the EVM denom is already native to the EVM. The pointer of the cosmos denom backing it
+	// moves balances in the StateDB, see send.
+	if denom == appconfig.EvmDenom {
+		rerr = fmt.Errorf("cannot register a pointer for the evm denom %s", denom)
+		return
+	}
+	if _, found := p.bankKeeper.GetDenomMetaData(ctx, denom); !found && !p.bankKeeper.GetSupply(ctx, denom).IsPositive() {
+		rerr = fmt.Errorf("denom %s does not exist", denom)
+		return
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	pointer := PointerAddress(denom)
+	if stateDB.GetCodeSize(pointer) != 0 {
+		rerr = fmt.Errorf("pointer for denom %s already exists at %s", denom, pointer.Hex())
+		return
+	}
+
+	if !stateDB.Exist(pointer) {
+		stateDB.CreateAccount(pointer)
+	}
+	stateDB.SetNonce(pointer, 1)
+	stateDB.SetCode(pointer, PointerCode(addr))
+	slots := setDenom(stateDB, pointer, denom)
+
+	data, err := ABI.Events[PointerRegisteredEvent].Inputs.NonIndexed().Pack(denom)
+	if err != nil {
+		rerr = err
+		return
+	}
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     addr,
+		Topics:      []common.Hash{ABI.Events[PointerRegisteredEvent].ID, common.BytesToHash(pointer.Bytes())},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	// the pointer code and denom are written to EVM storage, which the cosmos meter does not see
+	ctx.GasMeter().ConsumeGas(uint64(slots)*contract.WriteGasCostPerSlot, "erc20 pointer storage")
+
+	ret, err = method.Outputs.Pack(pointer)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) pointer(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 pointer using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[PointerMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	denom := args[0].(string)
+	pointer := PointerAddress(denom)
+	exists := bytes.Equal(accessibleState.GetStateDB().GetCode(pointer), PointerCode(addr))
+
+	ret, err = method.Outputs.Pack(pointer, exists)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) name(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 name using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[NameMethod]
+
+	denom, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	name := denom
+	if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found && metadata.Name != "" {
+		name = metadata.Name
+	}
+
+	ret, err = method.Outputs.Pack(name)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) symbol(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 symbol using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[SymbolMethod]
+
+	denom, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	symbol := denom
+	if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found && metadata.Symbol != "" {
+		symbol = metadata.Symbol
+	}
+
+	ret, err = method.Outputs.Pack(symbol)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) decimals(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 decimals using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DecimalsMethod]
+
+	denom, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	// balances are reported in base units, so decimals is the exponent of the display unit
+	decimals := uint8(0)
+	if metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display && unit.Exponent <= math.MaxUint8 {
+				decimals = uint8(unit.Exponent)
+			}
+		}
+	}
+
+	ret, err = method.Outputs.Pack(decimals)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) totalSupply(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 totalSupply using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TotalSupplyMethod]
+
+	denom, _, _, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	coin := p.bankKeeper.GetSupply(ctx, denom)
+	ret, err = method.Outputs.Pack(coin.Amount.BigInt())
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) balanceOf(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 balanceOf using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[BalanceOfMethod]
+
+	denom, _, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(p.balance(ctx, accessibleState.GetStateDB(), args[0].(common.Address), denom))
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) allowance(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying erc20 allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[AllowanceMethod]
+
+	_, _, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	owner := args[0].(common.Address)
+	spender := args[1].(common.Address)
+	amount := getAllowance(accessibleState.GetStateDB(), caller, owner, spender)
+
+	ret, err = method.Outputs.Pack(amount)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) approve(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error approving erc20 allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ApproveMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call approve from staticcall")
+		return
+	}
+
+	_, owner, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	spender := args[0].(common.Address)
+	amount := args[1].(*big.Int)
+	if spender == (common.Address{}) {
+		rerr = errors.New("cannot approve the zero address")
+		return
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	setAllowance(stateDB, caller, owner, spender, amount)
+	if err := emitLog(ctx, stateDB, caller, ApprovalEvent, owner, spender, amount); err != nil {
+		rerr = err
+		return
+	}
+
+	ctx.GasMeter().ConsumeGas(contract.WriteGasCostPerSlot, "erc20 allowance storage")
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) transfer(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error transferring erc20 using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TransferMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call transfer from staticcall")
+		return
+	}
+
+	denom, sender, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	to := args[0].(common.Address)
+	amount := args[1].(*big.Int)
+	if err := p.send(ctx, accessibleState.GetStateDB(), caller, denom, sender, to, amount); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) transferFrom(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error transferring erc20 from allowance using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TransferFromMethod]
+
+	if readOnly {
+		rerr = errors.New("cannot call transferFrom from staticcall")
+		return
+	}
+
+	denom, spender, input, err := resolvePointerCall(accessibleState, caller, addr, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	from := args[0].(common.Address)
+	to := args[1].(common.Address)
+	amount := args[2].(*big.Int)
+
+	stateDB := accessibleState.GetStateDB()
+	allowed := getAllowance(stateDB, caller, from, spender)
+	// an allowance of max uint256 is treated as infinite and never decreases
+	if allowed.Cmp(math.MaxBig256) != 0 {
+		if allowed.Cmp(amount) < 0 {
+			rerr = fmt.Errorf("insufficient allowance: %s < %s", allowed, amount)
+			return
+		}
+		setAllowance(stateDB, caller, from, spender, new(big.Int).Sub(allowed, amount))
+	}
+
+	if err := p.send(ctx, stateDB, caller, denom, from, to, amount); err != nil {
+		rerr = err
+		return
+	}
+
+	ctx.GasMeter().ConsumeGas(contract.WriteGasCostPerSlot, "erc20 allowance storage")
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+// balance returns the balance of denom of account. The cosmos denom backing the EVM balance is
+// read from the StateDB for the same reason send moves it there.
+func (p PrecompileExecutor) balance(ctx sdk.Context, stateDB contract.StateDB, account common.Address, denom string) *big.Int {
+	if denom == appconfig.CosmosDenom {
+		balance, _ := appconfig.ToCosmosAmount(stateDB.GetBalance(account))
+		return balance
+	}
+	cosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, account)
+	return p.bankKeeper.GetBalance(ctx, cosmosAddr, denom).Amount.BigInt()
+}
+
+// send moves amount of denom between the cosmos accounts mapped to from and to, and emits
+// the ERC20 Transfer log on the pointer. The cosmos denom backing the EVM balance is moved in
+// the StateDB rather than the bank: the StateDB caches the balances of the accounts the
+// transaction touched and would overwrite a bank transfer of them when it commits.
+func (p PrecompileExecutor) send(ctx sdk.Context, stateDB contract.StateDB, pointer common.Address, denom string, from, to common.Address, amount *big.Int) error {
+	if to == (common.Address{}) {
+		return errors.New("cannot transfer to the zero address")
+	}
+
+	if amount.Sign() > 0 && denom == appconfig.CosmosDenom {
+		evmAmount := appconfig.ToEvmAmount(amount)
+		if balance := stateDB.GetBalance(from); balance.Cmp(evmAmount) < 0 {
+			return fmt.Errorf("insufficient funds: %s%s is smaller than %s%s", balance, appconfig.EvmDenom, evmAmount, appconfig.EvmDenom)
+		}
+		stateDB.SubBalance(from, evmAmount)
+		stateDB.AddBalance(to, evmAmount)
+	} else if amount.Sign() > 0 {
+		fromCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, from)
+		toCosmosAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, to)
+		if err := p.bankKeeper.SendCoins(ctx, fromCosmosAddr, toCosmosAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))); err != nil {
+			return err
+		}
+	}
+
+	return emitLog(ctx, stateDB, pointer, TransferEvent, from, to, amount)
+}
+
+// resolvePointerCall checks that caller is a pointer deployed by this precompile and splits the
+// forwarded input into the pointer's denom, the original msg.sender and the ABI encoded arguments.
+func resolvePointerCall(accessibleState contract.AccessibleState, caller common.Address, addr common.Address, packedInput []byte, value *big.Int) (string, common.Address, []byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return "", common.Address{}, nil, err
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	if !bytes.Equal(stateDB.GetCode(caller), PointerCode(addr)) {
+		return "", common.Address{}, nil, errors.New("erc20 methods must be called through a pointer contract")
+	}
+
+	denom := getDenom(stateDB, caller)
+	if PointerAddress(denom) != caller {
+		return "", common.Address{}, nil, fmt.Errorf("pointer %s does not match its denom %s", caller.Hex(), denom)
+	}
+
+	if len(packedInput) < common.HashLength {
+		return "", common.Address{}, nil, errors.New("missing pointer caller")
+	}
+	split := len(packedInput) - common.HashLength
+	sender := common.BytesToAddress(packedInput[split:])
+
+	return denom, sender, packedInput[:split], nil
+}
+
+func emitLog(ctx sdk.Context, stateDB contract.StateDB, pointer common.Address, eventName string, from, to common.Address, amount *big.Int) error {
+	event := ABI.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     pointer,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func allowanceSlot(owner, spender common.Address) common.Hash {
+	return crypto.Keccak256Hash(owner.Bytes(), spender.Bytes())
+}
+
+func getAllowance(stateDB contract.StateDB, pointer, owner, spender common.Address) *big.Int {
+	return stateDB.GetState(pointer, allowanceSlot(owner, spender)).Big()
+}
+
+func setAllowance(stateDB contract.StateDB, pointer, owner, spender common.Address, amount *big.Int) {
+	stateDB.SetState(pointer, allowanceSlot(owner, spender), common.BigToHash(amount))
+}
+
+// setDenom writes denom into the storage of pointer and returns the number of slots written.
+func setDenom(stateDB contract.StateDB, pointer common.Address, denom string) int {
+	stateDB.SetState(pointer, denomLengthSlot, common.BigToHash(big.NewInt(int64(len(denom)))))
+
+	slots := 1
+	for offset := 0; offset < len(denom); offset += common.HashLength {
+		end := offset + common.HashLength
+		if end > len(denom) {
+			end = len(denom)
+		}
+		stateDB.SetState(pointer, denomSlot(slots), common.BytesToHash(common.RightPadBytes([]byte(denom[offset:end]), common.HashLength)))
+		slots++
+	}
+	return slots
+}
+
+func getDenom(stateDB contract.StateDB, pointer common.Address) string {
+	length := stateDB.GetState(pointer, denomLengthSlot).Big()
+	// denoms are at most 128 bytes (sdk.ValidateDenom), anything larger is not a pointer we wrote
+	if !length.IsUint64() || length.Uint64() > 128 {
+		return ""
+	}
+
+	denom := make([]byte, 0, length.Uint64())
+	for slot := 1; uint64(len(denom)) < length.Uint64(); slot++ {
+		denom = append(denom, stateDB.GetState(pointer, denomSlot(slot)).Bytes()...)
+	}
+	return string(denom[:length.Uint64()])
+}
+
+func denomSlot(index int) common.Hash {
+	return common.BigToHash(big.NewInt(int64(index)))
+}
@@ -0,0 +1,289 @@
+package erc20_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	// Generate a new Sei private key
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// setEvmDenom aligns the evm params with the denom served by EvmBankKeeper so the StateDB can
+// load accounts that hold a balance.
+func setEvmDenom(t *testing.T, tApp *app.WasmApp, ctx sdk.Context) {
+	params := tApp.EvmKeeper.GetParams(ctx)
+	params.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, params))
+}
+
+// callPointer runs method on the precompile the same way the pointer bytecode does: with the
+// pointer as caller and the original sender appended to the calldata.
+func callPointer(t *testing.T, p contract.StatefulPrecompiledContract, evm *vm.EVM, pointer common.Address, sender common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	m := erc20.ABI.Methods[method]
+	packed, err := m.Inputs.Pack(args...)
+	require.NoError(t, err)
+	input := append(append(m.ID, packed...), common.LeftPadBytes(sender.Bytes(), 32)...)
+	res, _, err := p.Run(evm, pointer, registry.Erc20ContractAddress, input, uint64(10_000_000), false, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.Outputs.Unpack(res)
+}
+
+func TestPointerCode(t *testing.T) {
+	code := erc20.PointerCode(registry.Erc20ContractAddress)
+	require.Len(t, code, 61)
+	// the success branch of JUMPI must land on a JUMPDEST
+	require.Equal(t, byte(vm.JUMPDEST), code[0x38])
+	require.Equal(t, registry.Erc20ContractAddress.Bytes(), code[21:41])
+}
+
+func TestRegisterPointer(t *testing.T) {
+	denom := "factory/orai1creator/token"
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	setEvmDenom(t, tApp, ctx)
+	bankKeeper := tApp.GetBankKeeper()
+	tApp.GetBankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    denom,
+		Display: "token",
+		Name:    "Factory Token",
+		Symbol:  "TKN",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+	})
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := erc20.NewContract(tApp.EvmKeeper, bankKeeper)
+	method := erc20.ABI.Methods[erc20.RegisterPointerMethod]
+	suppliedGas := uint64(10_000_000)
+	_, caller := MockAddressPair()
+
+	args, err := method.Inputs.Pack(denom)
+	require.Nil(t, err)
+	res, _, err := p.Run(&evm, caller, registry.Erc20ContractAddress,
+		append(method.ID, args...),
+		suppliedGas,
+		false,
+		nil,
+	)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	pointer := output[0].(common.Address)
+	require.Equal(t, erc20.PointerAddress(denom), pointer)
+	require.Equal(t, erc20.PointerCode(registry.Erc20ContractAddress), evm.StateDB.GetCode(pointer))
+
+	// registering twice fails
+	_, _, err = p.Run(&evm, caller, registry.Erc20ContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Error(t, err)
+
+	// unknown denoms cannot get a pointer
+	args, err = method.Inputs.Pack("unknown")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, caller, registry.Erc20ContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Error(t, err)
+
+	lookup := erc20.ABI.Methods[erc20.PointerMethod]
+	args, err = lookup.Inputs.Pack(denom)
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, caller, registry.Erc20ContractAddress, append(lookup.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err = lookup.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Equal(t, pointer, output[0].(common.Address))
+	require.True(t, output[1].(bool))
+
+	output, err = callPointer(t, p, &evm, pointer, caller, erc20.NameMethod)
+	require.Nil(t, err)
+	require.Equal(t, "Factory Token", output[0].(string))
+	output, err = callPointer(t, p, &evm, pointer, caller, erc20.SymbolMethod)
+	require.Nil(t, err)
+	require.Equal(t, "TKN", output[0].(string))
+	output, err = callPointer(t, p, &evm, pointer, caller, erc20.DecimalsMethod)
+	require.Nil(t, err)
+	require.Equal(t, uint8(6), output[0].(uint8))
+}
+
+func TestTransferAndAllowance(t *testing.T) {
+	denom := "ukava"
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	setEvmDenom(t, tApp, ctx)
+	ownerAddr, ownerEVMAddr := MockAddressPair()
+	spenderAddr, spenderEVMAddr := MockAddressPair()
+	receiverAddr, receiverEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, ownerAddr, ownerEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, spenderAddr, spenderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddr, receiverEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	mintCoins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, mintCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, ownerAddr, mintCoins))
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	p := erc20.NewContract(tApp.EvmKeeper, bankKeeper)
+	method := erc20.ABI.Methods[erc20.RegisterPointerMethod]
+	args, err := method.Inputs.Pack(denom)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, ownerEVMAddr, registry.Erc20ContractAddress, append(method.ID, args...), uint64(10_000_000), false, nil)
+	require.Nil(t, err)
+	pointer := erc20.PointerAddress(denom)
+
+	// calling erc20 methods directly, without a pointer, is rejected
+	balanceOf := erc20.ABI.Methods[erc20.BalanceOfMethod]
+	args, err = balanceOf.Inputs.Pack(ownerEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, ownerEVMAddr, registry.Erc20ContractAddress, append(balanceOf.ID, args...), uint64(10_000_000), true, nil)
+	require.ErrorContains(t, err, "pointer")
+
+	output, err := callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.TotalSupplyMethod)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(100), output[0].(*big.Int))
+
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.TransferMethod, receiverEVMAddr, big.NewInt(10))
+	require.Nil(t, err)
+	require.True(t, output[0].(bool))
+	require.Equal(t, sdkmath.NewInt(90), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	require.Equal(t, sdkmath.NewInt(10), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.BalanceOfMethod, receiverEVMAddr)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), output[0].(*big.Int))
+
+	// spending without an allowance fails
+	_, err = callPointer(t, p, &evm, pointer, spenderEVMAddr, erc20.TransferFromMethod, ownerEVMAddr, receiverEVMAddr, big.NewInt(1))
+	require.ErrorContains(t, err, "insufficient allowance")
+
+	_, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.ApproveMethod, spenderEVMAddr, big.NewInt(30))
+	require.Nil(t, err)
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.AllowanceMethod, ownerEVMAddr, spenderEVMAddr)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(30), output[0].(*big.Int))
+
+	_, err = callPointer(t, p, &evm, pointer, spenderEVMAddr, erc20.TransferFromMethod, ownerEVMAddr, receiverEVMAddr, big.NewInt(20))
+	require.Nil(t, err)
+	require.Equal(t, sdkmath.NewInt(70), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	require.Equal(t, sdkmath.NewInt(30), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+
+	output, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.AllowanceMethod, ownerEVMAddr, spenderEVMAddr)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), output[0].(*big.Int))
+
+	// PointerRegistered, Transfer, Approval, Transfer
+	logs := stateDB.Logs()
+	require.Len(t, logs, 4)
+	transfer := logs[3]
+	require.Equal(t, pointer, transfer.Address)
+	require.Equal(t, erc20.ABI.Events[erc20.TransferEvent].ID, transfer.Topics[0])
+	require.Equal(t, common.BytesToHash(ownerEVMAddr.Bytes()), transfer.Topics[1])
+	require.Equal(t, common.BytesToHash(receiverEVMAddr.Bytes()), transfer.Topics[2])
+	require.Equal(t, common.BigToHash(big.NewInt(20)).Bytes(), transfer.Data)
+	require.Equal(t, erc20.ABI.Events[erc20.ApprovalEvent].ID, logs[2].Topics[0])
+}
+
+func TestNativeDenomPointer(t *testing.T) {
+	denom := appconfig.CosmosDenom
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	setEvmDenom(t, tApp, ctx)
+	ownerAddr, ownerEVMAddr := MockAddressPair()
+	funderAddr, funderEVMAddr := MockAddressPair()
+	receiverAddr, receiverEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, ownerAddr, ownerEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, funderAddr, funderEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, receiverAddr, receiverEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	for _, addr := range []sdk.AccAddress{ownerAddr, funderAddr} {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+		require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, coins))
+	}
+	supply := bankKeeper.GetSupply(ctx, denom)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	p := erc20.NewContract(tApp.EvmKeeper, bankKeeper)
+
+	// the evm denom cannot get a pointer, the cosmos denom backing it can
+	method := erc20.ABI.Methods[erc20.RegisterPointerMethod]
+	args, err := method.Inputs.Pack(appconfig.EvmDenom)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, ownerEVMAddr, registry.Erc20ContractAddress, append(method.ID, args...), uint64(10_000_000), false, nil)
+	require.ErrorContains(t, err, "evm denom")
+	args, err = method.Inputs.Pack(denom)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, ownerEVMAddr, registry.Erc20ContractAddress, append(method.ID, args...), uint64(10_000_000), false, nil)
+	require.Nil(t, err)
+	pointer := erc20.PointerAddress(denom)
+
+	// the owner is a payable contract that received value earlier in the transaction, so its
+	// balance is dirty in the StateDB
+	stateDB.SubBalance(funderEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+	stateDB.AddBalance(ownerEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+
+	_, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.TransferMethod, receiverEVMAddr, big.NewInt(106))
+	require.ErrorContains(t, err, "insufficient funds")
+	_, err = callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.TransferMethod, receiverEVMAddr, big.NewInt(30))
+	require.Nil(t, err)
+	output, err := callPointer(t, p, &evm, pointer, ownerEVMAddr, erc20.BalanceOfMethod, ownerEVMAddr)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(75), output[0].(*big.Int))
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, sdkmath.NewInt(75), bankKeeper.GetBalance(ctx, ownerAddr, denom).Amount)
+	require.Equal(t, sdkmath.NewInt(95), bankKeeper.GetBalance(ctx, funderAddr, denom).Amount)
+	require.Equal(t, sdkmath.NewInt(30), bankKeeper.GetBalance(ctx, receiverAddr, denom).Amount)
+	require.Equal(t, supply, bankKeeper.GetSupply(ctx, denom))
+}
@@ -0,0 +1,539 @@
+package gov
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of gov contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	VoteMethod         = "vote"
+	VoteWeightedMethod = "voteWeighted"
+	DepositMethod      = "deposit"
+	ProposalMethod     = "proposal"
+	TallyMethod        = "tally"
+	ParamsMethod       = "params"
+)
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type WeightedVoteOption struct {
+	Option int32
+	Weight string
+}
+
+type Proposal struct {
+	Id              uint64
+	Status          int32
+	Title           string
+	Summary         string
+	Metadata        string
+	Proposer        string
+	SubmitTime      int64
+	DepositEndTime  int64
+	VotingStartTime int64
+	VotingEndTime   int64
+	TotalDeposit    []Coin
+	Expedited       bool
+}
+
+type TallyResult struct {
+	Yes        *big.Int
+	Abstain    *big.Int
+	No         *big.Int
+	NoWithVeto *big.Int
+}
+
+type Params struct {
+	MinDeposit       []Coin
+	MaxDepositPeriod int64
+	VotingPeriod     int64
+	Quorum           string
+	Threshold        string
+	VetoThreshold    string
+}
+
+type PrecompileExecutor struct {
+	evmKeeper    pcommon.EVMKeeper
+	bankKeeper   pcommon.BankKeeper
+	govMsgServer pcommon.GovMsgServer
+	govQuerier   pcommon.GovQuerier
+}
+
+// NewContract returns a new gov stateful precompiled contract.
+//
+//	Votes and deposits are cast through the x/gov msg server by the cosmos address mapped to the
+//	EVM caller, the same mapping used by the bank precompile. Deposits of the cosmos denom backing
+//	EVM balances are mirrored in the StateDB. Transactions cannot be sent through DELEGATECALL,
+//	which would let a contract act for its caller.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, govMsgServer pcommon.GovMsgServer, govQuerier pcommon.GovQuerier) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:    evmKeeper,
+		bankKeeper:   bankKeeper,
+		govMsgServer: govMsgServer,
+		govQuerier:   govQuerier,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[VoteMethod],
+			executor.vote,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[VoteWeightedMethod],
+			executor.voteWeighted,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DepositMethod],
+			executor.deposit,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ProposalMethod],
+			executor.proposal,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TallyMethod],
+			executor.tally,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ParamsMethod],
+			executor.params,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate gov precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) vote(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error voting using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[VoteMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call vote from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	voter := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	if _, err := p.govMsgServer.Vote(ctx, &govv1.MsgVote{
+		ProposalId: args[0].(uint64),
+		Voter:      voter.String(),
+		Option:     govv1.VoteOption(args[1].(int32)),
+		Metadata:   args[2].(string),
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) voteWeighted(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error weighted voting using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[VoteWeightedMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call voteWeighted from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	weightedOptions := args[1].([]struct {
+		Option int32  `json:"option"`
+		Weight string `json:"weight"`
+	})
+	options := make([]*govv1.WeightedVoteOption, 0, len(weightedOptions))
+	for _, option := range weightedOptions {
+		options = append(options, &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(option.Option),
+			Weight: option.Weight,
+		})
+	}
+
+	voter := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	if _, err := p.govMsgServer.VoteWeighted(ctx, &govv1.MsgVoteWeighted{
+		ProposalId: args[0].(uint64),
+		Voter:      voter.String(),
+		Options:    options,
+		Metadata:   args[2].(string),
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) deposit(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error depositing using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DepositMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call deposit from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	amount := sdk.NewCoins()
+	for _, coin := range args[1].([]struct {
+		Amount *big.Int `json:"amount"`
+		Denom  string   `json:"denom"`
+	}) {
+		amount = amount.Add(sdk.NewCoin(coin.Denom, sdkmath.NewIntFromBigInt(coin.Amount)))
+	}
+
+	depositor := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, accessibleState.GetStateDB(), caller, depositor, func(ctx sdk.Context) error {
+		_, err := p.govMsgServer.Deposit(ctx, &govv1.MsgDeposit{
+			ProposalId: args[0].(uint64),
+			Depositor:  depositor.String(),
+			Amount:     amount,
+		})
+		return err
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) proposal(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying proposal using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ProposalMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	res, err := p.govQuerier.Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	proposal := res.Proposal
+	ret, err = method.Outputs.Pack(Proposal{
+		Id:              proposal.Id,
+		Status:          int32(proposal.Status),
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		Metadata:        proposal.Metadata,
+		Proposer:        proposal.Proposer,
+		SubmitTime:      unixTime(proposal.SubmitTime),
+		DepositEndTime:  unixTime(proposal.DepositEndTime),
+		VotingStartTime: unixTime(proposal.VotingStartTime),
+		VotingEndTime:   unixTime(proposal.VotingEndTime),
+		TotalDeposit:    toCoins(proposal.TotalDeposit),
+		Expedited:       proposal.Expedited,
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) tally(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying tally using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TallyMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	// tallying an active proposal removes its votes, so it must never touch the real store
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := p.govQuerier.TallyResult(cacheCtx, &govv1.QueryTallyResultRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	tally, err := toTallyResult(res.Tally)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(tally)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) params(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying gov params using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ParamsMethod]
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	res, err := p.govQuerier.Params(ctx, &govv1.QueryParamsRequest{})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	params := res.Params
+	ret, err = method.Outputs.Pack(Params{
+		MinDeposit:       toCoins(params.MinDeposit),
+		MaxDepositPeriod: durationSeconds(params.MaxDepositPeriod),
+		VotingPeriod:     durationSeconds(params.VotingPeriod),
+		Quorum:           params.Quorum,
+		Threshold:        params.Threshold,
+		VetoThreshold:    params.VetoThreshold,
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func toCoins(coins sdk.Coins) []Coin {
+	res := make([]Coin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, Coin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		})
+	}
+	return res
+}
+
+func toTallyResult(tally *govv1.TallyResult) (TallyResult, error) {
+	counts := make([]*big.Int, 0, 4)
+	for _, count := range []string{tally.YesCount, tally.AbstainCount, tally.NoCount, tally.NoWithVetoCount} {
+		amount, ok := sdkmath.NewIntFromString(count)
+		if !ok {
+			return TallyResult{}, fmt.Errorf("invalid tally count %s", count)
+		}
+		counts = append(counts, amount.BigInt())
+	}
+	return TallyResult{Yes: counts[0], Abstain: counts[1], No: counts[2], NoWithVeto: counts[3]}, nil
+}
+
+func unixTime(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+func durationSeconds(d *time.Duration) int64 {
+	if d == nil {
+		return 0
+	}
+	return int64(d.Seconds())
+}
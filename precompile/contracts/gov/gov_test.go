@@ -0,0 +1,273 @@
+package gov_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/gov"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	// Generate a new Sei private key
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// convert copies an unpacked abi tuple into out. abi.ConvertType cannot handle the nested
+// tuple arrays of proposals and params, so this goes through their shared json field names.
+func convert(t *testing.T, in interface{}, out interface{}) {
+	bz, err := json.Marshal(in)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, out))
+}
+
+func TestVoteDepositAndQueries(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+
+	params, err := tApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	depositCoins := sdk.NewCoins(params.MinDeposit[0])
+	bankKeeper := tApp.GetBankKeeper()
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, depositCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, depositCoins))
+
+	proposal, err := tApp.GovKeeper.SubmitProposal(ctx, nil, "", "Text proposal", "a proposal from the evm", mockAddr, false)
+	require.NoError(t, err)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := gov.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), govkeeper.NewMsgServerImpl(&tApp.GovKeeper), govkeeper.NewQueryServer(&tApp.GovKeeper))
+	suppliedGas := uint64(10_000_000)
+
+	// depositing the minimum deposit opens the voting period
+	deposit := []gov.Coin{{Amount: depositCoins[0].Amount.BigInt(), Denom: depositCoins[0].Denom}}
+	method := gov.ABI.Methods[gov.DepositMethod]
+	args, err := method.Inputs.Pack(proposal.Id, deposit)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	require.True(t, bankKeeper.GetBalance(ctx, mockAddr, depositCoins[0].Denom).IsZero())
+
+	// the proposal query reflects the deposit
+	method = gov.ABI.Methods[gov.ProposalMethod]
+	args, err = method.Inputs.Pack(proposal.Id)
+	require.Nil(t, err)
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	var queried gov.Proposal
+	convert(t, output[0], &queried)
+	require.Equal(t, proposal.Id, queried.Id)
+	require.Equal(t, "Text proposal", queried.Title)
+	require.Equal(t, deposit, queried.TotalDeposit)
+	require.Equal(t, int32(govv1.StatusVotingPeriod), queried.Status)
+
+	// vote, then change it to a weighted vote
+	method = gov.ABI.Methods[gov.VoteMethod]
+	args, err = method.Inputs.Pack(proposal.Id, int32(govv1.OptionYes), "")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+
+	method = gov.ABI.Methods[gov.VoteWeightedMethod]
+	args, err = method.Inputs.Pack(proposal.Id, []gov.WeightedVoteOption{
+		{Option: int32(govv1.OptionYes), Weight: "0.7"},
+		{Option: int32(govv1.OptionNo), Weight: "0.3"},
+	}, "")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	vote, err := tApp.GovKeeper.Votes.Get(ctx, collections.Join(proposal.Id, mockAddr))
+	require.NoError(t, err)
+	require.Len(t, vote.Options, 2)
+
+	// querying the tally must not consume the votes
+	method = gov.ABI.Methods[gov.TallyMethod]
+	args, err = method.Inputs.Pack(proposal.Id)
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	var tally gov.TallyResult
+	convert(t, output[0], &tally)
+	require.Equal(t, int64(0), tally.Yes.Int64())
+	_, err = tApp.GovKeeper.Votes.Get(ctx, collections.Join(proposal.Id, mockAddr))
+	require.NoError(t, err)
+}
+
+func TestParams(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := gov.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), govkeeper.NewMsgServerImpl(&tApp.GovKeeper), govkeeper.NewQueryServer(&tApp.GovKeeper))
+	method := gov.ABI.Methods[gov.ParamsMethod]
+
+	res, _, err := p.Run(&evm, registry.GovContractAddress, registry.GovContractAddress,
+		method.ID,
+		uint64(10_000_000),
+		true,
+		nil,
+	)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	var params gov.Params
+	convert(t, output[0], &params)
+
+	expected, err := tApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected.Quorum, params.Quorum)
+	require.Equal(t, int64(expected.VotingPeriod.Seconds()), params.VotingPeriod)
+	require.Equal(t, expected.MinDeposit[0].Amount.BigInt(), params.MinDeposit[0].Amount)
+}
+
+func TestDepositNativeDenomFromDirtyCaller(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	params, err := tApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinDeposit = sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(100)))
+	require.NoError(t, tApp.GovKeeper.Params.Set(ctx, params))
+
+	contractAddr, contractEVMAddr := MockAddressPair()
+	funderAddr, funderEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, contractAddr, contractEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, funderAddr, funderEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	for _, addr := range []sdk.AccAddress{contractAddr, funderAddr} {
+		coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+		require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, coins))
+	}
+	supply := bankKeeper.GetSupply(ctx, appconfig.CosmosDenom)
+	proposal, err := tApp.GovKeeper.SubmitProposal(ctx, nil, "", "Text proposal", "a proposal from the evm", contractAddr, false)
+	require.NoError(t, err)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	p := gov.NewContract(tApp.EvmKeeper, bankKeeper, govkeeper.NewMsgServerImpl(&tApp.GovKeeper), govkeeper.NewQueryServer(&tApp.GovKeeper))
+	method := gov.ABI.Methods[gov.DepositMethod]
+	suppliedGas := uint64(10_000_000)
+
+	// the payable contract received value earlier in the transaction, so its balance is dirty in the StateDB
+	stateDB.SubBalance(funderEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+	stateDB.AddBalance(contractEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+
+	args, err := method.Inputs.Pack(proposal.Id, []gov.Coin{{Amount: big.NewInt(100), Denom: appconfig.CosmosDenom}})
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, appconfig.ToEvmAmount(big.NewInt(905)), stateDB.GetBalance(contractEVMAddr))
+
+	// the EVM balance bounds the deposit
+	args, err = method.Inputs.Pack(proposal.Id, []gov.Coin{{Amount: big.NewInt(906), Denom: appconfig.CosmosDenom}})
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "insufficient funds")
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, sdkmath.NewInt(905), bankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(995), bankKeeper.GetBalance(ctx, funderAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, supply, bankKeeper.GetSupply(ctx, appconfig.CosmosDenom))
+}
+
+func TestDelegateCallRejected(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	params, err := tApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	bankKeeper := tApp.GetBankKeeper()
+	depositCoins := sdk.NewCoins(params.MinDeposit[0])
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, depositCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, depositCoins))
+	proposal, err := tApp.GovKeeper.SubmitProposal(ctx, nil, "", "Text proposal", "a proposal from the evm", mockAddr, false)
+	require.NoError(t, err)
+
+	// the precompile runs in a frame entered through DELEGATECALL
+	tracer := pcommon.NewCallFrameTracer(nil, false)
+	tracer.CaptureEnter(vm.DELEGATECALL, common.HexToAddress("0xde1e"), registry.GovContractAddress, nil, 0, nil)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		Config:  vm.Config{Debug: true, Tracer: tracer},
+	}
+	p := gov.NewContract(tApp.EvmKeeper, bankKeeper, govkeeper.NewMsgServerImpl(&tApp.GovKeeper), govkeeper.NewQueryServer(&tApp.GovKeeper))
+	suppliedGas := uint64(10_000_000)
+
+	method := gov.ABI.Methods[gov.DepositMethod]
+	args, err := method.Inputs.Pack(proposal.Id, []gov.Coin{{Amount: depositCoins[0].Amount.BigInt(), Denom: depositCoins[0].Denom}})
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+	require.Equal(t, depositCoins, bankKeeper.GetAllBalances(ctx, mockAddr))
+
+	method = gov.ABI.Methods[gov.VoteMethod]
+	args, err = method.Inputs.Pack(proposal.Id, int32(govv1.OptionYes), "")
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+
+	method = gov.ABI.Methods[gov.VoteWeightedMethod]
+	args, err = method.Inputs.Pack(proposal.Id, []gov.WeightedVoteOption{{Option: int32(govv1.OptionYes), Weight: "1"}}, "")
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.GovContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+}
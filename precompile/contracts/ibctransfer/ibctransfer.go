@@ -0,0 +1,179 @@
+package ibctransfer
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of ibc transfer contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	TransferMethod = "transfer"
+
+	IBCTransferEvent = "IBCTransfer"
+)
+
+type PrecompileExecutor struct {
+	evmKeeper      pcommon.EVMKeeper
+	bankKeeper     pcommon.BankKeeper
+	transferKeeper pcommon.IBCTransferKeeper
+}
+
+// NewContract returns a new ibc transfer stateful precompiled contract.
+//
+//	Transfers are sent as a MsgTransfer on the transfer port from the cosmos address mapped to the
+//	EVM caller. transferKeeper is expected to be the app's transfer keeper so packets go through
+//	the same packet-forward and ibc-hooks middleware as cosmos transfers. Transfers of the cosmos
+//	denom backing EVM balances are mirrored in the StateDB. Transfers cannot be sent through
+//	DELEGATECALL, which would let a contract spend the tokens of its caller.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, transferKeeper pcommon.IBCTransferKeeper) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:      evmKeeper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[TransferMethod],
+			executor.transfer,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate ibc transfer precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) transfer(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error sending ibc transfer using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[TransferMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call transfer from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 8); err != nil {
+		rerr = err
+		return
+	}
+
+	sourceChannel := args[0].(string)
+	denom := args[1].(string)
+	amount := args[2].(*big.Int)
+	receiver := args[3].(string)
+	timeoutHeight := clienttypes.NewHeight(args[4].(uint64), args[5].(uint64))
+	timeoutTimestamp := args[6].(uint64)
+	memo := args[7].(string)
+
+	if amount.Sign() <= 0 {
+		rerr = errors.New("amount must be positive")
+		return
+	}
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		rerr = errors.New("either a timeout height or a timeout timestamp must be set")
+		return
+	}
+
+	sender := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		sourceChannel,
+		sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)),
+		sender.String(),
+		receiver,
+		timeoutHeight,
+		timeoutTimestamp,
+		memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	var res *ibctransfertypes.MsgTransferResponse
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, accessibleState.GetStateDB(), caller, sender, func(ctx sdk.Context) (err error) {
+		res, err = p.transferKeeper.Transfer(ctx, msg)
+		return err
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	event := ABI.Events[IBCTransferEvent]
+	data, err := event.Inputs.NonIndexed().Pack(sourceChannel, denom, amount, receiver, memo)
+	if err != nil {
+		rerr = err
+		return
+	}
+	accessibleState.GetStateDB().AddLog(&ethtypes.Log{
+		Address: addr,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(caller.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(res.Sequence)),
+		},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	ret, err = method.Outputs.Pack(res.Sequence)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
@@ -0,0 +1,200 @@
+package ibctransfer_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/ibctransfer"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+type MockTransferKeeper struct {
+	msgs     []*ibctransfertypes.MsgTransfer
+	sequence uint64
+	// escrows the transferred tokens when set
+	bankKeeper bankkeeper.Keeper
+}
+
+func (m *MockTransferKeeper) Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	if m.bankKeeper != nil {
+		sender := sdk.MustAccAddressFromBech32(msg.Sender)
+		escrow := ibctransfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel)
+		if err := m.bankKeeper.SendCoins(ctx, sender, escrow, sdk.NewCoins(msg.Token)); err != nil {
+			return nil, err
+		}
+	}
+	m.msgs = append(m.msgs, msg)
+	m.sequence++
+	return &ibctransfertypes.MsgTransferResponse{Sequence: m.sequence}, nil
+}
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	// Generate a new Sei private key
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+func TestTransfer(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{
+		StateDB: stateDB,
+	}
+	transferKeeper := &MockTransferKeeper{sequence: 6}
+	p := ibctransfer.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), transferKeeper)
+	suppliedGas := uint64(10_000_000)
+
+	receiver := "cosmos1vqy8rqqlydj9wkcyvct9zxl3hc4eqgu3d7hd9k"
+	memo := `{"forward":{"receiver":"juno1x","port":"transfer","channel":"channel-1"}}`
+	method := ibctransfer.ABI.Methods[ibctransfer.TransferMethod]
+	args, err := method.Inputs.Pack("channel-0", "orai", big.NewInt(100), receiver, uint64(0), uint64(0), uint64(1_000_000_000), memo)
+	require.Nil(t, err)
+
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, false, big.NewInt(1))
+	require.NotNil(t, err)
+	require.Empty(t, transferKeeper.msgs)
+
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), output[0].(uint64))
+
+	// the packet is sent from the caller's cosmos address on the transfer port
+	require.Len(t, transferKeeper.msgs, 1)
+	msg := transferKeeper.msgs[0]
+	require.Equal(t, ibctransfertypes.PortID, msg.SourcePort)
+	require.Equal(t, "channel-0", msg.SourceChannel)
+	require.Equal(t, mockAddr.String(), msg.Sender)
+	require.Equal(t, receiver, msg.Receiver)
+	require.Equal(t, "100orai", msg.Token.String())
+	require.Equal(t, uint64(1_000_000_000), msg.TimeoutTimestamp)
+	require.Equal(t, memo, msg.Memo)
+
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	event := ibctransfer.ABI.Events[ibctransfer.IBCTransferEvent]
+	require.Equal(t, registry.IBCTransferContractAddress, logs[0].Address)
+	require.Equal(t, event.ID, logs[0].Topics[0])
+	require.Equal(t, common.BytesToHash(mockEVMAddr.Bytes()), logs[0].Topics[1])
+	require.Equal(t, common.BigToHash(big.NewInt(7)), logs[0].Topics[2])
+	data, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"channel-0", "orai", big.NewInt(100), receiver, memo}, data)
+
+	// a transfer without any timeout is rejected before reaching the keeper
+	args, err = method.Inputs.Pack("channel-0", "orai", big.NewInt(100), receiver, uint64(0), uint64(0), uint64(0), "")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "timeout")
+	require.Len(t, transferKeeper.msgs, 1)
+
+	// a contract cannot send the tokens of the account calling it
+	tracer := pcommon.NewCallFrameTracer(nil, false)
+	tracer.CaptureEnter(vm.DELEGATECALL, common.HexToAddress("0xde1e"), registry.IBCTransferContractAddress, nil, 0, nil)
+	evm.Config = vm.Config{Debug: true, Tracer: tracer}
+	args, err = method.Inputs.Pack("channel-0", "orai", big.NewInt(100), receiver, uint64(0), uint64(0), uint64(1_000_000_000), "")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, pcommon.ErrDelegateCall)
+	require.Len(t, transferKeeper.msgs, 1)
+}
+
+func TestTransferNativeDenomFromDirtyCaller(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+
+	contractAddr, contractEVMAddr := MockAddressPair()
+	funderAddr, funderEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, contractAddr, contractEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, funderAddr, funderEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	for _, addr := range []sdk.AccAddress{contractAddr, funderAddr} {
+		coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+		require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, coins))
+	}
+	supply := bankKeeper.GetSupply(ctx, appconfig.CosmosDenom)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	transferKeeper := &MockTransferKeeper{bankKeeper: tApp.BankKeeper}
+	p := ibctransfer.NewContract(tApp.EvmKeeper, bankKeeper, transferKeeper)
+	method := ibctransfer.ABI.Methods[ibctransfer.TransferMethod]
+	suppliedGas := uint64(10_000_000)
+	receiver := "cosmos1vqy8rqqlydj9wkcyvct9zxl3hc4eqgu3d7hd9k"
+
+	// the payable contract received value earlier in the transaction, so its balance is dirty in the StateDB
+	stateDB.SubBalance(funderEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+	stateDB.AddBalance(contractEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+
+	args, err := method.Inputs.Pack("channel-0", appconfig.CosmosDenom, big.NewInt(100), receiver, uint64(0), uint64(0), uint64(1_000_000_000), "")
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, appconfig.ToEvmAmount(big.NewInt(905)), stateDB.GetBalance(contractEVMAddr))
+
+	// the EVM balance bounds the transfer
+	args, err = method.Inputs.Pack("channel-0", appconfig.CosmosDenom, big.NewInt(906), receiver, uint64(0), uint64(0), uint64(1_000_000_000), "")
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.IBCTransferContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "insufficient funds")
+	require.NoError(t, stateDB.Commit())
+
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	require.Equal(t, sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, escrow, appconfig.CosmosDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(905), bankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(995), bankKeeper.GetBalance(ctx, funderAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, supply, bankKeeper.GetSupply(ctx, appconfig.CosmosDenom))
+}
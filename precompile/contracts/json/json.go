@@ -1,6 +1,7 @@
 package json
 
 import (
+	"bytes"
 	_ "embed"
 	gjson "encoding/json"
 	"fmt"
@@ -8,6 +9,8 @@ import (
 	"strings"
 
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 )
@@ -19,15 +22,26 @@ var (
 	RawABI string
 
 	ABI = contract.MustParseABI(RawABI)
+
+	maxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	minInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
 )
 
 const (
-	ExtractAsBytesMethod     = "extractAsBytes"
-	ExtractAsBytesListMethod = "extractAsBytesList"
-	ExtractAsUint256Method   = "extractAsUint256"
+	ExtractAsBytesMethod      = "extractAsBytes"
+	ExtractAsBytesListMethod  = "extractAsBytesList"
+	ExtractAsUint256Method    = "extractAsUint256"
+	ExtractAsInt256Method     = "extractAsInt256"
+	ExtractAsBoolMethod       = "extractAsBool"
+	ExtractAsAddressMethod    = "extractAsAddress"
+	ExtractAsStringListMethod = "extractAsStringList"
+	BuildObjectMethod         = "buildObject"
+	BuildArrayMethod          = "buildArray"
+	EncodeStringMethod        = "encodeString"
 )
 
 type PrecompileExecutor struct {
+	evmKeeper pcommon.EVMKeeper
 }
 
 // NewContract returns a new wasmd stateful precompiled contract.
@@ -36,23 +50,53 @@ type PrecompileExecutor struct {
 //	The functions of this contract (once implemented), will be used to exercise and test the various aspects of
 //	the EVM such as gas usage, argument parsing, events, etc. The specific operations tested under this contract are
 //	still to be determined.
-func NewContract() contract.StatefulPrecompiledContract {
+func NewContract(evmKeeper pcommon.EVMKeeper) contract.StatefulPrecompiledContract {
 
-	executor := &PrecompileExecutor{}
+	executor := &PrecompileExecutor{
+		evmKeeper: evmKeeper,
+	}
 
 	functions := []*contract.StatefulPrecompileFunction{
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[ExtractAsBytesMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsBytesMethod],
 			executor.extractAsBytes,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[ExtractAsBytesListMethod].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsBytesListMethod],
 			executor.extractAsBytesList,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods[ExtractAsUint256Method].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsUint256Method],
 			executor.ExtractAsUint256,
 		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsInt256Method],
+			executor.extractAsInt256,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsBoolMethod],
+			executor.extractAsBool,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsAddressMethod],
+			executor.extractAsAddress,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ExtractAsStringListMethod],
+			executor.extractAsStringList,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[BuildObjectMethod],
+			executor.buildObject,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[BuildArrayMethod],
+			executor.buildArray,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[EncodeStringMethod],
+			executor.encodeString,
+		),
 	}
 
 	// Construct the contract with functions.
@@ -65,93 +109,301 @@ func NewContract() contract.StatefulPrecompiledContract {
 	return precompile
 }
 
-func (p PrecompileExecutor) extractAsBytes(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) extractAsBytes(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error Extracting as bytes: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ExtractAsBytesMethod]
+
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	// in the case of a string value, remove the quotes
+	if len(result) >= 2 && result[0] == '"' && result[len(result)-1] == '"' {
+		result = result[1 : len(result)-1]
+	}
+
+	ret, rerr = method.Outputs.Pack([]byte(result))
+	return
+}
+
+func (p PrecompileExecutor) extractAsBytesList(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s\n", err)
+			ctx.Logger().Error("Error Extracting as bytes list: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ExtractAsBytesListMethod]
 
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	decodedResult := []gjson.RawMessage{}
+	if err := gjson.Unmarshal(result, &decodedResult); err != nil {
+		rerr = err
 		return
 	}
 
+	decodedBytes := [][]byte{}
+	for _, r := range decodedResult {
+		decodedBytes = append(decodedBytes, []byte(r))
+	}
+
+	ret, rerr = method.Outputs.Pack(decodedBytes)
+	return
+}
+
+func (p PrecompileExecutor) ExtractAsUint256(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
-			ctx.Logger().Error("Error Extracting as bytes: ", rerr.Error())
+			ctx.Logger().Error("Error Extracting as uint256: ", rerr.Error())
 			return
 		}
 	}()
-	method := ABI.Methods[ExtractAsBytesMethod]
 
-	args, err := method.Inputs.Unpack(packedInput)
+	byteArr := make([]byte, 32)
+	uint_, err := p.extractAsUint256(packedInput, value)
 	if err != nil {
 		rerr = err
 		return
 	}
 
-	if err := pcommon.ValidateNonPayable(value); err != nil {
+	if uint_.BitLen() > 256 {
+		rerr = fmt.Errorf("value does not fit in 32 bytes\n")
+		return
+	}
+
+	uint_.FillBytes(byteArr)
+
+	return byteArr, nil
+}
+
+func (p PrecompileExecutor) extractAsUint256(
+	packedInput []byte,
+	value *big.Int) (*big.Int, error) {
+
+	method := ABI.Methods[ExtractAsUint256Method]
+
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assuming result is your byte slice
+	// Convert byte slice to string and trim quotation marks
+	strValue := strings.Trim(string(result), "\"")
+
+	// Convert the string to big.Int
+	value, success := new(big.Int).SetString(strValue, 10)
+	if !success {
+		return nil, fmt.Errorf("failed to convert %s to big.Int", strValue)
+	}
+
+	return value, nil
+}
+
+func (p PrecompileExecutor) extractAsInt256(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error Extracting as int256: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ExtractAsInt256Method]
+
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
 		rerr = err
 		return
 	}
 
-	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+	// numbers beyond the float64 precision are usually encoded as strings
+	strValue := strings.Trim(string(result), "\"")
+	int_, success := new(big.Int).SetString(strValue, 10)
+	if !success {
+		rerr = fmt.Errorf("failed to convert %s to big.Int", strValue)
+		return
+	}
+	if int_.Cmp(maxInt256) > 0 || int_.Cmp(minInt256) < 0 {
+		rerr = fmt.Errorf("value %s does not fit in int256", strValue)
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(int_)
+	return
+}
+
+func (p PrecompileExecutor) extractAsBool(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error Extracting as bool: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ExtractAsBoolMethod]
+
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
 		rerr = err
 		return
 	}
 
-	// type assertion will always succeed because it's already validated in p.Prepare call in Run()
-	bz := args[0].([]byte)
-	decoded := map[string]gjson.RawMessage{}
-	if err := gjson.Unmarshal(bz, &decoded); err != nil {
+	var b bool
+	if err := gjson.Unmarshal(result, &b); err != nil {
+		rerr = fmt.Errorf("value %s is not a bool", result)
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(b)
+	return
+}
+
+func (p PrecompileExecutor) extractAsAddress(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error Extracting as address: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ExtractAsAddressMethod]
+
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
 		rerr = err
 		return
 	}
-	key := args[1].(string)
-	result, ok := decoded[key]
-	if !ok {
-		rerr = fmt.Errorf("Could not decode key extractAsBytes\n")
+
+	var addrStr string
+	if err := gjson.Unmarshal(result, &addrStr); err != nil {
+		rerr = fmt.Errorf("value %s is not a string", result)
 		return
 	}
 
-	// in the case of a string value, remove the quotes
-	if len(result) >= 2 && result[0] == '"' && result[len(result)-1] == '"' {
-		result = result[1 : len(result)-1]
+	evmAddress, err := pcommon.ResolveEvmAddress(ctx, p.evmKeeper, addrStr)
+	if err != nil {
+		rerr = err
+		return
 	}
 
-	ret, rerr = method.Outputs.Pack([]byte(result))
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	ret, rerr = method.Outputs.Pack(evmAddress)
 	return
 }
 
-func (p PrecompileExecutor) extractAsBytesList(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) extractAsStringList(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	value *big.Int) (ret []byte, rerr error) {
 
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error Extracting as string list: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ExtractAsStringListMethod]
+
+	result, err := p.extractArgs(method, packedInput, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	list := []string{}
+	if err := gjson.Unmarshal(result, &list); err != nil {
+		rerr = fmt.Errorf("value %s is not a list of strings", result)
 		return
 	}
 
+	ret, rerr = method.Outputs.Pack(list)
+	return
+}
+
+func (p PrecompileExecutor) buildObject(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
-			rerr = fmt.Errorf("%s\n", err)
-			ctx.Logger().Error("Error Extracting as bytes list: ", rerr.Error())
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error building object: ", rerr.Error())
 			return
 		}
 	}()
-	method := ABI.Methods[ExtractAsBytesListMethod]
+	method := ABI.Methods[BuildObjectMethod]
 
 	args, err := method.Inputs.Unpack(packedInput)
 	if err != nil {
@@ -169,83 +421,140 @@ func (p PrecompileExecutor) extractAsBytesList(accessibleState contract.Accessib
 		return
 	}
 
-	// type assertion will always succeed because it's already validated in p.Prepare call in Run()
-	bz := args[0].([]byte)
-	decoded := map[string]gjson.RawMessage{}
-	if err := gjson.Unmarshal(bz, &decoded); err != nil {
+	keys := args[0].([]string)
+	values := args[1].([][]byte)
+	if len(keys) != len(values) {
+		rerr = fmt.Errorf("got %d keys but %d values", len(keys), len(values))
+		return
+	}
+
+	// keys keep the order they are given in so the output is deterministic
+	var buf bytes.Buffer
+	seen := make(map[string]struct{}, len(keys))
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if _, ok := seen[key]; ok {
+			rerr = fmt.Errorf("duplicate key %s", key)
+			return
+		}
+		seen[key] = struct{}{}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeString(&buf, key); err != nil {
+			rerr = err
+			return
+		}
+		buf.WriteByte(':')
+		if err := gjson.Compact(&buf, values[i]); err != nil {
+			rerr = fmt.Errorf("value of key %s is not valid json: %w", key, err)
+			return
+		}
+	}
+	buf.WriteByte('}')
+
+	ret, rerr = method.Outputs.Pack(buf.Bytes())
+	return
+}
+
+func (p PrecompileExecutor) buildArray(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error building array: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[BuildArrayMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
 		rerr = err
 		return
 	}
-	key := args[1].(string)
-	result, ok := decoded[key]
-	if !ok {
-		rerr = fmt.Errorf("input does not contain key %s in extractAsBytesList\n", key)
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
 		return
 	}
 
-	decodedResult := []gjson.RawMessage{}
-	if err := gjson.Unmarshal(result, &decodedResult); err != nil {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
 		rerr = err
 		return
 	}
 
-	decodedBytes := [][]byte{}
-	for _, r := range decodedResult {
-		decodedBytes = append(decodedBytes, []byte(r))
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, v := range args[0].([][]byte) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := gjson.Compact(&buf, v); err != nil {
+			rerr = fmt.Errorf("element %d is not valid json: %w", i, err)
+			return
+		}
 	}
+	buf.WriteByte(']')
 
-	ret, rerr = method.Outputs.Pack(decodedBytes)
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	ret, rerr = method.Outputs.Pack(buf.Bytes())
 	return
 }
 
-func (p PrecompileExecutor) ExtractAsUint256(accessibleState contract.AccessibleState,
+func (p PrecompileExecutor) encodeString(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
-	value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+	value *big.Int) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
-			ctx.Logger().Error("Error Extracting as uint256: ", rerr.Error())
+			ctx.Logger().Error("Error encoding string: ", rerr.Error())
 			return
 		}
 	}()
+	method := ABI.Methods[EncodeStringMethod]
 
-	byteArr := make([]byte, 32)
-	uint_, err := p.extractAsUint256(packedInput, value)
+	args, err := method.Inputs.Unpack(packedInput)
 	if err != nil {
 		rerr = err
 		return
 	}
 
-	if uint_.BitLen() > 256 {
-		rerr = fmt.Errorf("value does not fit in 32 bytes\n")
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
 	}
 
-	uint_.FillBytes(byteArr)
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
 
-	remainingGas, rerr = contract.DeductGas(suppliedGas, ctx.GasMeter().GasConsumed())
+	var buf bytes.Buffer
+	if err := writeString(&buf, args[0].(string)); err != nil {
+		rerr = err
+		return
+	}
 
-	return byteArr, remainingGas, nil
+	ret, rerr = method.Outputs.Pack(buf.Bytes())
+	return
 }
 
-func (p PrecompileExecutor) extractAsUint256(
-	packedInput []byte,
-	value *big.Int) (*big.Int, error) {
-
-	method := ABI.Methods[ExtractAsUint256Method]
-
+// extractArgs unpacks the (input, key) arguments shared by the extract methods and returns the
+// raw JSON value found at key, which may be a path such as `a.b[2].c`.
+func (p PrecompileExecutor) extractArgs(method abi.Method, packedInput []byte, value *big.Int) (gjson.RawMessage, error) {
 	args, err := method.Inputs.Unpack(packedInput)
 	if err != nil {
 		return nil, err
@@ -260,26 +569,17 @@ func (p PrecompileExecutor) extractAsUint256(
 	}
 
 	// type assertion will always succeed because it's already validated in p.Prepare call in Run()
-	bz := args[0].([]byte)
-	decoded := map[string]gjson.RawMessage{}
-	if err := gjson.Unmarshal(bz, &decoded); err != nil {
-		return nil, err
-	}
-	key := args[1].(string)
-	result, ok := decoded[key]
-	if !ok {
-		return nil, fmt.Errorf("input does not contain key %s", key)
-	}
-
-	// Assuming result is your byte slice
-	// Convert byte slice to string and trim quotation marks
-	strValue := strings.Trim(string(result), "\"")
+	return pcommon.ExtractJSON(args[0].([]byte), args[1].(string))
+}
 
-	// Convert the string to big.Int
-	value, success := new(big.Int).SetString(strValue, 10)
-	if !success {
-		return nil, fmt.Errorf("failed to convert %s to big.Int", strValue)
+// writeString writes s to buf as a JSON string, without escaping html characters.
+func writeString(buf *bytes.Buffer, s string) error {
+	encoder := gjson.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return err
 	}
-
-	return value, nil
+	// Encode terminates every value with a newline
+	buf.Truncate(buf.Len() - 1)
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/CosmWasm/wasmd/app"
 	"github.com/CosmWasm/wasmd/precompile/contracts/json"
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -20,7 +21,7 @@ func TestExtractAsBytes(t *testing.T) {
 	evm := vm.EVM{
 		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
 	}
-	p := json.NewContract()
+	p := json.NewContract(tApp.EvmKeeper)
 	method := json.ABI.Methods[json.ExtractAsBytesMethod]
 	suppliedGas := uint64(10_000_000)
 
@@ -65,7 +66,7 @@ func TestExtractAsBytesList(t *testing.T) {
 	evm := vm.EVM{
 		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
 	}
-	p := json.NewContract()
+	p := json.NewContract(tApp.EvmKeeper)
 	method := json.ABI.Methods[json.ExtractAsBytesListMethod]
 	suppliedGas := uint64(10_000_000)
 
@@ -110,7 +111,7 @@ func TestExtractAsUint256(t *testing.T) {
 	evm := vm.EVM{
 		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
 	}
-	p := json.NewContract()
+	p := json.NewContract(tApp.EvmKeeper)
 	method := json.ABI.Methods[json.ExtractAsUint256Method]
 	suppliedGas := uint64(10_000_000)
 	n := new(big.Int)
@@ -143,3 +144,152 @@ func TestExtractAsUint256(t *testing.T) {
 		require.Equal(t, 0, output[0].(*big.Int).Cmp(test.expectedOutput))
 	}
 }
+
+func TestExtractPath(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := json.NewContract(tApp.EvmKeeper)
+	method := json.ABI.Methods[json.ExtractAsBytesMethod]
+	suppliedGas := uint64(10_000_000)
+	body := []byte(`{"a":{"b":[{"c":1},{"c":2},{"c":"three"}]},"x.y":4,"list":[[5,6]]}`)
+
+	for _, test := range []struct {
+		path           string
+		expectedOutput []byte
+		expectedErr    bool
+	}{
+		{"a.b[2].c", []byte("three"), false},
+		{"a.b[0]", []byte(`{"c":1}`), false},
+		{"list[0][1]", []byte("6"), false},
+		{"x.y", []byte("4"), false},
+		{"a.b[3].c", nil, true},
+		{"a.missing", nil, true},
+		{"a[0]", nil, true},
+		{"a.b[x]", nil, true},
+		{"a..b", nil, true},
+		{"a.b[1", nil, true},
+	} {
+		args, err := method.Inputs.Pack(body, test.path)
+		require.Nil(t, err)
+		res, _, err := p.Run(&evm, registry.JsonContractAddress, registry.JsonContractAddress,
+			append(method.ID, args...),
+			suppliedGas,
+			false,
+			nil,
+		)
+		if test.expectedErr {
+			require.Error(t, err, test.path)
+			continue
+		}
+		require.Nil(t, err, test.path)
+		output, err := method.Outputs.Unpack(res)
+		require.Nil(t, err)
+		require.Equal(t, test.expectedOutput, output[0].([]byte), test.path)
+	}
+}
+
+func TestExtractTyped(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := json.NewContract(tApp.EvmKeeper)
+	suppliedGas := uint64(10_000_000)
+	evmAddress := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	minInt256 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+
+	for _, test := range []struct {
+		name           string
+		method         string
+		body           string
+		expectedOutput interface{}
+	}{
+		{"int256 negative", json.ExtractAsInt256Method, `{"v":{"n":-42}}`, big.NewInt(-42)},
+		{"int256 string", json.ExtractAsInt256Method, `{"v":{"n":"` + minInt256.String() + `"}}`, minInt256},
+		{"int256 overflow", json.ExtractAsInt256Method, `{"v":{"n":"` + new(big.Int).Lsh(big.NewInt(1), 255).String() + `"}}`, nil},
+		{"bool", json.ExtractAsBoolMethod, `{"v":{"n":true}}`, true},
+		{"bool not a bool", json.ExtractAsBoolMethod, `{"v":{"n":"true"}}`, nil},
+		{"address hex", json.ExtractAsAddressMethod, `{"v":{"n":"` + evmAddress.Hex() + `"}}`, evmAddress},
+		{"address bech32", json.ExtractAsAddressMethod, `{"v":{"n":"` + sdk.AccAddress(evmAddress.Bytes()).String() + `"}}`, evmAddress},
+		{"address invalid", json.ExtractAsAddressMethod, `{"v":{"n":"orai1invalid"}}`, nil},
+		{"string list", json.ExtractAsStringListMethod, `{"v":{"n":["a","b"]}}`, []string{"a", "b"}},
+		{"string list mixed", json.ExtractAsStringListMethod, `{"v":{"n":["a",1]}}`, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			method := json.ABI.Methods[test.method]
+			args, err := method.Inputs.Pack([]byte(test.body), "v.n")
+			require.Nil(t, err)
+			res, _, err := p.Run(&evm, registry.JsonContractAddress, registry.JsonContractAddress,
+				append(method.ID, args...),
+				suppliedGas,
+				false,
+				nil,
+			)
+			if test.expectedOutput == nil {
+				require.Error(t, err)
+				return
+			}
+			require.Nil(t, err)
+			output, err := method.Outputs.Unpack(res)
+			require.Nil(t, err)
+			require.Equal(t, test.expectedOutput, output[0])
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := json.NewContract(tApp.EvmKeeper)
+	suppliedGas := uint64(10_000_000)
+	run := func(methodName string, inputs ...interface{}) ([]byte, error) {
+		method := json.ABI.Methods[methodName]
+		args, err := method.Inputs.Pack(inputs...)
+		require.Nil(t, err)
+		res, _, err := p.Run(&evm, registry.JsonContractAddress, registry.JsonContractAddress,
+			append(method.ID, args...),
+			suppliedGas,
+			false,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		output, err := method.Outputs.Unpack(res)
+		require.Nil(t, err)
+		return output[0].([]byte), nil
+	}
+
+	recipient, err := run(json.EncodeStringMethod, `orai1 "<quoted>"`)
+	require.Nil(t, err)
+	require.Equal(t, `"orai1 \"<quoted>\""`, string(recipient))
+
+	amounts, err := run(json.BuildArrayMethod, [][]byte{[]byte(`"1"`), []byte(` { "a" : 2 } `)})
+	require.Nil(t, err)
+	require.Equal(t, `["1",{"a":2}]`, string(amounts))
+
+	transfer, err := run(json.BuildObjectMethod, []string{"recipient", "amounts"}, [][]byte{recipient, amounts})
+	require.Nil(t, err)
+	msg, err := run(json.BuildObjectMethod, []string{"transfer"}, [][]byte{transfer})
+	require.Nil(t, err)
+	require.Equal(t, `{"transfer":{"recipient":"orai1 \"<quoted>\"","amounts":["1",{"a":2}]}}`, string(msg))
+
+	_, err = run(json.BuildObjectMethod, []string{"a", "b"}, [][]byte{[]byte("1")})
+	require.Error(t, err)
+	_, err = run(json.BuildObjectMethod, []string{"a", "a"}, [][]byte{[]byte("1"), []byte("2")})
+	require.Error(t, err)
+	_, err = run(json.BuildObjectMethod, []string{"a"}, [][]byte{[]byte("not json")})
+	require.Error(t, err)
+	_, err = run(json.BuildArrayMethod, [][]byte{[]byte("{")})
+	require.Error(t, err)
+}
@@ -0,0 +1,441 @@
+package staking
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of staking contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	DelegateMethod   = "delegate"
+	UndelegateMethod = "undelegate"
+	RedelegateMethod = "redelegate"
+	DelegationMethod = "delegation"
+	ValidatorMethod  = "validator"
+)
+
+type Delegation struct {
+	Balance *big.Int
+	Denom   string
+	Shares  string
+}
+
+type Validator struct {
+	OperatorAddress string
+	Moniker         string
+	Jailed          bool
+	Status          int32
+	Tokens          *big.Int
+	DelegatorShares string
+	CommissionRate  string
+}
+
+type PrecompileExecutor struct {
+	evmKeeper        pcommon.EVMKeeper
+	bankKeeper       pcommon.BankKeeper
+	stakingMsgServer pcommon.StakingMsgServer
+	stakingQuerier   pcommon.StakingQuerier
+}
+
+// NewContract returns a new staking stateful precompiled contract.
+//
+//	Transactions are executed through the x/staking msg server on behalf of the cosmos address
+//	mapped to the EVM caller, amounts are in the staking bond denom. When the bond denom backs EVM
+//	balances, the changes x/staking makes to the bank balance of the caller are mirrored in the StateDB.
+//	Transactions cannot be sent through DELEGATECALL, which would let a contract act for its caller.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, stakingMsgServer pcommon.StakingMsgServer, stakingQuerier pcommon.StakingQuerier) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:        evmKeeper,
+		bankKeeper:       bankKeeper,
+		stakingMsgServer: stakingMsgServer,
+		stakingQuerier:   stakingQuerier,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DelegateMethod],
+			executor.delegate,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[UndelegateMethod],
+			executor.undelegate,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[RedelegateMethod],
+			executor.redelegate,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DelegationMethod],
+			executor.delegation,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ValidatorMethod],
+			executor.validator,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate staking precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) delegate(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error delegating using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DelegateMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call delegate from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	amount, err := p.bondCoin(ctx, args[1].(*big.Int))
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, accessibleState.GetStateDB(), caller, delegator, func(ctx sdk.Context) error {
+		_, err := p.stakingMsgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: args[0].(string),
+			Amount:           amount,
+		})
+		return err
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) undelegate(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error undelegating using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[UndelegateMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call undelegate from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	amount, err := p.bondCoin(ctx, args[1].(*big.Int))
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	var res *stakingtypes.MsgUndelegateResponse
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, accessibleState.GetStateDB(), caller, delegator, func(ctx sdk.Context) (err error) {
+		res, err = p.stakingMsgServer.Undelegate(ctx, &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: args[0].(string),
+			Amount:           amount,
+		})
+		return err
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(res.CompletionTime.Unix())
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) redelegate(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error redelegating using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[RedelegateMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call redelegate from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	amount, err := p.bondCoin(ctx, args[2].(*big.Int))
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	var res *stakingtypes.MsgBeginRedelegateResponse
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, accessibleState.GetStateDB(), caller, delegator, func(ctx sdk.Context) (err error) {
+		res, err = p.stakingMsgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegator.String(),
+			ValidatorSrcAddress: args[0].(string),
+			ValidatorDstAddress: args[1].(string),
+			Amount:              amount,
+		})
+		return err
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(res.CompletionTime.Unix())
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) delegation(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying delegation using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DelegationMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	delegator := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	res, err := p.stakingQuerier.Delegation(ctx, &stakingtypes.QueryDelegationRequest{
+		DelegatorAddr: delegator.String(),
+		ValidatorAddr: args[1].(string),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(Delegation{
+		Balance: res.DelegationResponse.Balance.Amount.BigInt(),
+		Denom:   res.DelegationResponse.Balance.Denom,
+		Shares:  res.DelegationResponse.Delegation.Shares.String(),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) validator(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying validator using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ValidatorMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	res, err := p.stakingQuerier.Validator(ctx, &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: args[0].(string),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	validator := res.Validator
+	ret, err = method.Outputs.Pack(Validator{
+		OperatorAddress: validator.OperatorAddress,
+		Moniker:         validator.Description.Moniker,
+		Jailed:          validator.Jailed,
+		Status:          int32(validator.Status),
+		Tokens:          validator.Tokens.BigInt(),
+		DelegatorShares: validator.DelegatorShares.String(),
+		CommissionRate:  validator.Commission.Rate.String(),
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+// bondCoin wraps amount into a coin of the staking bond denom.
+func (p PrecompileExecutor) bondCoin(ctx sdk.Context, amount *big.Int) (sdk.Coin, error) {
+	if amount.Sign() <= 0 {
+		return sdk.Coin{}, errors.New("amount must be positive")
+	}
+
+	params, err := p.stakingQuerier.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(params.Params.BondDenom, sdkmath.NewIntFromBigInt(amount)), nil
+}
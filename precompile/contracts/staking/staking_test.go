@@ -0,0 +1,313 @@
+package staking_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/precompile/contracts/staking"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	// Generate a new Sei private key
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+func TestDelegateAndUndelegate(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+
+	bondDenom, err := tApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1000)))
+	bankKeeper := tApp.GetBankKeeper()
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, coins))
+
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	valAddr := validators[0].OperatorAddress
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := staking.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper), stakingkeeper.NewQuerier(tApp.StakingKeeper))
+	suppliedGas := uint64(10_000_000)
+
+	// delegate
+	method := staking.ABI.Methods[staking.DelegateMethod]
+	args, err := method.Inputs.Pack(valAddr, big.NewInt(100))
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.True(t, output[0].(bool))
+	require.Equal(t, sdkmath.NewInt(900), bankKeeper.GetBalance(ctx, mockAddr, bondDenom).Amount)
+
+	// query the delegation
+	method = staking.ABI.Methods[staking.DelegationMethod]
+	args, err = method.Inputs.Pack(mockEVMAddr, valAddr)
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	delegation := output[0].(struct {
+		Balance *big.Int `json:"balance"`
+		Denom   string   `json:"denom"`
+		Shares  string   `json:"shares"`
+	})
+	require.Equal(t, big.NewInt(100), delegation.Balance)
+	require.Equal(t, bondDenom, delegation.Denom)
+
+	// undelegate part of it
+	method = staking.ABI.Methods[staking.UndelegateMethod]
+	args, err = method.Inputs.Pack(valAddr, big.NewInt(40))
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Greater(t, output[0].(int64), ctx.BlockTime().Unix())
+
+	delegationRes, err := stakingkeeper.NewQuerier(tApp.StakingKeeper).Delegation(ctx, &stakingtypes.QueryDelegationRequest{
+		DelegatorAddr: mockAddr.String(),
+		ValidatorAddr: valAddr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(60), delegationRes.DelegationResponse.Balance.Amount)
+
+	// redelegating to the same validator is rejected by x/staking
+	method = staking.ABI.Methods[staking.RedelegateMethod]
+	args, err = method.Inputs.Pack(valAddr, valAddr, big.NewInt(10))
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Error(t, err)
+}
+
+func TestDelegateNativeDenomFromDirtyCaller(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	stakingParams, err := tApp.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	stakingParams.BondDenom = appconfig.CosmosDenom
+	require.NoError(t, tApp.StakingKeeper.SetParams(ctx, stakingParams))
+
+	contractAddr, contractEVMAddr := MockAddressPair()
+	funderAddr, funderEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, contractAddr, contractEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, funderAddr, funderEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	for _, addr := range []sdk.AccAddress{contractAddr, funderAddr} {
+		coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+		require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, coins))
+	}
+	supply := bankKeeper.GetSupply(ctx, appconfig.CosmosDenom)
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr := validators[0].OperatorAddress
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	p := staking.NewContract(tApp.EvmKeeper, bankKeeper, stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper), stakingkeeper.NewQuerier(tApp.StakingKeeper))
+	method := staking.ABI.Methods[staking.DelegateMethod]
+	suppliedGas := uint64(10_000_000)
+
+	// the payable contract received value earlier in the transaction, so its balance is dirty in the StateDB
+	stateDB.SubBalance(funderEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+	stateDB.AddBalance(contractEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+
+	args, err := method.Inputs.Pack(valAddr, big.NewInt(100))
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, appconfig.ToEvmAmount(big.NewInt(905)), stateDB.GetBalance(contractEVMAddr))
+
+	// the EVM balance bounds the delegation
+	args, err = method.Inputs.Pack(valAddr, big.NewInt(906))
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "insufficient funds")
+
+	// undelegating only moves coins once the unbonding completes, the StateDB stays in sync
+	method = staking.ABI.Methods[staking.UndelegateMethod]
+	args, err = method.Inputs.Pack(valAddr, big.NewInt(40))
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, sdkmath.NewInt(905), bankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(995), bankKeeper.GetBalance(ctx, funderAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, supply, bankKeeper.GetSupply(ctx, appconfig.CosmosDenom))
+}
+
+func TestValidator(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := staking.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), stakingkeeper.NewMsgServerImpl(tApp.StakingKeeper), stakingkeeper.NewQuerier(tApp.StakingKeeper))
+	method := staking.ABI.Methods[staking.ValidatorMethod]
+	suppliedGas := uint64(10_000_000)
+
+	args, err := method.Inputs.Pack(validators[0].OperatorAddress)
+	require.Nil(t, err)
+	res, _, err := p.Run(&evm, registry.StakingContractAddress, registry.StakingContractAddress,
+		append(method.ID, args...),
+		suppliedGas,
+		true,
+		nil,
+	)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(output))
+	validator := output[0].(struct {
+		OperatorAddress string   `json:"operatorAddress"`
+		Moniker         string   `json:"moniker"`
+		Jailed          bool     `json:"jailed"`
+		Status          int32    `json:"status"`
+		Tokens          *big.Int `json:"tokens"`
+		DelegatorShares string   `json:"delegatorShares"`
+		CommissionRate  string   `json:"commissionRate"`
+	})
+	require.Equal(t, validators[0].OperatorAddress, validator.OperatorAddress)
+	require.Equal(t, int32(stakingtypes.Bonded), validator.Status)
+	require.Equal(t, validators[0].Tokens.BigInt(), validator.Tokens)
+
+	// unknown validators revert
+	args, err = method.Inputs.Pack("oraivaloper1invalid")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, registry.StakingContractAddress, registry.StakingContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Error(t, err)
+}
+
+// forwarderCode copies its calldata to the staking precompile with the call opcode op and
+// returns whether the call succeeded.
+func forwarderCode(op vm.OpCode) []byte {
+	code := []byte{byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0}
+	if op == vm.CALL {
+		code = append(code, byte(vm.PUSH1), 0)
+	}
+	code = append(code, byte(vm.PUSH20))
+	code = append(code, registry.StakingContractAddress.Bytes()...)
+	return append(code, byte(vm.GAS), byte(op),
+		byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN))
+}
+
+func TestDelegateCallRejected(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	valAddr := validators[0].OperatorAddress
+
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	bondDenom, err := tApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1000)))
+	bankKeeper := tApp.GetBankKeeper()
+	require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, coins))
+
+	method := staking.ABI.Methods[staking.DelegateMethod]
+	args, err := method.Inputs.Pack(valAddr, big.NewInt(100))
+	require.NoError(t, err)
+	input := append(method.ID, args...)
+	delegated := func() sdkmath.Int {
+		bonded, err := tApp.StakingKeeper.GetDelegatorBonded(ctx, mockAddr)
+		require.NoError(t, err)
+		return bonded
+	}
+	call := func(to common.Address) []byte {
+		cfg, err := tApp.EvmKeeper.EVMConfig(ctx, consAddr, tApp.EvmKeeper.ChainID())
+		require.NoError(t, err)
+		stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+		stateDB.SetCode(common.HexToAddress("0xde1e"), forwarderCode(vm.DELEGATECALL))
+		stateDB.SetCode(common.HexToAddress("0xca11"), forwarderCode(vm.CALL))
+		msg := ethtypes.NewMessage(mockEVMAddr, &to, 0, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false)
+		evm := tApp.EvmKeeper.NewEVM(ctx, msg, cfg, evmtypes.NewNoOpTracer(), stateDB)
+		ret, _, err := evm.Call(vm.AccountRef(mockEVMAddr), to, input, 1_000_000, big.NewInt(0))
+		require.NoError(t, err)
+		require.NoError(t, stateDB.Commit())
+		return ret
+	}
+	before := delegated()
+
+	// a contract cannot delegate on behalf of the account calling it
+	require.Equal(t, common.LeftPadBytes(nil, 32), call(common.HexToAddress("0xde1e")))
+	require.Equal(t, before, delegated())
+
+	// a contract calling the precompile delegates its own funds
+	require.Equal(t, common.LeftPadBytes(nil, 32), call(common.HexToAddress("0xca11")))
+	require.Equal(t, before, delegated())
+
+	call(registry.StakingContractAddress)
+	require.Equal(t, before.AddRaw(100), delegated())
+}
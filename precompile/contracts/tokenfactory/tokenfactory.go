@@ -0,0 +1,679 @@
+package tokenfactory
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// Singleton StatefulPrecompiledContract.
+var (
+	// RawABI contains the raw ABI of tokenfactory contract.
+	//go:embed abi.json
+	RawABI string
+
+	ABI = contract.MustParseABI(RawABI)
+)
+
+const (
+	CreateDenomMethod      = "createDenom"
+	MintMethod             = "mint"
+	BurnMethod             = "burn"
+	ChangeAdminMethod      = "changeAdmin"
+	SetDenomMetadataMethod = "setDenomMetadata"
+	ForceTransferMethod    = "forceTransfer"
+	DenomAdminMethod       = "denomAdmin"
+	DenomsByCreatorMethod  = "denomsByCreator"
+	ParamsMethod           = "params"
+)
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type Params struct {
+	DenomCreationFee        []Coin
+	DenomCreationGasConsume uint64
+}
+
+type PrecompileExecutor struct {
+	evmKeeper             pcommon.EVMKeeper
+	bankKeeper            pcommon.BankKeeper
+	tokenFactoryMsgServer pcommon.TokenFactoryMsgServer
+	tokenFactoryQuerier   pcommon.TokenFactoryQuerier
+}
+
+// NewContract returns a new tokenfactory stateful precompiled contract.
+//
+//	Denoms are created and administered through the x/tokenfactory msg server by the cosmos address
+//	mapped to the EVM caller, so capability checks apply exactly as they do for CosmWasm bindings.
+//	Denom creation fees paid in the cosmos denom backing EVM balances are mirrored in the StateDB.
+//	Transactions cannot be sent through DELEGATECALL, which would let a contract act for its caller.
+func NewContract(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, tokenFactoryMsgServer pcommon.TokenFactoryMsgServer, tokenFactoryQuerier pcommon.TokenFactoryQuerier) contract.StatefulPrecompiledContract {
+
+	executor := &PrecompileExecutor{
+		evmKeeper:             evmKeeper,
+		bankKeeper:            bankKeeper,
+		tokenFactoryMsgServer: tokenFactoryMsgServer,
+		tokenFactoryQuerier:   tokenFactoryQuerier,
+	}
+
+	functions := []*contract.StatefulPrecompileFunction{
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[CreateDenomMethod],
+			executor.createDenom,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[MintMethod],
+			executor.mint,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[BurnMethod],
+			executor.burn,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ChangeAdminMethod],
+			executor.changeAdmin,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[SetDenomMetadataMethod],
+			executor.setDenomMetadata,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ForceTransferMethod],
+			executor.forceTransfer,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DenomAdminMethod],
+			executor.denomAdmin,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[DenomsByCreatorMethod],
+			executor.denomsByCreator,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods[ParamsMethod],
+			executor.params,
+		),
+	}
+
+	// Construct the contract with functions.
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to instantiate tokenfactory precompile: %s", err.Error()))
+	}
+
+	return precompile
+}
+
+func (p PrecompileExecutor) createDenom(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error creating denom using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[CreateDenomMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call createDenom from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	creator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := tokenfactorytypes.NewMsgCreateDenom(creator.String(), args[0].(string))
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	var res *tokenfactorytypes.MsgCreateDenomResponse
+	if err := pcommon.SyncNativeBalance(ctx, p.bankKeeper, accessibleState.GetStateDB(), caller, creator, func(ctx sdk.Context) (err error) {
+		res, err = p.tokenFactoryMsgServer.CreateDenom(ctx, msg)
+		return err
+	}); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(res.NewTokenDenom)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) mint(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error minting using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[MintMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call mint from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	admin := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	mintTo := p.evmKeeper.GetCosmosAddressMapping(ctx, args[2].(common.Address))
+	msg := tokenfactorytypes.NewMsgMintTo(admin.String(), sdk.NewCoin(args[0].(string), sdkmath.NewIntFromBigInt(args[1].(*big.Int))), mintTo.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	if _, err := p.tokenFactoryMsgServer.Mint(ctx, msg); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) burn(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error burning using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[BurnMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call burn from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		rerr = err
+		return
+	}
+
+	admin := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	amount := sdk.NewCoin(args[0].(string), sdkmath.NewIntFromBigInt(args[1].(*big.Int)))
+	msg := tokenfactorytypes.NewMsgBurn(admin.String(), amount)
+	// burning from anyone but the admin itself is gated by the burn from capability
+	if burnFrom := p.evmKeeper.GetCosmosAddressMapping(ctx, args[2].(common.Address)); !burnFrom.Equals(admin) {
+		msg = tokenfactorytypes.NewMsgBurnFrom(admin.String(), amount, burnFrom.String())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	if _, err := p.tokenFactoryMsgServer.Burn(ctx, msg); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) changeAdmin(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error changing denom admin using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ChangeAdminMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call changeAdmin from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		rerr = err
+		return
+	}
+
+	admin := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	newAdmin := p.evmKeeper.GetCosmosAddressMapping(ctx, args[1].(common.Address))
+	msg := tokenfactorytypes.NewMsgChangeAdmin(admin.String(), args[0].(string), newAdmin.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	if _, err := p.tokenFactoryMsgServer.ChangeAdmin(ctx, msg); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) setDenomMetadata(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error setting denom metadata using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[SetDenomMetadataMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call setDenomMetadata from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	metadata := args[0].(struct {
+		Description string `json:"description"`
+		DenomUnits  []struct {
+			Denom    string   `json:"denom"`
+			Exponent uint32   `json:"exponent"`
+			Aliases  []string `json:"aliases"`
+		} `json:"denomUnits"`
+		Base    string `json:"base"`
+		Display string `json:"display"`
+		Name    string `json:"name"`
+		Symbol  string `json:"symbol"`
+	})
+	denomUnits := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		})
+	}
+
+	admin := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(admin.String(), banktypes.Metadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	})
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	if _, err := p.tokenFactoryMsgServer.SetDenomMetadata(ctx, msg); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) forceTransfer(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error force transferring using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ForceTransferMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if readOnly {
+		rerr = errors.New("cannot call forceTransfer from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonDelegateCall(accessibleState); err != nil {
+		rerr = err
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 4); err != nil {
+		rerr = err
+		return
+	}
+
+	admin := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+	from := p.evmKeeper.GetCosmosAddressMapping(ctx, args[2].(common.Address))
+	to := p.evmKeeper.GetCosmosAddressMapping(ctx, args[3].(common.Address))
+	msg := tokenfactorytypes.NewMsgForceTransfer(admin.String(), sdk.NewCoin(args[0].(string), sdkmath.NewIntFromBigInt(args[1].(*big.Int))), from.String(), to.String())
+	if err := msg.ValidateBasic(); err != nil {
+		rerr = err
+		return
+	}
+
+	if _, err := p.tokenFactoryMsgServer.ForceTransfer(ctx, msg); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) denomAdmin(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying denom admin using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DenomAdminMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	res, err := p.tokenFactoryQuerier.DenomAuthorityMetadata(ctx, &tokenfactorytypes.QueryDenomAuthorityMetadataRequest{Denom: args[0].(string)})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(res.AuthorityMetadata.Admin)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) denomsByCreator(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying denoms by creator using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[DenomsByCreatorMethod]
+
+	args, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		rerr = err
+		return
+	}
+
+	creator := p.evmKeeper.GetCosmosAddressMapping(ctx, args[0].(common.Address))
+	res, err := p.tokenFactoryQuerier.DenomsFromCreator(ctx, &tokenfactorytypes.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	denoms := res.Denoms
+	if denoms == nil {
+		denoms = []string{}
+	}
+	ret, err = method.Outputs.Pack(denoms)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) params(ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying tokenfactory params using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	method := ABI.Methods[ParamsMethod]
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	res, err := p.tokenFactoryQuerier.Params(ctx, &tokenfactorytypes.QueryParamsRequest{})
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	fee := make([]Coin, 0, len(res.Params.DenomCreationFee))
+	for _, coin := range res.Params.DenomCreationFee {
+		fee = append(fee, Coin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		})
+	}
+	ret, err = method.Outputs.Pack(Params{
+		DenomCreationFee:        fee,
+		DenomCreationGasConsume: res.Params.DenomCreationGasConsume,
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
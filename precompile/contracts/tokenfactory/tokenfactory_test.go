@@ -0,0 +1,332 @@
+package tokenfactory_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/tokenfactory"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	tokenfactorykeeper "github.com/CosmWasm/wasmd/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/CosmWasm/wasmd/x/tokenfactory/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func MockAddressPair() (sdk.AccAddress, common.Address) {
+	return PrivateKeyToAddresses(MockPrivateKey())
+}
+
+func MockPrivateKey() cryptotypes.PrivKey {
+	// Generate a new Sei private key
+	entropySeed, _ := bip39.NewEntropy(256)
+	mnemonic, _ := bip39.NewMnemonic(entropySeed)
+	algo := hd.Secp256k1
+	derivedPriv, _ := algo.Derive()(mnemonic, "", "")
+	return algo.Generate()(derivedPriv)
+}
+
+func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.Address) {
+	// Encode the private key to hex (i.e. what wallets do behind the scene when users reveal private keys)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+
+	// Sign an Ethereum transaction with the hex private key
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	msg := crypto.Keccak256([]byte("foo"))
+	sig, _ := crypto.Sign(msg, key)
+
+	// Recover the public keys from the Ethereum signature
+	recoveredPub, _ := crypto.Ecrecover(msg, sig)
+	pubKey, _ := crypto.UnmarshalPubkey(recoveredPub)
+
+	return sdk.AccAddress(privKey.PubKey().Address()), crypto.PubkeyToAddress(*pubKey)
+}
+
+// convert copies an unpacked abi tuple into out through their shared json field names.
+func convert(t *testing.T, in interface{}, out interface{}) {
+	bz, err := json.Marshal(in)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, out))
+}
+
+func TestDenomLifecycle(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	adminAddr, adminEVMAddr := MockAddressPair()
+	userAddr, userEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, adminAddr, adminEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, userAddr, userEVMAddr)
+	tApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{})
+
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := tokenfactory.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), tokenfactorykeeper.NewMsgServerImpl(tApp.TokenFactoryKeeper), tApp.TokenFactoryKeeper)
+	suppliedGas := uint64(10_000_000)
+	bankKeeper := tApp.GetBankKeeper()
+
+	// the denom is namespaced under the caller's cosmos address
+	method := tokenfactory.ABI.Methods[tokenfactory.CreateDenomMethod]
+	args, err := method.Inputs.Pack("bridge")
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	res, _, err := p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	denom := output[0].(string)
+	require.Equal(t, "factory/"+adminAddr.String()+"/bridge", denom)
+
+	method = tokenfactory.ABI.Methods[tokenfactory.MintMethod]
+	args, err = method.Inputs.Pack(denom, big.NewInt(1000), userEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, userEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(1000), bankKeeper.GetBalance(ctx, userAddr, denom).Amount.Int64())
+
+	method = tokenfactory.ABI.Methods[tokenfactory.BurnMethod]
+	args, err = method.Inputs.Pack(denom, big.NewInt(100), userEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(900), bankKeeper.GetBalance(ctx, userAddr, denom).Amount.Int64())
+
+	method = tokenfactory.ABI.Methods[tokenfactory.ForceTransferMethod]
+	args, err = method.Inputs.Pack(denom, big.NewInt(400), userEVMAddr, adminEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	require.Equal(t, int64(400), bankKeeper.GetBalance(ctx, adminAddr, denom).Amount.Int64())
+
+	method = tokenfactory.ABI.Methods[tokenfactory.SetDenomMetadataMethod]
+	args, err = method.Inputs.Pack(struct {
+		Description string
+		DenomUnits  []struct {
+			Denom    string
+			Exponent uint32
+			Aliases  []string
+		}
+		Base    string
+		Display string
+		Name    string
+		Symbol  string
+	}{
+		Description: "bridged token",
+		DenomUnits: []struct {
+			Denom    string
+			Exponent uint32
+			Aliases  []string
+		}{{Denom: denom, Exponent: 0, Aliases: []string{}}},
+		Base:    denom,
+		Display: denom,
+		Name:    "Bridge",
+		Symbol:  "BRG",
+	})
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, "BRG", metadata.Symbol)
+
+	method = tokenfactory.ABI.Methods[tokenfactory.ChangeAdminMethod]
+	args, err = method.Inputs.Pack(denom, userEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+
+	method = tokenfactory.ABI.Methods[tokenfactory.DenomAdminMethod]
+	args, err = method.Inputs.Pack(denom)
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Equal(t, userAddr.String(), output[0].(string))
+
+	method = tokenfactory.ABI.Methods[tokenfactory.DenomsByCreatorMethod]
+	args, err = method.Inputs.Pack(adminEVMAddr)
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	output, err = method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Equal(t, []string{denom}, output[0].([]string))
+}
+
+func TestCapabilities(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	adminAddr, adminEVMAddr := MockAddressPair()
+	userAddr, userEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, adminAddr, adminEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, userAddr, userEVMAddr)
+	tApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{})
+
+	// a keeper without the burn from and force transfer capabilities
+	keeper := tokenfactorykeeper.NewKeeper(
+		tApp.GetKey(tokenfactorytypes.StoreKey),
+		tApp.GetSubspace(tokenfactorytypes.ModuleName),
+		tApp.AccountKeeper,
+		tApp.BankKeeper,
+		tApp.DistrKeeper,
+		[]string{tokenfactorytypes.EnableSetMetadata},
+	)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := tokenfactory.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), tokenfactorykeeper.NewMsgServerImpl(keeper), keeper)
+	suppliedGas := uint64(10_000_000)
+
+	denom, err := keeper.CreateDenom(ctx, adminAddr.String(), "gated")
+	require.NoError(t, err)
+	method := tokenfactory.ABI.Methods[tokenfactory.MintMethod]
+	args, err := method.Inputs.Pack(denom, big.NewInt(1000), userEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+
+	method = tokenfactory.ABI.Methods[tokenfactory.BurnMethod]
+	args, err = method.Inputs.Pack(denom, big.NewInt(100), userEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrCapabilityNotEnabled)
+
+	method = tokenfactory.ABI.Methods[tokenfactory.ForceTransferMethod]
+	args, err = method.Inputs.Pack(denom, big.NewInt(100), userEVMAddr, adminEVMAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrCapabilityNotEnabled)
+	require.Equal(t, int64(1000), tApp.GetBankKeeper().GetBalance(ctx, userAddr, denom).Amount.Int64())
+}
+
+func TestCreateDenomFeeFromDirtyCaller(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	tApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{
+		DenomCreationFee: sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(100))),
+	})
+
+	contractAddr, contractEVMAddr := MockAddressPair()
+	funderAddr, funderEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, contractAddr, contractEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, funderAddr, funderEVMAddr)
+	bankKeeper := tApp.GetBankKeeper()
+	for _, addr := range []sdk.AccAddress{contractAddr, funderAddr} {
+		coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(1000)))
+		require.NoError(t, bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, coins))
+	}
+	supply := bankKeeper.GetSupply(ctx, appconfig.CosmosDenom)
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{StateDB: stateDB}
+	p := tokenfactory.NewContract(tApp.EvmKeeper, bankKeeper, tokenfactorykeeper.NewMsgServerImpl(tApp.TokenFactoryKeeper), tApp.TokenFactoryKeeper)
+	method := tokenfactory.ABI.Methods[tokenfactory.CreateDenomMethod]
+	suppliedGas := uint64(10_000_000)
+
+	// the payable contract received value earlier in the transaction, so its balance is dirty in the StateDB
+	stateDB.SubBalance(funderEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+	stateDB.AddBalance(contractEVMAddr, appconfig.ToEvmAmount(big.NewInt(5)))
+
+	args, err := method.Inputs.Pack("bridge")
+	require.NoError(t, err)
+	_, _, err = p.Run(&evm, contractEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, appconfig.ToEvmAmount(big.NewInt(905)), stateDB.GetBalance(contractEVMAddr))
+	require.NoError(t, stateDB.Commit())
+
+	require.Equal(t, sdkmath.NewInt(905), bankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(995), bankKeeper.GetBalance(ctx, funderAddr, appconfig.CosmosDenom).Amount)
+	require.Equal(t, supply, bankKeeper.GetSupply(ctx, appconfig.CosmosDenom))
+}
+
+func TestDelegateCallRejected(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	adminAddr, adminEVMAddr := MockAddressPair()
+	userAddr, userEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, adminAddr, adminEVMAddr)
+	tApp.EvmKeeper.SetAddressMapping(ctx, userAddr, userEVMAddr)
+	tApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{})
+	denom, err := tApp.TokenFactoryKeeper.CreateDenom(ctx, adminAddr.String(), "guarded")
+	require.NoError(t, err)
+
+	// the precompile runs in a frame entered through DELEGATECALL
+	tracer := pcommon.NewCallFrameTracer(nil, false)
+	tracer.CaptureEnter(vm.DELEGATECALL, common.HexToAddress("0xde1e"), registry.TokenFactoryContractAddress, nil, 0, nil)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		Config:  vm.Config{Debug: true, Tracer: tracer},
+	}
+	p := tokenfactory.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), tokenfactorykeeper.NewMsgServerImpl(tApp.TokenFactoryKeeper), tApp.TokenFactoryKeeper)
+	suppliedGas := uint64(10_000_000)
+
+	for _, tc := range []struct {
+		method string
+		args   []interface{}
+	}{
+		{tokenfactory.CreateDenomMethod, []interface{}{"other"}},
+		{tokenfactory.MintMethod, []interface{}{denom, big.NewInt(1000), userEVMAddr}},
+		{tokenfactory.BurnMethod, []interface{}{denom, big.NewInt(100), userEVMAddr}},
+		{tokenfactory.ForceTransferMethod, []interface{}{denom, big.NewInt(100), userEVMAddr, adminEVMAddr}},
+		{tokenfactory.ChangeAdminMethod, []interface{}{denom, userEVMAddr}},
+	} {
+		method := tokenfactory.ABI.Methods[tc.method]
+		args, err := method.Inputs.Pack(tc.args...)
+		require.NoError(t, err)
+		_, _, err = p.Run(&evm, adminEVMAddr, registry.TokenFactoryContractAddress, append(method.ID, args...), suppliedGas, false, nil)
+		require.ErrorIs(t, err, pcommon.ErrDelegateCall, tc.method)
+	}
+	require.True(t, tApp.GetBankKeeper().GetBalance(ctx, userAddr, denom).IsZero())
+	authority, err := tApp.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, adminAddr.String(), authority.Admin)
+}
+
+func TestParams(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	p := tokenfactory.NewContract(tApp.EvmKeeper, tApp.GetBankKeeper(), tokenfactorykeeper.NewMsgServerImpl(tApp.TokenFactoryKeeper), tApp.TokenFactoryKeeper)
+	method := tokenfactory.ABI.Methods[tokenfactory.ParamsMethod]
+
+	res, _, err := p.Run(&evm, registry.TokenFactoryContractAddress, registry.TokenFactoryContractAddress,
+		method.ID,
+		uint64(10_000_000),
+		true,
+		nil,
+	)
+	require.Nil(t, err)
+	output, err := method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	var params tokenfactory.Params
+	convert(t, output[0], &params)
+
+	expected := tApp.TokenFactoryKeeper.GetParams(ctx)
+	require.Equal(t, expected.DenomCreationGasConsume, params.DenomCreationGasConsume)
+	require.Len(t, params.DenomCreationFee, len(expected.DenomCreationFee))
+	require.Equal(t, expected.DenomCreationFee[0].Amount.BigInt(), params.DenomCreationFee[0].Amount)
+}
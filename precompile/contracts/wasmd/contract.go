@@ -7,10 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
+	sdkmath "cosmossdk.io/math"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 )
 
@@ -23,6 +28,26 @@ var (
 	ABI = contract.MustParseABI(RawABI)
 )
 
+const ExecutedEvent = "Executed"
+
+type EventAttribute struct {
+	Key   string
+	Value string
+}
+
+type Event struct {
+	EventType  string
+	Attributes []EventAttribute
+}
+
+type ContractInfo struct {
+	CodeID    uint64
+	Creator   string
+	Admin     string
+	Label     string
+	IbcPortID string
+}
+
 type PrecompileExecutor struct {
 	wasmdKeeper     pcommon.WasmdKeeper
 	wasmdViewKeeper pcommon.WasmdViewKeeper
@@ -30,24 +55,18 @@ type PrecompileExecutor struct {
 }
 
 func (p PrecompileExecutor) instantiateCosmWasm(
+	ctx sdk.Context,
 	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
 	value *big.Int,
-) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
 			ctx.Logger().Error("Error instantiating cosmwasm using precompile: ", rerr.Error())
 			return
@@ -77,8 +96,16 @@ func (p PrecompileExecutor) instantiateCosmWasm(
 	label := res[3].(string)
 	funds := res[4].([]byte)
 
-	// unmarshal funds
-	deposit := UnmarshalCosmWasmDeposit(funds)
+	deposit, err := UnmarshalCosmWasmDeposit(funds)
+	if err != nil {
+		rerr = err
+		return
+	}
+	deposit, err = depositValue(accessibleState.GetStateDB(), callingContract, deposit, value)
+	if err != nil {
+		rerr = err
+		return
+	}
 
 	creator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 
@@ -88,40 +115,38 @@ func (p PrecompileExecutor) instantiateCosmWasm(
 		return
 	}
 
-	addr, data, err := p.wasmdKeeper.Instantiate(ctx, codeID, creator, adminAddr, msg, label, deposit)
+	em := sdk.NewEventManager()
+	cacheCtx, writeCache := ctx.CacheContext()
+	addr, data, err := p.wasmdKeeper.Instantiate(cacheCtx.WithEventManager(em), codeID, creator, adminAddr, msg, label, deposit)
 	if err != nil {
 		rerr = err
 		return
 	}
+	writeCache()
+	ctx.EventManager().EmitEvents(em.Events())
 
-	cosmosGasUsed := ctx.GasMeter().GasConsumed()
+	if err := emitExecuted(ctx, accessibleState.GetStateDB(), callingContract, caller, addr, em.Events()); err != nil {
+		rerr = err
+		return
+	}
 
 	ret, rerr = method.Outputs.Pack(addr.String(), data)
-
-	remainingGas, rerr = contract.DeductGas(suppliedGas, cosmosGasUsed)
-
 	return
 }
 
 func (p PrecompileExecutor) executeCosmWasm(
+	ctx sdk.Context,
 	accessibleState contract.AccessibleState,
 	caller common.Address,
 	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
 	value *big.Int,
-) (ret []byte, remainingGas uint64, rerr error) {
-
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
-		return
-	}
+) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
 			ctx.Logger().Error("Error executing cosmwasm using precompile: ", rerr.Error())
 			return
@@ -144,8 +169,16 @@ func (p PrecompileExecutor) executeCosmWasm(
 	msg := res[1].([]byte)
 	funds := res[2].([]byte)
 
-	// unmarshal funds
-	deposit := UnmarshalCosmWasmDeposit(funds)
+	deposit, err := UnmarshalCosmWasmDeposit(funds)
+	if err != nil {
+		rerr = err
+		return
+	}
+	deposit, err = depositValue(accessibleState.GetStateDB(), callingContract, deposit, value)
+	if err != nil {
+		rerr = err
+		return
+	}
 
 	senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
 
@@ -156,44 +189,130 @@ func (p PrecompileExecutor) executeCosmWasm(
 		return
 	}
 
-	exeRes, err := p.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, msg, deposit)
-
+	em := sdk.NewEventManager()
+	cacheCtx, writeCache := ctx.CacheContext()
+	exeRes, err := p.wasmdKeeper.Execute(cacheCtx.WithEventManager(em), contractAddr, senderAddr, msg, deposit)
 	if err != nil {
 		rerr = err
 		return
 	}
+	writeCache()
+	ctx.EventManager().EmitEvents(em.Events())
 
-	cosmosGasUsed := ctx.GasMeter().GasConsumed()
+	if err := emitExecuted(ctx, accessibleState.GetStateDB(), callingContract, caller, contractAddr, em.Events()); err != nil {
+		rerr = err
+		return
+	}
 
 	ret, rerr = method.Outputs.Pack(exeRes)
-
-	remainingGas, rerr = contract.DeductGas(suppliedGas, cosmosGasUsed)
-
 	return
 
 }
 
-func (p PrecompileExecutor) queryCosmWasm(
+func (p PrecompileExecutor) executeBatchCosmWasm(
+	ctx sdk.Context,
 	accessibleState contract.AccessibleState,
 	caller common.Address,
-	addr common.Address,
+	callingContract common.Address,
 	packedInput []byte,
-	suppliedGas uint64,
 	readOnly bool,
 	value *big.Int,
-) (ret []byte, remainingGas uint64, rerr error) {
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error batch executing cosmwasm using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	if readOnly {
+		rerr = errors.New("cannot call executeBatch from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["executeBatch"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	executeMsgs := res[0].([]struct {
+		ContractAddress string `json:"contractAddress"`
+		Msg             []byte `json:"msg"`
+		Funds           []byte `json:"funds"`
+	})
+
+	senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+	// every execution runs on a branch of the store that is only written once all of them succeed
+	cacheCtx, writeCache := ctx.CacheContext()
+	responses := make([][]byte, 0, len(executeMsgs))
+	contractAddrs := make([]sdk.AccAddress, 0, len(executeMsgs))
+	events := make([]sdk.Events, 0, len(executeMsgs))
+	for i, executeMsg := range executeMsgs {
+		// addresses will be sent in Cosmos format
+		contractAddr, err := sdk.AccAddressFromBech32(executeMsg.ContractAddress)
+		if err != nil {
+			rerr = fmt.Errorf("execute %d: %w", i, err)
+			return
+		}
+
+		deposit, err := UnmarshalCosmWasmDeposit(executeMsg.Funds)
+		if err != nil {
+			rerr = fmt.Errorf("execute %d: %w", i, err)
+			return
+		}
+
+		em := sdk.NewEventManager()
+		exeRes, err := p.wasmdKeeper.Execute(cacheCtx.WithEventManager(em), contractAddr, senderAddr, executeMsg.Msg, deposit)
+		if err != nil {
+			rerr = fmt.Errorf("execute %d: %w", i, err)
+			return
+		}
+		cacheCtx.EventManager().EmitEvents(em.Events())
+		responses = append(responses, exeRes)
+		contractAddrs = append(contractAddrs, contractAddr)
+		events = append(events, em.Events())
+	}
+	writeCache()
+
+	for i, contractAddr := range contractAddrs {
+		if err := emitExecuted(ctx, accessibleState.GetStateDB(), callingContract, caller, contractAddr, events[i]); err != nil {
+			rerr = err
+			return
+		}
+	}
 
-	ctx, rerr := pcommon.GetPrecompileCtx(accessibleState)
-	if rerr != nil {
+	ret, err = method.Outputs.Pack(responses)
+	if err != nil {
+		rerr = err
 		return
 	}
+	return
+}
+
+func (p PrecompileExecutor) queryCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
 
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
-			remainingGas = 0
 			rerr = fmt.Errorf("%s", err)
-			fmt.Println("rerr: ", rerr)
 			ctx.Logger().Error("Error querying cosmwasm using precompile: ", rerr.Error())
 			return
 		}
@@ -228,14 +347,460 @@ func (p PrecompileExecutor) queryCosmWasm(
 		return
 	}
 
-	cosmosGasUsed := ctx.GasMeter().GasConsumed()
-
 	ret, rerr = method.Outputs.Pack(queryRes)
+	return
+
+}
+
+// queryTypedCosmWasm runs a smart query and returns its JSON response ABI encoded as described
+// by the abiSchema argument, so that callers can abi.decode it directly.
+func (p PrecompileExecutor) queryTypedCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cosmwasm using precompile: ", rerr.Error())
+			return
+		}
+	}()
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["queryTyped"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	contractAddress := res[0].(string)
+	req := res[1].([]byte)
+	abiSchema := res[2].(string)
+
+	// parse the schema before running the query so a malformed one fails cheaply
+	if _, err := parseSchema(abiSchema); err != nil {
+		rerr = err
+		return
+	}
+
+	// addresses will be sent in Cosmos format
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	queryRes, err := p.wasmdViewKeeper.QuerySmart(ctx, contractAddr, req)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	typedRes, err := decodeTyped(ctx, p.evmKeeper, queryRes, abiSchema)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, rerr = method.Outputs.Pack(typedRes)
+	return
+}
+
+func (p PrecompileExecutor) instantiate2CosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error instantiating cosmwasm with predictable address using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	if readOnly {
+		rerr = errors.New("cannot call instantiate2 from staticcall")
+		return
+	}
+
+	method := ABI.Methods["instantiate2"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	codeID := res[0].(uint64)
+	admin := res[1].(string)
+	msg := res[2].([]byte)
+	label := res[3].(string)
+	funds := res[4].([]byte)
+	salt := res[5].([]byte)
+	fixMsg := res[6].(bool)
+
+	deposit, err := UnmarshalCosmWasmDeposit(funds)
+	if err != nil {
+		rerr = err
+		return
+	}
+	deposit, err = depositValue(accessibleState.GetStateDB(), callingContract, deposit, value)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	creator := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+	// an empty admin instantiates an immutable contract
+	var adminAddr sdk.AccAddress
+	if admin != "" {
+		adminAddr, err = sdk.AccAddressFromBech32(admin)
+		if err != nil {
+			rerr = err
+			return
+		}
+	}
+
+	// the address is derived with BuildContractAddressPredictable from the code checksum, creator, salt and
+	// optionally the init msg, so it can be known before the contract exists
+	em := sdk.NewEventManager()
+	cacheCtx, writeCache := ctx.CacheContext()
+	addr, data, err := p.wasmdKeeper.Instantiate2(cacheCtx.WithEventManager(em), codeID, creator, adminAddr, msg, label, deposit, salt, fixMsg)
+	if err != nil {
+		rerr = err
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(em.Events())
+
+	if err := emitExecuted(ctx, accessibleState.GetStateDB(), callingContract, caller, addr, em.Events()); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(addr.String(), data)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) migrateCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error migrating cosmwasm using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	if readOnly {
+		rerr = errors.New("cannot call migrate from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["migrate"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	contractAddress := res[0].(string)
+	newCodeID := res[1].(uint64)
+	msg := res[2].([]byte)
+
+	senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+	// addresses will be sent in Cosmos format
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		rerr = err
+		return
+	}
 
-	remainingGas, rerr = contract.DeductGas(suppliedGas, cosmosGasUsed)
+	data, err := p.wasmdKeeper.Migrate(ctx, contractAddr, senderAddr, newCodeID, msg)
+	if err != nil {
+		rerr = err
+		return
+	}
 
+	ret, err = method.Outputs.Pack(data)
+	if err != nil {
+		rerr = err
+		return
+	}
 	return
+}
+
+func (p PrecompileExecutor) updateAdminCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error updating cosmwasm admin using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	if readOnly {
+		rerr = errors.New("cannot call updateAdmin from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["updateAdmin"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	contractAddress := res[0].(string)
+	newAdmin := res[1].(string)
+
+	senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+	// addresses will be sent in Cosmos format
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		rerr = err
+		return
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(newAdmin)
+	if err != nil {
+		rerr = err
+		return
+	}
 
+	if err := p.wasmdKeeper.UpdateContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) clearAdminCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	callingContract common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error clearing cosmwasm admin using precompile: ", rerr.Error())
+			return
+		}
+	}()
+	if readOnly {
+		rerr = errors.New("cannot call clearAdmin from staticcall")
+		return
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["clearAdmin"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	contractAddress := res[0].(string)
+
+	senderAddr := p.evmKeeper.GetCosmosAddressMapping(ctx, caller)
+
+	// addresses will be sent in Cosmos format
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	if err := p.wasmdKeeper.ClearContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) queryRawCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error raw querying cosmwasm using precompile: ", rerr.Error())
+			return
+		}
+	}()
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["queryRaw"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	contractAddress := res[0].(string)
+	key := res[1].([]byte)
+
+	// addresses will be sent in Cosmos format
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	ret, err = method.Outputs.Pack(p.wasmdViewKeeper.QueryRaw(ctx, contractAddr, key))
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
+}
+
+func (p PrecompileExecutor) contractInfoCosmWasm(
+	ctx sdk.Context,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	packedInput []byte,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, rerr error) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			rerr = fmt.Errorf("%s", err)
+			ctx.Logger().Error("Error querying cosmwasm contract info using precompile: ", rerr.Error())
+			return
+		}
+	}()
+
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		rerr = err
+		return
+	}
+
+	method := ABI.Methods["contractInfo"]
+
+	res, err := method.Inputs.Unpack(packedInput)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	contractAddress := res[0].(string)
+
+	// addresses will be sent in Cosmos format
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		rerr = err
+		return
+	}
+
+	info := p.wasmdViewKeeper.GetContractInfo(ctx, contractAddr)
+	if info == nil {
+		rerr = fmt.Errorf("contract %s not found", contractAddress)
+		return
+	}
+
+	ret, err = method.Outputs.Pack(ContractInfo{
+		CodeID:    info.CodeID,
+		Creator:   info.Creator,
+		Admin:     info.Admin,
+		Label:     info.Label,
+		IbcPortID: info.IBCPortID,
+	})
+	if err != nil {
+		rerr = err
+		return
+	}
+	return
 }
 
 // NewContract returns a new wasmd stateful precompiled contract.
@@ -253,18 +818,50 @@ func NewContract(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdV
 	}
 
 	functions := []*contract.StatefulPrecompileFunction{
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods["instantiate"].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["instantiate"],
 			executor.instantiateCosmWasm,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods["execute"].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["execute"],
 			executor.executeCosmWasm,
 		),
-		contract.NewStatefulPrecompileFunction(
-			ABI.Methods["query"].ID,
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["executeBatch"],
+			executor.executeBatchCosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["query"],
 			executor.queryCosmWasm,
 		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["queryTyped"],
+			executor.queryTypedCosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["instantiate2"],
+			executor.instantiate2CosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["migrate"],
+			executor.migrateCosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["updateAdmin"],
+			executor.updateAdminCosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["clearAdmin"],
+			executor.clearAdminCosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["queryRaw"],
+			executor.queryRawCosmWasm,
+		),
+		pcommon.NewPrecompileFunction(
+			ABI.Methods["contractInfo"],
+			executor.contractInfoCosmWasm,
+		),
 	}
 
 	// Construct the contract with functions.
@@ -277,12 +874,67 @@ func NewContract(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdV
 	return precompile
 }
 
-func UnmarshalCosmWasmDeposit(coins []byte) sdk.Coins {
-	// unmarshal coins
-	var deposit sdk.Coins
-	err := json.Unmarshal(coins, &deposit)
+// emitExecuted appends an Executed log for contractAddr carrying the wasm events it emitted. The
+// log is journaled by the StateDB, so it is dropped again if the EVM call reverts.
+func emitExecuted(ctx sdk.Context, stateDB contract.StateDB, addr common.Address, caller common.Address, contractAddr sdk.AccAddress, events sdk.Events) error {
+	wasmEvents := make([]Event, 0, len(events))
+	for _, event := range events {
+		if event.Type != wasmtypes.WasmModuleEventType && !strings.HasPrefix(event.Type, wasmtypes.CustomContractEventPrefix) {
+			continue
+		}
+		attributes := make([]EventAttribute, 0, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes = append(attributes, EventAttribute{Key: attribute.Key, Value: attribute.Value})
+		}
+		wasmEvents = append(wasmEvents, Event{EventType: event.Type, Attributes: attributes})
+	}
+
+	event := ABI.Events[ExecutedEvent]
+	data, err := event.Inputs.NonIndexed().Pack(contractAddr.String(), wasmEvents)
 	if err != nil {
-		return sdk.NewCoins()
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     addr,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(caller.Bytes())},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+// UnmarshalCosmWasmDeposit parses the JSON encoded coins sent along a wasm call, such as
+// `[{"denom":"orai","amount":"100"}]`. Empty input is no deposit, anything else must be a valid
+// list of coins so a malformed deposit is never mistaken for none.
+func UnmarshalCosmWasmDeposit(coins []byte) (sdk.Coins, error) {
+	if len(bytes.TrimSpace(coins)) == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	var deposit sdk.Coins
+	if err := json.Unmarshal(coins, &deposit); err != nil {
+		return nil, fmt.Errorf("invalid funds %s: %w", coins, err)
+	}
+	deposit = deposit.Sort()
+	if err := deposit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid funds %s: %w", coins, err)
+	}
+	return deposit, nil
+}
+
+// depositValue adds msg.value to deposit as orai. The EVM moved the value from the caller to the
+// precompile before calling it, taking it back from the precompile leaves it deducted from the
+// caller in the StateDB, matching the bank transfer of the deposit by the wasm keeper.
+func depositValue(stateDB contract.StateDB, precompile common.Address, deposit sdk.Coins, value *big.Int) (sdk.Coins, error) {
+	if value == nil || value.Sign() == 0 {
+		return deposit, nil
+	}
+
+	amount, remainder := appconfig.ToCosmosAmount(value)
+	if remainder.Sign() != 0 {
+		return nil, fmt.Errorf("msg.value must be a multiple of %s%s", appconfig.ConversionMultiplier, appconfig.EvmDenom)
 	}
-	return deposit
+	stateDB.SubBalance(precompile, value)
+	return deposit.Add(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewIntFromBigInt(amount))), nil
 }
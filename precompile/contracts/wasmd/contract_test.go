@@ -5,14 +5,17 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
 	"os"
 	"testing"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
 	"github.com/CosmWasm/wasmd/precompile/contracts/wasmd"
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -20,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -42,10 +46,35 @@ func (m *MockWasmer) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, ca
 	return []byte("Execute"), nil
 }
 
+func (m *MockWasmer) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error) {
+	addr := sdk.MustAccAddressFromBech32("orai19xtunzaq20unp8squpmfrw8duclac22hd7ves2")
+	return addr, []byte("Instantiate2"), nil
+}
+
+func (m *MockWasmer) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error) {
+	return []byte("Migrate"), nil
+}
+
+func (m *MockWasmer) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error {
+	return nil
+}
+
+func (m *MockWasmer) ClearContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	return nil
+}
+
 func (m *MockWasmer) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	return []byte("QuerySmart"), nil
 }
 
+func (m *MockWasmer) QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	return []byte("QueryRaw")
+}
+
+func (m *MockWasmer) GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return nil
+}
+
 func MockAddressPair() (sdk.AccAddress, common.Address) {
 	return PrivateKeyToAddresses(MockPrivateKey())
 }
@@ -76,22 +105,26 @@ func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.
 }
 
 func TestUnmarshalCosmWasmDeposit(t *testing.T) {
-	deposit := wasmd.UnmarshalCosmWasmDeposit([]byte("[]"))
-	require.Equal(t, deposit, sdk.NewCoins())
-	deposit = wasmd.UnmarshalCosmWasmDeposit([]byte("foobar"))
+	deposit, err := wasmd.UnmarshalCosmWasmDeposit([]byte("[]"))
+	require.NoError(t, err)
 	require.Equal(t, deposit, sdk.NewCoins())
-	deposit = wasmd.UnmarshalCosmWasmDeposit([]byte("{}"))
+	deposit, err = wasmd.UnmarshalCosmWasmDeposit(nil)
+	require.NoError(t, err)
 	require.Equal(t, deposit, sdk.NewCoins())
-	deposit = wasmd.UnmarshalCosmWasmDeposit([]byte("[{\"denom\":\"ukava\",\"amount\":\"10\"}, {\"denom\":\"orai\",\"amount\":\"100\"}]"))
-	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10)), sdk.NewCoin("orai", sdkmath.NewInt(100)))
-	for _, coin := range coins {
-		if coin.Denom == "orai" {
-			require.Equal(t, coin.Amount, sdkmath.NewInt(100))
-		} else if coin.Denom == "ukava" {
-			require.Equal(t, coin.Amount, sdkmath.NewInt(10))
-		} else {
-			panic("Wrong Unmarshal")
-		}
+	deposit, err = wasmd.UnmarshalCosmWasmDeposit([]byte("[{\"denom\":\"ukava\",\"amount\":\"10\"}, {\"denom\":\"orai\",\"amount\":\"100\"}]"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10)), sdk.NewCoin("orai", sdkmath.NewInt(100))), deposit)
+
+	// a malformed deposit is rejected instead of sending nothing
+	for _, funds := range []string{
+		"foobar",
+		"{}",
+		`[{"denom":"orai","amount":"-1"}]`,
+		`[{"denom":"orai","amount":"1"},{"denom":"orai","amount":"2"}]`,
+		`[{"denom":"1","amount":"1"}]`,
+	} {
+		_, err := wasmd.UnmarshalCosmWasmDeposit([]byte(funds))
+		require.ErrorContains(t, err, "invalid funds", funds)
 	}
 }
 
@@ -135,6 +168,16 @@ func TestExecuteAndQuery(t *testing.T) {
 
 	args, err := instantiateMethod.Inputs.Pack(codeID, mockAddr.String(), []byte("{}"), "test", []byte("foo"))
 	require.Nil(t, err)
+	_, _, err = p.Contract.Run(&evm, registry.WasmdContractAddress, registry.WasmdContractAddress,
+		append(instantiateMethod.ID, args...),
+		suppliedGas,
+		false,
+		nil,
+	)
+	require.ErrorContains(t, err, "invalid funds")
+
+	args, err = instantiateMethod.Inputs.Pack(codeID, mockAddr.String(), []byte("{}"), "test", []byte("[]"))
+	require.Nil(t, err)
 	res, suppliedGas, err := p.Contract.Run(&evm, registry.WasmdContractAddress, registry.WasmdContractAddress,
 		append(instantiateMethod.ID, args...),
 		suppliedGas,
@@ -187,3 +230,321 @@ func TestExecuteAndQuery(t *testing.T) {
 	response = rets[0].([]byte)
 	require.Equal(t, base64.StdEncoding.EncodeToString(response), "eyJtZXNzYWdlIjoicXVlcnkgdGVzdCJ9")
 }
+
+func TestInstantiate2AndAdmin(t *testing.T) {
+
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams())
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	newAdminAddr, _ := MockAddressPair()
+
+	code, err := os.ReadFile("../../cosmwasm/echo/artifacts/echo.wasm")
+	require.Nil(t, err)
+	codeID, checksum, err := tApp.ContractKeeper.Create(ctx, mockAddr, code, nil)
+	require.Nil(t, err)
+
+	p := wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	suppliedGas := uint64(10_000_000)
+
+	// the contract lands at the address predicted from the checksum, creator and salt
+	salt := []byte("factory-salt")
+	instantiate2Method := wasmd.ABI.Methods["instantiate2"]
+	args, err := instantiate2Method.Inputs.Pack(codeID, mockAddr.String(), []byte("{}"), "test", []byte("[]"), salt, false)
+	require.Nil(t, err)
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(instantiate2Method.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(instantiate2Method.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	rets, err := instantiate2Method.Outputs.Unpack(res)
+	require.Nil(t, err)
+	cosmwasmAddr := rets[0].(string)
+	require.Equal(t, wasmkeeper.BuildContractAddressPredictable(checksum, mockAddr, salt, nil).String(), cosmwasmAddr)
+
+	contractInfoMethod := wasmd.ABI.Methods["contractInfo"]
+	args, err = contractInfoMethod.Inputs.Pack(cosmwasmAddr)
+	require.Nil(t, err)
+	queryContractInfo := func() wasmd.ContractInfo {
+		res, _, err := p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(contractInfoMethod.ID, args...), suppliedGas, true, nil)
+		require.Nil(t, err)
+		rets, err := contractInfoMethod.Outputs.Unpack(res)
+		require.Nil(t, err)
+		return *abi.ConvertType(rets[0], new(wasmd.ContractInfo)).(*wasmd.ContractInfo)
+	}
+	info := queryContractInfo()
+	require.Equal(t, codeID, info.CodeID)
+	require.Equal(t, mockAddr.String(), info.Creator)
+	require.Equal(t, mockAddr.String(), info.Admin)
+	require.Equal(t, "test", info.Label)
+
+	// the echo contract keeps no state of its own
+	queryRawMethod := wasmd.ABI.Methods["queryRaw"]
+	rawArgs, err := queryRawMethod.Inputs.Pack(cosmwasmAddr, []byte("config"))
+	require.Nil(t, err)
+	res, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(queryRawMethod.ID, rawArgs...), suppliedGas, true, nil)
+	require.Nil(t, err)
+	rets, err = queryRawMethod.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Empty(t, rets[0].([]byte))
+
+	updateAdminMethod := wasmd.ABI.Methods["updateAdmin"]
+	updateArgs, err := updateAdminMethod.Inputs.Pack(cosmwasmAddr, newAdminAddr.String())
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(updateAdminMethod.ID, updateArgs...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	require.Equal(t, newAdminAddr.String(), queryContractInfo().Admin)
+
+	// only the current admin can clear the admin
+	clearAdminMethod := wasmd.ABI.Methods["clearAdmin"]
+	clearArgs, err := clearAdminMethod.Inputs.Pack(cosmwasmAddr)
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(clearAdminMethod.ID, clearArgs...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "unauthorized")
+	require.Equal(t, newAdminAddr.String(), queryContractInfo().Admin)
+}
+
+func TestMigrate(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	wasmer := &MockWasmer{}
+	p := wasmd.NewContract(wasmer, wasmer, tApp.EvmKeeper)
+	contractAddr, mockEVMAddr := MockAddressPair()
+
+	migrateMethod := wasmd.ABI.Methods["migrate"]
+	args, err := migrateMethod.Inputs.Pack(contractAddr.String(), uint64(2), []byte("{}"))
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(migrateMethod.ID, args...), uint64(10_000_000), false, big.NewInt(1))
+	require.ErrorContains(t, err, "non-payable")
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(migrateMethod.ID, args...), uint64(10_000_000), false, nil)
+	require.Nil(t, err)
+	rets, err := migrateMethod.Outputs.Unpack(res)
+	require.Nil(t, err)
+	require.Equal(t, []byte("Migrate"), rets[0].([]byte))
+}
+
+func TestExecuteBatch(t *testing.T) {
+
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams())
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	amts := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000)))
+	tApp.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts)
+	tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, amts)
+
+	code, err := os.ReadFile("../../cosmwasm/echo/artifacts/echo.wasm")
+	require.Nil(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, mockAddr, code, nil)
+	require.Nil(t, err)
+	first, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, mockAddr, nil, []byte("{}"), "first", nil)
+	require.Nil(t, err)
+	second, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, mockAddr, nil, []byte("{}"), "second", nil)
+	require.Nil(t, err)
+
+	p := wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper)
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{
+		StateDB: stateDB,
+	}
+	suppliedGas := uint64(10_000_000)
+
+	type executeMsg struct {
+		ContractAddress string
+		Msg             []byte
+		Funds           []byte
+	}
+	fundsBz, _ := json.Marshal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10))))
+	executeBatchMethod := wasmd.ABI.Methods["executeBatch"]
+	args, err := executeBatchMethod.Inputs.Pack([]executeMsg{
+		{ContractAddress: first.String(), Msg: []byte(`{"echo":{"message":"one"}}`), Funds: fundsBz},
+		{ContractAddress: second.String(), Msg: []byte(`{"echo":{"message":"two"}}`), Funds: []byte("[]")},
+	})
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(executeBatchMethod.ID, args...), suppliedGas, true, nil)
+	require.ErrorContains(t, err, "staticcall")
+	res, _, err := p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(executeBatchMethod.ID, args...), suppliedGas, false, nil)
+	require.Nil(t, err)
+	rets, err := executeBatchMethod.Outputs.Unpack(res)
+	require.Nil(t, err)
+	responses := rets[0].([][]byte)
+	require.Len(t, responses, 2)
+	require.Contains(t, string(responses[0]), "received one")
+	require.Contains(t, string(responses[1]), "received two")
+	require.Equal(t, int64(990), tApp.GetBankKeeper().GetBalance(ctx, mockAddr, "ukava").Amount.Int64())
+
+	// each execution in the batch gets its own Executed log
+	executedEvent := wasmd.ABI.Events[wasmd.ExecutedEvent]
+	logs := stateDB.Logs()
+	require.Len(t, logs, 2)
+	for i, contractAddr := range []sdk.AccAddress{first, second} {
+		require.Equal(t, registry.WasmdContractAddress, logs[i].Address)
+		require.Equal(t, []common.Hash{executedEvent.ID, common.BytesToHash(mockEVMAddr.Bytes())}, logs[i].Topics)
+		data, err := executedEvent.Inputs.NonIndexed().Unpack(logs[i].Data)
+		require.Nil(t, err)
+		require.Equal(t, contractAddr.String(), data[0].(string))
+	}
+
+	// a failing execution reverts the funds already sent by earlier ones in the batch
+	args, err = executeBatchMethod.Inputs.Pack([]executeMsg{
+		{ContractAddress: first.String(), Msg: []byte(`{"echo":{"message":"one"}}`), Funds: fundsBz},
+		{ContractAddress: second.String(), Msg: []byte(`{"unknown":{}}`), Funds: []byte("[]")},
+	})
+	require.Nil(t, err)
+	_, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(executeBatchMethod.ID, args...), suppliedGas, false, nil)
+	require.ErrorContains(t, err, "execute 1")
+	require.Equal(t, int64(990), tApp.GetBankKeeper().GetBalance(ctx, mockAddr, "ukava").Amount.Int64())
+	require.Len(t, stateDB.Logs(), 2)
+}
+
+func TestExecuteWithValue(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContextLegacy(true, tmtypes.Header{Height: 1, ChainID: "wasmd-test", Time: time.Now().UTC()})
+	tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams())
+	evmParams := tApp.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, evmParams))
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+	amts := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1000)), sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(10_000_000)))
+	tApp.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts)
+	tApp.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, mockAddr, amts)
+
+	code, err := os.ReadFile("../../cosmwasm/echo/artifacts/echo.wasm")
+	require.Nil(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, mockAddr, code, nil)
+	require.Nil(t, err)
+	contractAddr, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, mockAddr, nil, []byte("{}"), "test", nil)
+	require.Nil(t, err)
+
+	p := wasmd.NewContract(tApp.ContractKeeper, tApp.WasmKeeper, tApp.EvmKeeper)
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := vm.EVM{
+		StateDB: stateDB,
+	}
+	// the EVM moves msg.value from the caller to the precompile before calling it
+	call := func(value *big.Int) error {
+		stateDB.SubBalance(mockEVMAddr, value)
+		stateDB.AddBalance(registry.WasmdContractAddress, value)
+		fundsBz, _ := json.Marshal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10))))
+		executeMethod := wasmd.ABI.Methods["execute"]
+		args, err := executeMethod.Inputs.Pack(contractAddr.String(), []byte(`{"echo":{"message":"paid"}}`), fundsBz)
+		require.Nil(t, err)
+		_, _, err = p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress, append(executeMethod.ID, args...), uint64(10_000_000), false, value)
+		return err
+	}
+
+	snapshot := stateDB.Snapshot()
+	require.ErrorContains(t, call(big.NewInt(1)), "multiple of")
+	stateDB.RevertToSnapshot(snapshot)
+
+	// 2.5 orai is deposited along with the coins from the funds argument
+	require.Nil(t, call(appconfig.ToEvmAmount(big.NewInt(2_500_000))))
+	require.NoError(t, stateDB.Commit())
+
+	bankKeeper := tApp.GetBankKeeper()
+	require.Equal(t, int64(2_500_000), bankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount.Int64())
+	require.Equal(t, int64(10), bankKeeper.GetBalance(ctx, contractAddr, "ukava").Amount.Int64())
+	require.Equal(t, int64(7_500_000), bankKeeper.GetBalance(ctx, mockAddr, appconfig.CosmosDenom).Amount.Int64())
+	require.True(t, bankKeeper.GetBalance(ctx, sdk.AccAddress(registry.WasmdContractAddress.Bytes()), appconfig.CosmosDenom).IsZero())
+}
+
+// MockQueryWasmer answers every smart query with a fixed response.
+type MockQueryWasmer struct {
+	MockWasmer
+	response []byte
+}
+
+func (m *MockQueryWasmer) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	return m.response, nil
+}
+
+func TestQueryTyped(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	mockAddr, mockEVMAddr := MockAddressPair()
+	tApp.EvmKeeper.SetAddressMapping(ctx, mockAddr, mockEVMAddr)
+
+	wasmer := &MockQueryWasmer{response: []byte(`{
+		"assets": [
+			{"info": {"native_token": {"denom": "orai"}}, "amount": "1000"},
+			{"info": {"token": {"contract_addr": "` + mockAddr.String() + `"}}, "amount": "2000"}
+		],
+		"total_share": "340282366920938463463374607431768211456",
+		"price": -5,
+		"frozen": false,
+		"data": "aGVsbG8=",
+		"owner": "` + mockAddr.String() + `",
+		"tags": ["a", "b"],
+		"nullable": null
+	}`)}
+	p := wasmd.NewContract(wasmer, wasmer, tApp.EvmKeeper)
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	method := wasmd.ABI.Methods["queryTyped"]
+	run := func(schema string) ([]byte, error) {
+		args, err := method.Inputs.Pack(mockAddr.String(), []byte(`{"pool":{}}`), schema)
+		require.NoError(t, err)
+		res, _, err := p.Run(&evm, mockEVMAddr, registry.WasmdContractAddress,
+			append(method.ID, args...),
+			uint64(10_000_000),
+			true,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rets, err := method.Outputs.Unpack(res)
+		require.NoError(t, err)
+		return rets[0].([]byte), nil
+	}
+
+	res, err := run("uint256 total_share, int64 price, bool frozen, bytes data, address owner, string[] tags, string assets[0].info.native_token.denom")
+	require.NoError(t, err)
+	stringTy, _ := abi.NewType("string", "", nil)
+	uint256Ty, _ := abi.NewType("uint256", "", nil)
+	int64Ty, _ := abi.NewType("int64", "", nil)
+	boolTy, _ := abi.NewType("bool", "", nil)
+	bytesTy, _ := abi.NewType("bytes", "", nil)
+	addressTy, _ := abi.NewType("address", "", nil)
+	stringsTy, _ := abi.NewType("string[]", "", nil)
+	decoded, err := abi.Arguments{{Type: uint256Ty}, {Type: int64Ty}, {Type: boolTy}, {Type: bytesTy}, {Type: addressTy}, {Type: stringsTy}, {Type: stringTy}}.Unpack(res)
+	require.NoError(t, err)
+	totalShare, _ := new(big.Int).SetString("340282366920938463463374607431768211456", 10)
+	require.Equal(t, []interface{}{totalShare, int64(-5), false, []byte("hello"), mockEVMAddr, []string{"a", "b"}, "orai"}, decoded)
+
+	// tuple arrays select their fields from every element
+	res, err = run("(uint128 amount)[] assets")
+	require.NoError(t, err)
+	tupleTy, _ := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{{Name: "amount", Type: "uint128"}})
+	decoded, err = abi.Arguments{{Type: tupleTy}}.Unpack(res)
+	require.NoError(t, err)
+	amounts := decoded[0].([]struct {
+		Amount *big.Int `json:"amount"`
+	})
+	require.Len(t, amounts, 2)
+	require.Equal(t, big.NewInt(1000), amounts[0].Amount)
+	require.Equal(t, big.NewInt(2000), amounts[1].Amount)
+
+	for _, schema := range []string{
+		"uint128 total_share",       // overflow
+		"uint256 price",             // negative into unsigned
+		"string nullable",           // null
+		"uint256 missing",           // missing key
+		"bytes32 data",              // unsupported type
+		"(uint256 amount assets",    // unterminated tuple
+		"uint256 total_share extra", // trailing garbage
+		"",                          // empty
+	} {
+		_, err := run(schema)
+		require.Error(t, err, schema)
+	}
+}
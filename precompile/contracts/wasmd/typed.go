@@ -0,0 +1,234 @@
+package wasmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// schemaField is one value of a queryTyped schema: its type and the JSON path it is read from.
+type schemaField struct {
+	typ        string
+	components []schemaField
+	path       string
+}
+
+// parseSchema parses the type descriptor of queryTyped. A descriptor is a comma separated list
+// of `type path` fields such as `uint256 balance` or `(string denom,uint256 amount)[] coins`.
+// Paths use the json precompile syntax and are relative to the enclosing value, inside a tuple
+// array they select from each element. A field without a path decodes the enclosing value itself.
+func parseSchema(schema string) ([]schemaField, error) {
+	fields, rest, err := parseFields(schema)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("unexpected %q in schema", rest)
+	}
+	return fields, nil
+}
+
+func parseFields(s string) ([]schemaField, string, error) {
+	var fields []schemaField
+	for {
+		field, rest, err := parseField(s)
+		if err != nil {
+			return nil, "", err
+		}
+		fields = append(fields, field)
+		if !strings.HasPrefix(rest, ",") {
+			return fields, rest, nil
+		}
+		s = rest[1:]
+	}
+}
+
+func parseField(s string) (schemaField, string, error) {
+	var field schemaField
+	s = strings.TrimLeft(s, " ")
+	if strings.HasPrefix(s, "(") {
+		components, rest, err := parseFields(s[1:])
+		if err != nil {
+			return field, "", err
+		}
+		if !strings.HasPrefix(rest, ")") {
+			return field, "", fmt.Errorf("unterminated tuple in schema")
+		}
+		field.typ, field.components, s = "tuple", components, rest[1:]
+	} else {
+		end := strings.IndexAny(s, " [,)")
+		if end < 0 {
+			end = len(s)
+		}
+		if end == 0 {
+			return field, "", fmt.Errorf("missing type in schema")
+		}
+		field.typ, s = s[:end], s[end:]
+	}
+	for strings.HasPrefix(s, "[]") {
+		field.typ, s = field.typ+"[]", s[2:]
+	}
+
+	s = strings.TrimLeft(s, " ")
+	end := strings.IndexAny(s, " ,)")
+	if end < 0 {
+		end = len(s)
+	}
+	field.path, s = s[:end], strings.TrimLeft(s[end:], " ")
+	return field, s, nil
+}
+
+// abiArguments returns the ABI arguments the fields are encoded as.
+func abiArguments(fields []schemaField) (abi.Arguments, error) {
+	args := make(abi.Arguments, 0, len(fields))
+	for _, field := range fields {
+		t, err := abi.NewType(field.typ, "", abiComponents(field.components))
+		if err != nil {
+			return nil, fmt.Errorf("invalid type %s in schema: %w", field.typ, err)
+		}
+		args = append(args, abi.Argument{Type: t})
+	}
+	return args, nil
+}
+
+func abiComponents(fields []schemaField) []abi.ArgumentMarshaling {
+	components := make([]abi.ArgumentMarshaling, 0, len(fields))
+	for i, field := range fields {
+		components = append(components, abi.ArgumentMarshaling{
+			Name:       fmt.Sprintf("field%d", i),
+			Type:       field.typ,
+			Components: abiComponents(field.components),
+		})
+	}
+	return components
+}
+
+// decodeTyped ABI encodes the fields read from the JSON document response.
+func decodeTyped(ctx sdk.Context, evmKeeper pcommon.EVMKeeper, response []byte, schema string) ([]byte, error) {
+	fields, err := parseSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	args, err := abiArguments(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		v, err := decodeField(ctx, evmKeeper, response, field, args[i].Type)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v.Interface())
+	}
+	return args.Pack(values...)
+}
+
+func decodeField(ctx sdk.Context, evmKeeper pcommon.EVMKeeper, raw json.RawMessage, field schemaField, t abi.Type) (reflect.Value, error) {
+	if field.path != "" {
+		var err error
+		if raw, err = pcommon.ExtractJSON(raw, field.path); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	return decodeValue(ctx, evmKeeper, raw, field, t)
+}
+
+func decodeValue(ctx sdk.Context, evmKeeper pcommon.EVMKeeper, raw json.RawMessage, field schemaField, t abi.Type) (reflect.Value, error) {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return reflect.Value{}, fmt.Errorf("unexpected null value for %s", t.String())
+	}
+
+	switch t.T {
+	case abi.UintTy, abi.IntTy:
+		n, ok := new(big.Int).SetString(strings.Trim(string(raw), "\""), 10)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("value %s is not an integer", raw)
+		}
+		if !fitsInteger(n, t) {
+			return reflect.Value{}, fmt.Errorf("value %s does not fit in %s", raw, t.String())
+		}
+		if t.GetType() == reflect.TypeOf(n) {
+			return reflect.ValueOf(n), nil
+		}
+		v := reflect.New(t.GetType()).Elem()
+		if t.T == abi.UintTy {
+			v.SetUint(n.Uint64())
+		} else {
+			v.SetInt(n.Int64())
+		}
+		return v, nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, fmt.Errorf("value %s is not a bool", raw)
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, fmt.Errorf("value %s is not a string", raw)
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, fmt.Errorf("value %s is not a string", raw)
+		}
+		address, err := pcommon.ResolveEvmAddress(ctx, evmKeeper, s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(address), nil
+	case abi.BytesTy:
+		// binary values are base64 encoded by cosmwasm
+		var bz []byte
+		if err := json.Unmarshal(raw, &bz); err != nil {
+			return reflect.Value{}, fmt.Errorf("value %s is not base64 encoded bytes", raw)
+		}
+		return reflect.ValueOf(bz), nil
+	case abi.SliceTy:
+		var elements []json.RawMessage
+		if err := json.Unmarshal(raw, &elements); err != nil {
+			return reflect.Value{}, fmt.Errorf("value %s is not an array", raw)
+		}
+		slice := reflect.MakeSlice(t.GetType(), len(elements), len(elements))
+		for i, element := range elements {
+			v, err := decodeValue(ctx, evmKeeper, element, field, *t.Elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			slice.Index(i).Set(v)
+		}
+		return slice, nil
+	case abi.TupleTy:
+		tuple := reflect.New(t.TupleType).Elem()
+		for i, component := range field.components {
+			v, err := decodeField(ctx, evmKeeper, raw, component, *t.TupleElems[i])
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			tuple.Field(i).Set(v)
+		}
+		return tuple, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type %s in schema", t.String())
+	}
+}
+
+// fitsInteger reports whether n is in the range of the integer type t.
+func fitsInteger(n *big.Int, t abi.Type) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	return n.Cmp(limit) < 0 && n.Cmp(new(big.Int).Neg(limit)) >= 0
+}
@@ -0,0 +1,239 @@
+#[cfg(not(feature = "library"))]
+use cosmwasm_std::entry_point;
+use cosmwasm_std::{
+    to_json_binary, Binary, Deps, DepsMut, Env, MessageInfo, Response, StdResult, Uint128, WasmMsg,
+};
+use cw20::{AllowanceResponse, BalanceResponse, Cw20ReceiveMsg, Expiration, TokenInfoResponse};
+use cw_storage_plus::Item;
+
+use crate::error::ContractError;
+use crate::evm::{
+    self, evm_address, Erc20AllowanceResponse, Erc20BalanceResponse, Erc20TokenInfoResponse,
+    EvmCustomMsg, EvmCustomQuery, EvmMsg, EvmQuery,
+};
+use crate::msg::{ExecuteMsg, InstantiateMsg, QueryMsg};
+
+/// The 0x address of the ERC20 contract the pointer is backed by.
+pub const ERC20_ADDRESS: Item<String> = Item::new("erc20_address");
+
+/// Declares that the contract needs the "evm" bindings of the chain.
+#[cfg(not(feature = "library"))]
+#[no_mangle]
+extern "C" fn requires_evm() {}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn instantiate(
+    deps: DepsMut<EvmCustomQuery>,
+    _env: Env,
+    _info: MessageInfo,
+    msg: InstantiateMsg,
+) -> Result<Response<EvmCustomMsg>, ContractError> {
+    evm::validate_address(&msg.erc20_address)?;
+    ERC20_ADDRESS.save(deps.storage, &msg.erc20_address)?;
+    Ok(Response::new().add_attribute("erc20_address", msg.erc20_address))
+}
+
+/// Every message is executed as an ERC20 call from the EVM address of its sender, which the
+/// chain only accepts once governance registered this contract as the pointer of the ERC20.
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn execute(
+    deps: DepsMut<EvmCustomQuery>,
+    _env: Env,
+    info: MessageInfo,
+    msg: ExecuteMsg,
+) -> Result<Response<EvmCustomMsg>, ContractError> {
+    let erc20 = ERC20_ADDRESS.load(deps.storage)?;
+    let sender = info.sender.to_string();
+    match msg {
+        ExecuteMsg::Transfer { recipient, amount } => {
+            let data = evm::transfer(&evm_address(&deps.querier, &recipient)?, amount)?;
+            Ok(Response::new()
+                .add_message(delegate_call(&sender, &erc20, data))
+                .add_attribute("action", "transfer")
+                .add_attribute("from", sender)
+                .add_attribute("to", recipient)
+                .add_attribute("amount", amount))
+        }
+        ExecuteMsg::TransferFrom {
+            owner,
+            recipient,
+            amount,
+        } => {
+            let data = evm::transfer_from(
+                &evm_address(&deps.querier, &owner)?,
+                &evm_address(&deps.querier, &recipient)?,
+                amount,
+            )?;
+            Ok(Response::new()
+                .add_message(delegate_call(&sender, &erc20, data))
+                .add_attribute("action", "transfer_from")
+                .add_attribute("from", owner)
+                .add_attribute("to", recipient)
+                .add_attribute("by", sender)
+                .add_attribute("amount", amount))
+        }
+        ExecuteMsg::Send {
+            contract,
+            amount,
+            msg,
+        } => {
+            let data = evm::transfer(&evm_address(&deps.querier, &contract)?, amount)?;
+            let receive = receive_msg(&sender, &contract, amount, msg)?;
+            Ok(Response::new()
+                .add_message(delegate_call(&sender, &erc20, data))
+                .add_message(receive)
+                .add_attribute("action", "send")
+                .add_attribute("from", sender)
+                .add_attribute("to", contract)
+                .add_attribute("amount", amount))
+        }
+        ExecuteMsg::SendFrom {
+            owner,
+            contract,
+            amount,
+            msg,
+        } => {
+            let data = evm::transfer_from(
+                &evm_address(&deps.querier, &owner)?,
+                &evm_address(&deps.querier, &contract)?,
+                amount,
+            )?;
+            let receive = receive_msg(&sender, &contract, amount, msg)?;
+            Ok(Response::new()
+                .add_message(delegate_call(&sender, &erc20, data))
+                .add_message(receive)
+                .add_attribute("action", "send_from")
+                .add_attribute("from", owner)
+                .add_attribute("to", contract)
+                .add_attribute("by", sender)
+                .add_attribute("amount", amount))
+        }
+        ExecuteMsg::IncreaseAllowance {
+            spender,
+            amount,
+            expires,
+        } => {
+            let current = allowance(deps.as_ref(), &erc20, &sender, &spender)?;
+            set_allowance(
+                deps,
+                &erc20,
+                sender,
+                spender,
+                current.checked_add(amount)?,
+                expires,
+            )
+        }
+        ExecuteMsg::DecreaseAllowance {
+            spender,
+            amount,
+            expires,
+        } => {
+            let current = allowance(deps.as_ref(), &erc20, &sender, &spender)?;
+            set_allowance(
+                deps,
+                &erc20,
+                sender,
+                spender,
+                current.saturating_sub(amount),
+                expires,
+            )
+        }
+        _ => Err(ContractError::Unsupported {}),
+    }
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn query(deps: Deps<EvmCustomQuery>, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+    let erc20 = ERC20_ADDRESS.load(deps.storage)?;
+    match msg {
+        QueryMsg::Balance { address } => {
+            let res: Erc20BalanceResponse = deps.querier.query(
+                &EvmCustomQuery::Evm(EvmQuery::Erc20Balance {
+                    contract: erc20,
+                    address,
+                })
+                .into(),
+            )?;
+            to_json_binary(&BalanceResponse {
+                balance: res.balance,
+            })
+        }
+        QueryMsg::TokenInfo {} => {
+            let res: Erc20TokenInfoResponse = deps
+                .querier
+                .query(&EvmCustomQuery::Evm(EvmQuery::Erc20TokenInfo { contract: erc20 }).into())?;
+            to_json_binary(&TokenInfoResponse {
+                name: res.name,
+                symbol: res.symbol,
+                decimals: res.decimals,
+                total_supply: res.total_supply,
+            })
+        }
+        QueryMsg::Allowance { owner, spender } => to_json_binary(&AllowanceResponse {
+            allowance: allowance(deps, &erc20, &owner, &spender)?,
+            expires: Expiration::Never {},
+        }),
+        _ => Err(cosmwasm_std::StdError::generic_err(
+            "query is not supported by an erc20 pointer",
+        )),
+    }
+}
+
+fn delegate_call(from: &str, erc20: &str, data: Binary) -> EvmCustomMsg {
+    EvmCustomMsg::Evm(EvmMsg::DelegateCall {
+        from: from.to_string(),
+        to: erc20.to_string(),
+        data,
+    })
+}
+
+fn receive_msg(sender: &str, contract: &str, amount: Uint128, msg: Binary) -> StdResult<WasmMsg> {
+    Ok(WasmMsg::Execute {
+        contract_addr: contract.to_string(),
+        msg: Cw20ReceiveMsg {
+            sender: sender.to_string(),
+            amount,
+            msg,
+        }
+        .into_binary()?,
+        funds: vec![],
+    })
+}
+
+fn allowance(
+    deps: Deps<EvmCustomQuery>,
+    erc20: &str,
+    owner: &str,
+    spender: &str,
+) -> StdResult<Uint128> {
+    let res: Erc20AllowanceResponse = deps.querier.query(
+        &EvmCustomQuery::Evm(EvmQuery::Erc20Allowance {
+            contract: erc20.to_string(),
+            owner: owner.to_string(),
+            spender: spender.to_string(),
+        })
+        .into(),
+    )?;
+    Ok(res.allowance)
+}
+
+/// ERC20 allowances are absolute, so the new allowance is approved as a whole.
+fn set_allowance(
+    deps: DepsMut<EvmCustomQuery>,
+    erc20: &str,
+    owner: String,
+    spender: String,
+    amount: Uint128,
+    expires: Option<Expiration>,
+) -> Result<Response<EvmCustomMsg>, ContractError> {
+    if !matches!(expires, None | Some(Expiration::Never {})) {
+        return Err(ContractError::ExpiringAllowance {});
+    }
+    let data = evm::approve(&evm_address(&deps.querier, &spender)?, amount)?;
+    Ok(Response::new()
+        .add_message(delegate_call(&owner, erc20, data))
+        .add_attribute("action", "approve")
+        .add_attribute("owner", owner)
+        .add_attribute("spender", spender)
+        .add_attribute("amount", amount))
+}
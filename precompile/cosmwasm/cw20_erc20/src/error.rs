@@ -0,0 +1,20 @@
+use cosmwasm_std::{OverflowError, StdError};
+use thiserror::Error;
+
+#[derive(Error, Debug)]
+pub enum ContractError {
+    #[error("{0}")]
+    Std(#[from] StdError),
+
+    #[error("{0}")]
+    Overflow(#[from] OverflowError),
+
+    #[error("invalid erc20 address {address}")]
+    InvalidErc20Address { address: String },
+
+    #[error("allowances of an erc20 pointer cannot expire")]
+    ExpiringAllowance {},
+
+    #[error("message is not supported by an erc20 pointer")]
+    Unsupported {},
+}
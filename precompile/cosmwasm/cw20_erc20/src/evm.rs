@@ -0,0 +1,170 @@
+use cosmwasm_schema::cw_serde;
+use cosmwasm_std::{Binary, CustomMsg, CustomQuery, QuerierWrapper, StdError, StdResult, Uint128};
+
+use crate::error::ContractError;
+
+/// Messages of the "evm" bindings of the chain.
+#[cw_serde]
+pub enum EvmCustomMsg {
+    Evm(EvmMsg),
+}
+
+impl CustomMsg for EvmCustomMsg {}
+
+#[cw_serde]
+pub enum EvmMsg {
+    /// Calls the ERC20 contract `to` from the EVM address of `from`. Only accepted from the
+    /// registered pointer of the ERC20.
+    DelegateCall {
+        from: String,
+        to: String,
+        data: Binary,
+    },
+}
+
+/// Queries of the "evm" bindings of the chain.
+#[cw_serde]
+pub enum EvmCustomQuery {
+    Evm(EvmQuery),
+}
+
+impl CustomQuery for EvmCustomQuery {}
+
+#[cw_serde]
+pub enum EvmQuery {
+    Erc20Balance {
+        contract: String,
+        address: String,
+    },
+    Erc20TokenInfo {
+        contract: String,
+    },
+    Erc20Allowance {
+        contract: String,
+        owner: String,
+        spender: String,
+    },
+    AddressMapping {
+        address: String,
+    },
+}
+
+#[cw_serde]
+pub struct Erc20BalanceResponse {
+    pub balance: Uint128,
+}
+
+#[cw_serde]
+pub struct Erc20TokenInfoResponse {
+    pub name: String,
+    pub symbol: String,
+    pub decimals: u8,
+    pub total_supply: Uint128,
+}
+
+#[cw_serde]
+pub struct Erc20AllowanceResponse {
+    pub allowance: Uint128,
+}
+
+#[cw_serde]
+pub struct AddressMappingResponse {
+    pub cosmos_address: String,
+    pub evm_address: String,
+    pub mapped: bool,
+}
+
+const TRANSFER_SELECTOR: [u8; 4] = [0xa9, 0x05, 0x9c, 0xbb];
+const TRANSFER_FROM_SELECTOR: [u8; 4] = [0x23, 0xb8, 0x72, 0xdd];
+const APPROVE_SELECTOR: [u8; 4] = [0x09, 0x5e, 0xa7, 0xb3];
+
+/// Returns the EVM address of a bech32 account, as the chain maps it.
+pub fn evm_address(querier: &QuerierWrapper<EvmCustomQuery>, address: &str) -> StdResult<String> {
+    let res: AddressMappingResponse = querier.query(
+        &EvmCustomQuery::Evm(EvmQuery::AddressMapping {
+            address: address.to_string(),
+        })
+        .into(),
+    )?;
+    Ok(res.evm_address)
+}
+
+/// Checks that address is a 0x hex EVM address.
+pub fn validate_address(address: &str) -> Result<(), ContractError> {
+    address_word(address)
+        .map(|_| ())
+        .map_err(|_| ContractError::InvalidErc20Address {
+            address: address.to_string(),
+        })
+}
+
+/// ABI encodes `transfer(address to, uint256 amount)`.
+pub fn transfer(to: &str, amount: Uint128) -> StdResult<Binary> {
+    encode_call(TRANSFER_SELECTOR, &[address_word(to)?, uint_word(amount)])
+}
+
+/// ABI encodes `transferFrom(address from, address to, uint256 amount)`.
+pub fn transfer_from(from: &str, to: &str, amount: Uint128) -> StdResult<Binary> {
+    encode_call(
+        TRANSFER_FROM_SELECTOR,
+        &[address_word(from)?, address_word(to)?, uint_word(amount)],
+    )
+}
+
+/// ABI encodes `approve(address spender, uint256 amount)`.
+pub fn approve(spender: &str, amount: Uint128) -> StdResult<Binary> {
+    encode_call(
+        APPROVE_SELECTOR,
+        &[address_word(spender)?, uint_word(amount)],
+    )
+}
+
+fn encode_call(selector: [u8; 4], words: &[[u8; 32]]) -> StdResult<Binary> {
+    let mut data = selector.to_vec();
+    for word in words {
+        data.extend_from_slice(word);
+    }
+    Ok(Binary::from(data))
+}
+
+fn address_word(address: &str) -> StdResult<[u8; 32]> {
+    let hex_address = address
+        .strip_prefix("0x")
+        .ok_or_else(|| StdError::generic_err(format!("invalid evm address {}", address)))?;
+    let bytes = hex::decode(hex_address)
+        .map_err(|_| StdError::generic_err(format!("invalid evm address {}", address)))?;
+    if bytes.len() != 20 {
+        return Err(StdError::generic_err(format!(
+            "invalid evm address {}",
+            address
+        )));
+    }
+    let mut word = [0u8; 32];
+    word[12..].copy_from_slice(&bytes);
+    Ok(word)
+}
+
+fn uint_word(amount: Uint128) -> [u8; 32] {
+    let mut word = [0u8; 32];
+    word[16..].copy_from_slice(&amount.u128().to_be_bytes());
+    word
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn encodes_transfer() {
+        let data = transfer(
+            "0x00000000000000000000000000000000000000ff",
+            Uint128::new(258),
+        )
+        .unwrap();
+        assert_eq!(data.len(), 68);
+        assert_eq!(data[..4], TRANSFER_SELECTOR);
+        assert_eq!(data[35], 0xff);
+        assert_eq!(data[66..], [0x01, 0x02]);
+        assert!(transfer("orai1xyz", Uint128::zero()).is_err());
+    }
+}
@@ -0,0 +1,4 @@
+pub mod contract;
+pub mod error;
+pub mod evm;
+pub mod msg;
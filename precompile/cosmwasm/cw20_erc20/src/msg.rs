@@ -0,0 +1,11 @@
+use cosmwasm_schema::cw_serde;
+
+#[cw_serde]
+pub struct InstantiateMsg {
+    /// The 0x address of the ERC20 contract the pointer is backed by.
+    pub erc20_address: String,
+}
+
+/// The pointer serves the CW20 messages and queries on balances and allowances.
+pub use cw20::Cw20ExecuteMsg as ExecuteMsg;
+pub use cw20::Cw20QueryMsg as QueryMsg;
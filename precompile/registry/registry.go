@@ -1,32 +1,178 @@
 package registry
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
 	pcommon "github.com/CosmWasm/wasmd/precompile/common"
 	"github.com/CosmWasm/wasmd/precompile/contracts/addr"
 	"github.com/CosmWasm/wasmd/precompile/contracts/bank"
+	"github.com/CosmWasm/wasmd/precompile/contracts/cw20"
+	"github.com/CosmWasm/wasmd/precompile/contracts/distribution"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
+	"github.com/CosmWasm/wasmd/precompile/contracts/gov"
+	"github.com/CosmWasm/wasmd/precompile/contracts/ibctransfer"
 	"github.com/CosmWasm/wasmd/precompile/contracts/json"
+	"github.com/CosmWasm/wasmd/precompile/contracts/staking"
+	"github.com/CosmWasm/wasmd/precompile/contracts/tokenfactory"
 	"github.com/CosmWasm/wasmd/precompile/contracts/wasmd"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/ethereum/go-ethereum/precompile/modules"
+	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
 var (
 	// WasmdContractAddress the primary noop contract address for testing
-	WasmdContractAddress = common.HexToAddress("0x9000000000000000000000000000000000000001")
-	JsonContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000002")
-	AddrContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000003")
-	BankContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000004")
+	WasmdContractAddress        = common.HexToAddress("0x9000000000000000000000000000000000000001")
+	JsonContractAddress         = common.HexToAddress("0x9000000000000000000000000000000000000002")
+	AddrContractAddress         = common.HexToAddress("0x9000000000000000000000000000000000000003")
+	BankContractAddress         = common.HexToAddress("0x9000000000000000000000000000000000000004")
+	Erc20ContractAddress        = common.HexToAddress("0x9000000000000000000000000000000000000005")
+	StakingContractAddress      = common.HexToAddress("0x9000000000000000000000000000000000000006")
+	DistributionContractAddress = common.HexToAddress("0x9000000000000000000000000000000000000007")
+	GovContractAddress          = common.HexToAddress("0x9000000000000000000000000000000000000008")
+	IBCTransferContractAddress  = common.HexToAddress("0x9000000000000000000000000000000000000009")
+	TokenFactoryContractAddress = common.HexToAddress("0x900000000000000000000000000000000000000a")
+	Cw20ContractAddress         = common.HexToAddress("0x900000000000000000000000000000000000000b")
+)
+
+// Registry is the set of stateful precompile contracts owned by one app. It is built once
+// when the app is constructed and never modified afterwards.
+type Registry struct {
+	contracts  map[common.Address]contract.StatefulPrecompiledContract
+	abis       map[common.Address]string
+	activation pcommon.PrecompileActivationKeeper
+}
+
+// Addresses returns the addresses of all precompiles in ascending order.
+func Addresses() []common.Address {
+	return []common.Address{
+		WasmdContractAddress,
+		JsonContractAddress,
+		AddrContractAddress,
+		BankContractAddress,
+		Erc20ContractAddress,
+		StakingContractAddress,
+		DistributionContractAddress,
+		GovContractAddress,
+		IBCTransferContractAddress,
+		TokenFactoryContractAddress,
+		Cw20ContractAddress,
+	}
+}
+
+// NewRegistry instantiates every stateful precompile contract on top of the given keepers.
+// Calls are only served while activationKeeper reports the precompile as active.
+func NewRegistry(activationKeeper pcommon.PrecompileActivationKeeper, wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdViewKeeper, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, accountKeeper pcommon.AccountKeeper, stakingMsgServer pcommon.StakingMsgServer, stakingQuerier pcommon.StakingQuerier, distrMsgServer pcommon.DistributionMsgServer, distrQuerier pcommon.DistributionQuerier, govMsgServer pcommon.GovMsgServer, govQuerier pcommon.GovQuerier, transferKeeper pcommon.IBCTransferKeeper, tokenFactoryMsgServer pcommon.TokenFactoryMsgServer, tokenFactoryQuerier pcommon.TokenFactoryQuerier) *Registry {
+	return &Registry{
+		contracts: map[common.Address]contract.StatefulPrecompiledContract{
+			WasmdContractAddress:        wasmd.NewContract(wasmdKeeper, wasmdViewKeeper, evmKeeper),
+			JsonContractAddress:         json.NewContract(evmKeeper),
+			AddrContractAddress:         addr.NewContract(evmKeeper),
+			BankContractAddress:         bank.NewContract(evmKeeper, bankKeeper, accountKeeper),
+			Erc20ContractAddress:        erc20.NewContract(evmKeeper, bankKeeper),
+			StakingContractAddress:      staking.NewContract(evmKeeper, bankKeeper, stakingMsgServer, stakingQuerier),
+			DistributionContractAddress: distribution.NewContract(evmKeeper, bankKeeper, distrMsgServer, distrQuerier),
+			GovContractAddress:          gov.NewContract(evmKeeper, bankKeeper, govMsgServer, govQuerier),
+			IBCTransferContractAddress:  ibctransfer.NewContract(evmKeeper, bankKeeper, transferKeeper),
+			TokenFactoryContractAddress: tokenfactory.NewContract(evmKeeper, bankKeeper, tokenFactoryMsgServer, tokenFactoryQuerier),
+			Cw20ContractAddress:         cw20.NewContract(wasmdKeeper, wasmdViewKeeper, evmKeeper),
+		},
+		abis: map[common.Address]string{
+			WasmdContractAddress:        wasmd.RawABI,
+			JsonContractAddress:         json.RawABI,
+			AddrContractAddress:         addr.RawABI,
+			BankContractAddress:         bank.RawABI,
+			Erc20ContractAddress:        erc20.RawABI,
+			StakingContractAddress:      staking.RawABI,
+			DistributionContractAddress: distribution.RawABI,
+			GovContractAddress:          gov.RawABI,
+			IBCTransferContractAddress:  ibctransfer.RawABI,
+			TokenFactoryContractAddress: tokenfactory.RawABI,
+			Cw20ContractAddress:         cw20.RawABI,
+		},
+		activation: activationKeeper,
+	}
+}
+
+// Contract returns the precompile contract deployed at address, if any.
+func (r *Registry) Contract(address common.Address) (contract.StatefulPrecompiledContract, bool) {
+	c, ok := r.contracts[address]
+	return c, ok
+}
+
+// Addresses returns the addresses of the precompiles in r in ascending order.
+func (r *Registry) Addresses() []common.Address {
+	return Addresses()
+}
+
+// ABIHash returns the keccak256 hash of the JSON ABI of the precompile at address.
+func (r *Registry) ABIHash(address common.Address) (common.Hash, bool) {
+	rawABI, ok := r.abis[address]
+	if !ok {
+		return common.Hash{}, false
+	}
+	return crypto.Keccak256Hash([]byte(rawABI)), true
+}
+
+var (
+	// bound maps an EVM keeper to the Registry serving the EVM calls it executes
+	bound        sync.Map
+	registerOnce sync.Once
 )
 
-// init registers stateful precompile contracts with the global precompile registry
-// defined in kava-labs/go-ethereum/precompile/modules
-func InitializePrecompiles(wasmdKeeper pcommon.WasmdKeeper, wasmdViewKeeper pcommon.WasmdViewKeeper, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, accountKeeper pcommon.AccountKeeper) {
-	register(WasmdContractAddress, wasmd.NewContract(wasmdKeeper, wasmdViewKeeper, evmKeeper))
-	register(JsonContractAddress, json.NewContract())
-	register(AddrContractAddress, addr.NewContract(evmKeeper))
-	register(BankContractAddress, bank.NewContract(evmKeeper, bankKeeper, accountKeeper))
+// Bind makes r serve the precompile calls of every EVM executed by evmKeeper.
+//
+// go-ethereum only resolves stateful precompiles through the process global registry
+// defined in kava-labs/go-ethereum/precompile/modules. On first use that registry is filled
+// with dispatchers which forward each call to the Registry bound to the keeper of the calling
+// StateDB, so several apps can live in one process without sharing keepers.
+func Bind(evmKeeper statedb.Keeper, r *Registry) {
+	registerOnce.Do(func() {
+		for address := range r.contracts {
+			register(address, dispatcher{address: address})
+		}
+	})
+	bound.Store(evmKeeper, r)
+}
 
+// dispatcher is registered in the global precompile registry in place of a concrete contract.
+type dispatcher struct {
+	address common.Address
+}
+
+func (d dispatcher) Run(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+	value *big.Int,
+) (ret []byte, remainingGas uint64, err error) {
+	stateDB, ok := accessibleState.GetStateDB().(*statedb.StateDB)
+	if !ok {
+		return nil, 0, errors.New("cannot get context from EVM")
+	}
+	bucket, ok := bound.Load(stateDB.Keeper())
+	if !ok {
+		return nil, 0, fmt.Errorf("no precompiles bound to the evm keeper running %s", d.address.Hex())
+	}
+	r := bucket.(*Registry)
+	c, ok := r.Contract(d.address)
+	if !ok {
+		return nil, 0, fmt.Errorf("precompile %s is not registered", d.address.Hex())
+	}
+	if !r.activation.IsPrecompileActive(stateDB.Ctx(), d.address) {
+		// revert rather than fail so that callers get the reason and keep their gas
+		return pcommon.RevertReason(fmt.Sprintf("precompile %s is disabled", d.address.Hex())), suppliedGas, vm.ErrExecutionReverted
+	}
+	return c.Run(accessibleState, caller, addr, input, suppliedGas, readOnly, value)
 }
 
 // register accepts a 0x address string and a stateful precompile contract constructor, instantiates the
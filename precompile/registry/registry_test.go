@@ -1,11 +1,24 @@
 package registry_test
 
 import (
+	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/CosmWasm/wasmd/app"
+	"github.com/CosmWasm/wasmd/precompile/contracts/bank"
 	"github.com/CosmWasm/wasmd/precompile/registry"
+	precompilekeeper "github.com/CosmWasm/wasmd/x/precompile/keeper"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/precompile/modules"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestRegisteredPrecompiles asserts precompiles are registered
@@ -17,7 +30,7 @@ import (
 //     expected length, not missing 0's, etc.
 func TestRegisteredPrecompilesAddresses(t *testing.T) {
 
-	registry.InitializePrecompiles(nil, nil, nil, nil, nil)
+	registry.Bind(nil, registry.NewRegistry(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
 
 	// build list of 0x addresses that are registered
 	registeredModules := modules.RegisteredModules()
@@ -32,8 +45,100 @@ func TestRegisteredPrecompilesAddresses(t *testing.T) {
 		"0x9000000000000000000000000000000000000002", // noop
 		"0x9000000000000000000000000000000000000003", // noop
 		"0x9000000000000000000000000000000000000004", // noop
+		"0x9000000000000000000000000000000000000005", // noop
+		"0x9000000000000000000000000000000000000006", // noop
+		"0x9000000000000000000000000000000000000007", // noop
+		"0x9000000000000000000000000000000000000008", // noop
+		"0x9000000000000000000000000000000000000009", // noop
+		"0x900000000000000000000000000000000000000a", // noop
+		"0x900000000000000000000000000000000000000b", // noop
 	}
 
 	assert.Equal(t, expectedPrecompiles, registeredPrecompiles,
 		"expected registered precompile address list to match to match expected")
 }
+
+// TestRegistryPerApp asserts that every app is served by the precompiles built on its own keepers,
+// even though go-ethereum resolves them through a process global registry.
+func TestRegistryPerApp(t *testing.T) {
+	denom := "ukava"
+	apps := []*app.WasmApp{app.Setup(t), app.Setup(t)}
+	contexts := make([]sdk.Context, len(apps))
+	for i, tApp := range apps {
+		contexts[i] = tApp.NewContext(true)
+		mintCoins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(int64(100*(i+1)))))
+		require.NoError(t, tApp.BankKeeper.MintCoins(contexts[i], evmtypes.ModuleName, mintCoins))
+	}
+
+	module, found := modules.GetPrecompileModuleByAddress(registry.BankContractAddress)
+	require.True(t, found)
+	method := bank.ABI.Methods[bank.SupplyMethod]
+	args, err := method.Inputs.Pack(denom)
+	require.NoError(t, err)
+
+	for i, tApp := range apps {
+		ctx := contexts[i]
+		evm := vm.EVM{
+			StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		}
+		res, _, err := module.Contract.Run(&evm, registry.BankContractAddress, registry.BankContractAddress,
+			append(method.ID, args...),
+			uint64(10_000_000),
+			true,
+			nil,
+		)
+		require.NoError(t, err)
+		output, err := method.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(int64(100*(i+1))), output[0].(*big.Int))
+	}
+}
+
+// TestPrecompileActivation asserts that a precompile removed from the allow-list reverts with a
+// reason, and that a later activation height takes effect once the chain reaches it.
+func TestPrecompileActivation(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(true)
+	bankAddress := registry.BankContractAddress.Hex()
+	require.NoError(t, tApp.PrecompileKeeper.SetParams(ctx, precompiletypes.Params{
+		Precompiles: []precompiletypes.PrecompileActivation{
+			{Address: bankAddress, Enabled: false, ActivationHeight: 0},
+			{Address: bankAddress, Enabled: true, ActivationHeight: ctx.BlockHeight() + 1},
+		},
+	}))
+
+	module, found := modules.GetPrecompileModuleByAddress(registry.BankContractAddress)
+	require.True(t, found)
+	method := bank.ABI.Methods[bank.SupplyMethod]
+	args, err := method.Inputs.Pack("ukava")
+	require.NoError(t, err)
+	run := func(ctx sdk.Context) ([]byte, uint64, error) {
+		evm := vm.EVM{
+			StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+		}
+		return module.Contract.Run(&evm, registry.BankContractAddress, registry.BankContractAddress,
+			append(method.ID, args...),
+			uint64(10_000_000),
+			true,
+			nil,
+		)
+	}
+
+	res, remainingGas, err := run(ctx)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	require.Equal(t, uint64(10_000_000), remainingGas)
+	reason, err := abi.UnpackRevert(res)
+	require.NoError(t, err)
+	require.Equal(t, "precompile "+bankAddress+" is disabled", reason)
+
+	_, _, err = run(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	require.NoError(t, err)
+
+	// only the precompiles enabled at the current height are reported
+	queryServer := precompilekeeper.NewQueryServer(tApp.PrecompileKeeper, tApp.Precompiles)
+	active, err := queryServer.ActivePrecompiles(ctx.WithBlockHeight(ctx.BlockHeight()+1), &precompiletypes.QueryActivePrecompilesRequest{})
+	require.NoError(t, err)
+	abiHash, found := tApp.Precompiles.ABIHash(registry.BankContractAddress)
+	require.True(t, found)
+	require.Equal(t, []precompiletypes.ActivePrecompile{{Address: bankAddress, AbiHash: abiHash.Hex()}}, active.Precompiles)
+}
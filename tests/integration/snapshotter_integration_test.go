@@ -62,8 +62,13 @@ func TestSnapshotter(t *testing.T) {
 				require.Equal(t, uint64(i+1), codeID)
 				srcCodeIDToChecksum[codeID] = checksum
 			}
+			// with a gasless contract
+			contractAddr, _, err := contractKeeper.Instantiate(ctx, 1, genesisAddr, nil, []byte("{}"), "gasless", nil)
+			require.NoError(t, err)
+			gaslessConfig := types.GaslessConfig{MaxGasPerExecution: 1, MaxGasPerBlock: 2, Sponsor: genesisAddr.String()}
+			require.NoError(t, contractKeeper.SetGasless(ctx, contractAddr, gaslessConfig))
 			// create snapshot
-			_, err := srcWasmApp.Commit()
+			_, err = srcWasmApp.Commit()
 			require.NoError(t, err)
 
 			snapshotHeight := uint64(srcWasmApp.LastBlockHeight())
@@ -110,6 +115,11 @@ func TestSnapshotter(t *testing.T) {
 				return false
 			})
 			assert.Equal(t, srcCodeIDToChecksum, destCodeIDToChecksum)
+
+			// and gasless contracts keep their configuration
+			gotConfig, ok := wasmKeeper.GetGaslessConfig(ctx, contractAddr)
+			require.True(t, ok)
+			assert.Equal(t, gaslessConfig, gotConfig)
 		})
 	}
 }
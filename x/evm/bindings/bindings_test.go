@@ -0,0 +1,394 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+	"github.com/CosmWasm/wasmd/precompile/contracts/erc20"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/CosmWasm/wasmd/x/evm/bindings"
+	bindingstypes "github.com/CosmWasm/wasmd/x/evm/bindings/types"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var (
+	// stores the caller in slot 0 and the value in slot 1
+	recorderCode = common.FromHex("0x336000553460015500")
+	// returns 42
+	answerCode = common.FromHex("0x602a60005260206000f3")
+	// reverts without a reason
+	revertCode = common.FromHex("0x60006000fd")
+	// logs 42 as its only topic
+	loggerCode = common.FromHex("0x602a60006000a100")
+)
+
+type capturingMessenger struct {
+	msgs []wasmvmtypes.CosmosMsg
+}
+
+func (m *capturingMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	m.msgs = append(m.msgs, msg)
+	return nil, nil, nil, nil
+}
+
+func setupEvm(t *testing.T) (*app.WasmApp, sdk.Context, map[string]common.Address) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	// the coinbase of the EVM is the block proposer
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+	params := tApp.EvmKeeper.GetParams(ctx)
+	params.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, params))
+
+	contracts := map[string]common.Address{}
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	for name, code := range map[string][]byte{"recorder": recorderCode, "answer": answerCode, "revert": revertCode, "logger": loggerCode} {
+		contracts[name] = common.BytesToAddress(crypto.Keccak256([]byte(name)))
+		stateDB.SetCode(contracts[name], code)
+	}
+	require.NoError(t, stateDB.Commit())
+	return tApp, ctx, contracts
+}
+
+func customMsg(t *testing.T, msg interface{}) wasmvmtypes.CosmosMsg {
+	bz, err := json.Marshal(msg)
+	require.NoError(t, err)
+	return wasmvmtypes.CosmosMsg{Custom: bz}
+}
+
+func TestCall(t *testing.T) {
+	tApp, ctx, contracts := setupEvm(t)
+	// contracts have 32 byte addresses
+	contractAddr := sdk.AccAddress(crypto.Keccak256([]byte("contract")))
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(10)))
+	require.NoError(t, tApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, tApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, contractAddr, coins))
+
+	wrapped := &capturingMessenger{}
+	messenger := bindings.CustomMessageDecorator(tApp.EvmKeeper, &tApp.PrecompileKeeper)(wrapped)
+	call := func(ctx sdk.Context, to common.Address, value sdkmath.Int) ([]sdk.Event, [][]byte, error) {
+		msg := customMsg(t, bindingstypes.EvmCustomMsg{Evm: &bindingstypes.EvmMsg{Call: &bindingstypes.Call{To: to.Hex(), Value: value}}})
+		events, data, _, err := messenger.DispatchMsg(ctx, contractAddr, "", msg)
+		return events, data, err
+	}
+
+	// one orai, paid from the contract's balance
+	value := sdkmath.NewIntFromBigInt(appconfig.ToEvmAmount(big.NewInt(1)))
+	events, data, err := call(ctx, contracts["recorder"], value)
+	require.NoError(t, err)
+	evmAddress := bindings.EvmAddress(ctx, tApp.EvmKeeper, contractAddr)
+	require.Equal(t, common.BytesToAddress(crypto.Keccak256(contractAddr)[12:]), evmAddress)
+	require.Equal(t, common.BytesToHash(evmAddress.Bytes()), tApp.EvmKeeper.GetState(ctx, contracts["recorder"], common.Hash{}))
+	require.Equal(t, common.BigToHash(value.BigInt()), tApp.EvmKeeper.GetState(ctx, contracts["recorder"], common.BigToHash(big.NewInt(1))))
+	require.Equal(t, int64(9), tApp.BankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount.Int64())
+	require.Equal(t, contractAddr, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, evmAddress))
+	require.Len(t, data, 1)
+	require.JSONEq(t, `{"data":null}`, string(data[0]))
+	require.Len(t, events, 1)
+	require.Equal(t, bindings.EventTypeEvmCall, events[0].Type)
+
+	// the logs of the call are emitted like those of an ethereum transaction
+	logCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, _, err = call(logCtx, contracts["logger"], sdkmath.ZeroInt())
+	require.NoError(t, err)
+	logs := txLogs(t, logCtx.EventManager().Events())
+	require.Len(t, logs, 1)
+	require.Equal(t, contracts["logger"], logs[0].Address)
+	require.Equal(t, []common.Hash{common.BigToHash(big.NewInt(42))}, logs[0].Topics)
+
+	_, _, err = call(ctx, contracts["revert"], sdkmath.ZeroInt())
+	require.ErrorContains(t, err, "execution reverted")
+	_, _, err = call(ctx, contracts["recorder"], sdkmath.NewIntFromBigInt(appconfig.ToEvmAmount(big.NewInt(100))))
+	require.ErrorContains(t, err, "insufficient balance")
+	require.Equal(t, int64(9), tApp.BankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount.Int64())
+
+	// a contract executed by the EVM cannot call back into it
+	evm := vm.EVM{
+		StateDB: statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))),
+	}
+	run := pcommon.WithGasMeter(0, func(ctx sdk.Context, _ contract.AccessibleState, _ common.Address, _ common.Address, _ []byte, _ bool, _ *big.Int) ([]byte, error) {
+		_, _, err := call(ctx, contracts["recorder"], sdkmath.ZeroInt())
+		return nil, err
+	})
+	_, _, err = run(&evm, common.Address{}, common.Address{}, nil, 10_000_000, false, nil)
+	require.ErrorContains(t, err, "cannot call the EVM from a contract executed by the EVM")
+
+	// custom messages of other bindings are passed on
+	tokenMsg := wasmvmtypes.CosmosMsg{Custom: []byte(`{"token":{"create_denom":{"subdenom":"foo"}}}`)}
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", tokenMsg)
+	require.NoError(t, err)
+	require.Equal(t, []wasmvmtypes.CosmosMsg{tokenMsg}, wrapped.msgs)
+}
+
+// TestCallMovesEvmBalance asserts that coins sent to the EVM address of a contract before its
+// first call are not left behind when the address is mapped to the contract.
+func TestCallMovesEvmBalance(t *testing.T) {
+	tApp, ctx, contracts := setupEvm(t)
+	contractAddr := sdk.AccAddress(crypto.Keccak256([]byte("contract")))
+	evmAddress := bindings.EvmAddress(ctx, tApp.EvmKeeper, contractAddr)
+	coins := sdk.NewCoins(sdk.NewCoin(appconfig.CosmosDenom, sdkmath.NewInt(10)))
+	require.NoError(t, tApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, tApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, sdk.AccAddress(evmAddress.Bytes()), coins))
+	require.Equal(t, appconfig.ToEvmAmount(big.NewInt(10)), tApp.EvmKeeper.GetBalance(ctx, evmAddress))
+
+	messenger := bindings.CustomMessageDecorator(tApp.EvmKeeper, &tApp.PrecompileKeeper)(&capturingMessenger{})
+	value := sdkmath.NewIntFromBigInt(appconfig.ToEvmAmount(big.NewInt(1)))
+	msg := customMsg(t, bindingstypes.EvmCustomMsg{Evm: &bindingstypes.EvmMsg{Call: &bindingstypes.Call{To: contracts["recorder"].Hex(), Value: value}}})
+	_, _, _, err := messenger.DispatchMsg(ctx, contractAddr, "", msg)
+	require.NoError(t, err)
+
+	require.Equal(t, contractAddr, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, evmAddress))
+	require.True(t, tApp.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(evmAddress.Bytes())).IsZero())
+	require.Equal(t, int64(9), tApp.BankKeeper.GetBalance(ctx, contractAddr, appconfig.CosmosDenom).Amount.Int64())
+	require.Equal(t, appconfig.ToEvmAmount(big.NewInt(9)), tApp.EvmKeeper.GetBalance(ctx, evmAddress))
+}
+
+// txLogs decodes the logs of the tx_log events in events.
+func txLogs(t *testing.T, events sdk.Events) []*ethtypes.Log {
+	var logs []*evmtypes.Log
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			var log evmtypes.Log
+			require.NoError(t, json.Unmarshal([]byte(attr.Value), &log))
+			logs = append(logs, &log)
+		}
+	}
+	return evmtypes.LogsToEthereum(logs)
+}
+
+func TestQueries(t *testing.T) {
+	tApp, ctx, contracts := setupEvm(t)
+	contractAddr := sdk.AccAddress(crypto.Keccak256([]byte("contract")))
+	var wrapped []wasmvmtypes.QueryRequest
+	handler := bindings.CustomQueryDecorator(tApp.EvmKeeper)(
+		wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+			wrapped = append(wrapped, request)
+			return []byte("wrapped"), nil
+		}),
+	)
+	query := func(query bindingstypes.EvmQuery, response interface{}) error {
+		bz, err := json.Marshal(bindingstypes.EvmCustomQuery{Evm: &query})
+		require.NoError(t, err)
+		res, err := handler.HandleQuery(ctx, contractAddr, wasmvmtypes.QueryRequest{Custom: bz})
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(res, response)
+	}
+
+	var staticCall bindingstypes.StaticCallResponse
+	require.NoError(t, query(bindingstypes.EvmQuery{StaticCall: &bindingstypes.StaticCall{To: contracts["answer"].Hex()}}, &staticCall))
+	require.Equal(t, common.BigToHash(big.NewInt(42)).Bytes(), staticCall.Data)
+	// static calls cannot change state
+	err := query(bindingstypes.EvmQuery{StaticCall: &bindingstypes.StaticCall{To: contracts["recorder"].Hex()}}, &staticCall)
+	require.ErrorContains(t, err, "write protection")
+
+	var balance bindingstypes.Erc20BalanceResponse
+	require.NoError(t, query(bindingstypes.EvmQuery{Erc20Balance: &bindingstypes.Erc20Balance{Contract: contracts["answer"].Hex(), Address: contractAddr.String()}}, &balance))
+	require.Equal(t, sdkmath.NewInt(42), balance.Balance)
+	err = query(bindingstypes.EvmQuery{Erc20Balance: &bindingstypes.Erc20Balance{Contract: contracts["answer"].Hex(), Address: "foo"}}, &balance)
+	require.ErrorContains(t, err, "neither a hex nor a bech32 address")
+
+	cosmosAddr := sdk.AccAddress(crypto.Keccak256([]byte("account"))[:20])
+	mappedEvmAddr := common.BytesToAddress(crypto.Keccak256([]byte("evm account")))
+	tApp.EvmKeeper.SetAddressMapping(ctx, cosmosAddr, mappedEvmAddr)
+	for _, tc := range []struct {
+		address  string
+		expected bindingstypes.AddressMappingResponse
+	}{
+		{cosmosAddr.String(), bindingstypes.AddressMappingResponse{CosmosAddress: cosmosAddr.String(), EvmAddress: mappedEvmAddr.Hex(), Mapped: true}},
+		{mappedEvmAddr.Hex(), bindingstypes.AddressMappingResponse{CosmosAddress: cosmosAddr.String(), EvmAddress: mappedEvmAddr.Hex(), Mapped: true}},
+		{contracts["answer"].Hex(), bindingstypes.AddressMappingResponse{CosmosAddress: sdk.AccAddress(contracts["answer"].Bytes()).String(), EvmAddress: contracts["answer"].Hex()}},
+		{contractAddr.String(), bindingstypes.AddressMappingResponse{CosmosAddress: contractAddr.String(), EvmAddress: common.BytesToAddress(crypto.Keccak256(contractAddr)[12:]).Hex()}},
+	} {
+		var mapping bindingstypes.AddressMappingResponse
+		require.NoError(t, query(bindingstypes.EvmQuery{AddressMapping: &bindingstypes.AddressMapping{Address: tc.address}}, &mapping))
+		require.Equal(t, tc.expected, mapping)
+	}
+
+	// queries of other bindings are passed on
+	tokenQuery := wasmvmtypes.QueryRequest{Custom: []byte(`{"token":{"params":{}}}`)}
+	res, err := handler.HandleQuery(ctx, contractAddr, tokenQuery)
+	require.NoError(t, err)
+	require.Equal(t, []byte("wrapped"), res)
+	require.Equal(t, []wasmvmtypes.QueryRequest{tokenQuery}, wrapped)
+}
+
+// setupErc20 deploys the bank pointer of a denom with a balance of 100 for owner and returns the
+// pointer, an ERC20 contract served by the erc20 precompile.
+func setupErc20(t *testing.T, tApp *app.WasmApp, ctx sdk.Context, owner sdk.AccAddress) common.Address {
+	denom := "factory/orai1creator/token"
+	tApp.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		Display:    "token",
+		Name:       "Factory Token",
+		Symbol:     "TKN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "token", Exponent: 6}},
+	})
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	require.NoError(t, tApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins))
+	require.NoError(t, tApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, owner, coins))
+
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	method := erc20.ABI.Methods[erc20.RegisterPointerMethod]
+	args, err := method.Inputs.Pack(denom)
+	require.NoError(t, err)
+	p := erc20.NewContract(tApp.EvmKeeper, tApp.BankKeeper)
+	_, _, err = p.Run(&vm.EVM{StateDB: stateDB}, common.Address{}, registry.Erc20ContractAddress, append(method.ID, args...), 10_000_000, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+	return erc20.PointerAddress(denom)
+}
+
+func TestDelegateCall(t *testing.T) {
+	tApp, ctx, _ := setupEvm(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	owner := sdk.AccAddress(crypto.Keccak256([]byte("owner"))[:20])
+	receiver := sdk.AccAddress(crypto.Keccak256([]byte("receiver"))[:20])
+	erc20Addr := setupErc20(t, tApp, ctx, owner)
+
+	// any contract can serve as the cw20 pointer of the erc20 once registered
+	require.NoError(t, tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams()))
+	code, err := os.ReadFile("../../../precompile/cosmwasm/echo/artifacts/echo.wasm")
+	require.NoError(t, err)
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, owner, code, nil)
+	require.NoError(t, err)
+	pointer, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, owner, nil, []byte("{}"), "pointer", nil)
+	require.NoError(t, err)
+	other, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, owner, nil, []byte("{}"), "other", nil)
+	require.NoError(t, err)
+	_, err = tApp.PrecompileKeeper.RegisterPointer(ctx, precompiletypes.PointerTypeERC20, erc20Addr.Hex(), pointer.String())
+	require.NoError(t, err)
+
+	messenger := bindings.CustomMessageDecorator(tApp.EvmKeeper, &tApp.PrecompileKeeper)(&capturingMessenger{})
+	receiverEvmAddr := bindings.EvmAddress(ctx, tApp.EvmKeeper, receiver)
+	data, err := erc20.ABI.Pack(erc20.TransferMethod, receiverEvmAddr, big.NewInt(10))
+	require.NoError(t, err)
+	msg := customMsg(t, bindingstypes.EvmCustomMsg{Evm: &bindingstypes.EvmMsg{DelegateCall: &bindingstypes.DelegateCall{From: owner.String(), To: erc20Addr.Hex(), Data: data}}})
+
+	// other contracts cannot spend the tokens of their callers
+	_, _, _, err = messenger.DispatchMsg(ctx, other, "", msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	events, _, _, err := messenger.DispatchMsg(ctx, pointer, "", msg)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, bindings.EventTypeEvmCall, events[0].Type)
+	require.Equal(t, int64(90), tApp.BankKeeper.GetBalance(ctx, owner, "factory/orai1creator/token").Amount.Int64())
+	require.Equal(t, int64(10), tApp.BankKeeper.GetBalance(ctx, receiver, "factory/orai1creator/token").Amount.Int64())
+
+	handler := bindings.CustomQueryDecorator(tApp.EvmKeeper)(nil)
+	query := func(query bindingstypes.EvmQuery, response interface{}) {
+		bz, err := json.Marshal(bindingstypes.EvmCustomQuery{Evm: &query})
+		require.NoError(t, err)
+		res, err := handler.HandleQuery(ctx, pointer, wasmvmtypes.QueryRequest{Custom: bz})
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(res, response))
+	}
+	var tokenInfo bindingstypes.Erc20TokenInfoResponse
+	query(bindingstypes.EvmQuery{Erc20TokenInfo: &bindingstypes.Erc20TokenInfo{Contract: erc20Addr.Hex()}}, &tokenInfo)
+	require.Equal(t, bindingstypes.Erc20TokenInfoResponse{Name: "Factory Token", Symbol: "TKN", Decimals: 6, TotalSupply: sdkmath.NewInt(100)}, tokenInfo)
+	var allowance bindingstypes.Erc20AllowanceResponse
+	query(bindingstypes.EvmQuery{Erc20Allowance: &bindingstypes.Erc20Allowance{Contract: erc20Addr.Hex(), Owner: owner.String(), Spender: receiver.String()}}, &allowance)
+	require.Equal(t, sdkmath.ZeroInt(), allowance.Allowance)
+}
+
+func TestCw20Erc20Pointer(t *testing.T) {
+	code, err := os.ReadFile("../../../precompile/cosmwasm/cw20_erc20/artifacts/cw20_erc20.wasm")
+	if os.IsNotExist(err) {
+		t.Skip("the cw20_erc20 pointer contract is not built")
+	}
+	require.NoError(t, err)
+	tApp, ctx, _ := setupEvm(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	owner := sdk.AccAddress(crypto.Keccak256([]byte("owner"))[:20])
+	spender := sdk.AccAddress(crypto.Keccak256([]byte("spender"))[:20])
+	receiver := sdk.AccAddress(crypto.Keccak256([]byte("receiver"))[:20])
+	erc20Addr := setupErc20(t, tApp, ctx, owner)
+	const denom = "factory/orai1creator/token"
+
+	require.NoError(t, tApp.GetWasmKeeper().SetParams(ctx, wasmtypes.DefaultParams()))
+	codeID, _, err := tApp.ContractKeeper.Create(ctx, owner, code, nil)
+	require.NoError(t, err)
+	initMsg := fmt.Sprintf(`{"erc20_address":%q}`, erc20Addr.Hex())
+	pointer, _, err := tApp.ContractKeeper.Instantiate(ctx, codeID, owner, nil, []byte(initMsg), "pointer", nil)
+	require.NoError(t, err)
+	execute := func(sender sdk.AccAddress, msg string) error {
+		_, err := tApp.ContractKeeper.Execute(ctx, pointer, sender, []byte(msg), nil)
+		return err
+	}
+	query := func(msg string, response interface{}) {
+		res, err := tApp.WasmKeeper.QuerySmart(ctx, pointer, []byte(msg))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(res, response))
+	}
+	transfer := fmt.Sprintf(`{"transfer":{"recipient":%q,"amount":"10"}}`, receiver)
+
+	// the pointer cannot move the tokens of its callers until it is registered
+	require.ErrorIs(t, execute(owner, transfer), sdkerrors.ErrUnauthorized)
+	_, err = tApp.PrecompileKeeper.RegisterPointer(ctx, precompiletypes.PointerTypeERC20, erc20Addr.Hex(), pointer.String())
+	require.NoError(t, err)
+
+	require.NoError(t, execute(owner, transfer))
+	var balance struct {
+		Balance sdkmath.Int `json:"balance"`
+	}
+	query(fmt.Sprintf(`{"balance":{"address":%q}}`, receiver), &balance)
+	require.Equal(t, sdkmath.NewInt(10), balance.Balance)
+	require.Equal(t, int64(90), tApp.BankKeeper.GetBalance(ctx, owner, denom).Amount.Int64())
+
+	// allowances are approved on the erc20 and spent through the pointer
+	var allowance struct {
+		Allowance sdkmath.Int     `json:"allowance"`
+		Expires   json.RawMessage `json:"expires"`
+	}
+	allowanceQuery := fmt.Sprintf(`{"allowance":{"owner":%q,"spender":%q}}`, owner, spender)
+	require.NoError(t, execute(owner, fmt.Sprintf(`{"increase_allowance":{"spender":%q,"amount":"30"}}`, spender)))
+	query(allowanceQuery, &allowance)
+	require.Equal(t, sdkmath.NewInt(30), allowance.Allowance)
+	require.JSONEq(t, `{"never":{}}`, string(allowance.Expires))
+
+	transferFrom := fmt.Sprintf(`{"transfer_from":{"owner":%q,"recipient":%q,"amount":"20"}}`, owner, receiver)
+	require.NoError(t, execute(spender, transferFrom))
+	query(allowanceQuery, &allowance)
+	require.Equal(t, sdkmath.NewInt(10), allowance.Allowance)
+	require.Equal(t, int64(70), tApp.BankKeeper.GetBalance(ctx, owner, denom).Amount.Int64())
+	require.Equal(t, int64(30), tApp.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	require.NoError(t, execute(owner, fmt.Sprintf(`{"decrease_allowance":{"spender":%q,"amount":"10"}}`, spender)))
+	query(allowanceQuery, &allowance)
+	require.True(t, allowance.Allowance.IsZero())
+	require.Error(t, execute(spender, transferFrom))
+	require.Equal(t, int64(70), tApp.BankKeeper.GetBalance(ctx, owner, denom).Amount.Int64())
+}
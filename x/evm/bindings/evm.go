@@ -0,0 +1,161 @@
+package bindings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/server/config"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	pcommon "github.com/CosmWasm/wasmd/precompile/common"
+)
+
+// EvmAddress returns the EVM address of the cosmos account addr: its mapped address, or for an
+// unmapped account the same 20 bytes. Contract addresses are 32 bytes and get the last 20 bytes
+// of their hash instead.
+func EvmAddress(ctx sdk.Context, evmKeeper *evmkeeper.Keeper, addr sdk.AccAddress) common.Address {
+	if evmAddress, err := evmKeeper.GetEvmAddressMapping(ctx, addr); err == nil {
+		return *evmAddress
+	}
+	if len(addr) == common.AddressLength {
+		return common.BytesToAddress(addr)
+	}
+	return common.BytesToAddress(crypto.Keccak256(addr)[12:])
+}
+
+// mapContractAddress stores the mapping of a contract to its EVM address, so the balance of the
+// contract is what the EVM sees at that address. Until then the EVM address is backed by the
+// cosmos account with the same 20 bytes, which nobody holds the key of, so whatever was sent to
+// the EVM address before is moved to the contract when the mapping is stored.
+func mapContractAddress(ctx sdk.Context, evmKeeper *evmkeeper.Keeper, contractAddr sdk.AccAddress) (common.Address, error) {
+	evmAddress := EvmAddress(ctx, evmKeeper, contractAddr)
+	if _, err := evmKeeper.GetEvmAddressMapping(ctx, contractAddr); err == nil || len(contractAddr) == common.AddressLength {
+		return evmAddress, nil
+	}
+	if !evmKeeper.GetCosmosAddressMapping(ctx, evmAddress).Equals(sdk.AccAddress(evmAddress.Bytes())) {
+		return common.Address{}, fmt.Errorf("evm address %s of contract %s is mapped to another account", evmAddress.Hex(), contractAddr)
+	}
+	if err := evmKeeper.MigrateBalance(ctx, evmAddress, contractAddr); err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to move the balance of the evm address")
+	}
+	evmKeeper.SetAddressMapping(ctx, contractAddr, evmAddress)
+	return evmAddress, nil
+}
+
+// resolveEvmAddress parses an EVM address, or a bech32 address which is converted with EvmAddress.
+func resolveEvmAddress(ctx sdk.Context, evmKeeper *evmkeeper.Keeper, address string) (common.Address, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("address %s is neither a hex nor a bech32 address", address)
+	}
+	return EvmAddress(ctx, evmKeeper, addr), nil
+}
+
+// callEVM calls the contract to from the account from and returns the returned data. The call is
+// limited to the gas left in ctx and charged to it. A static call never changes state, the state
+// changes of a successful call are committed to ctx and its logs emitted as tx_log events, the
+// way the EVM module emits the logs of an ethereum transaction.
+//
+// Contracts cannot call the EVM while they are themselves executed by a precompile: the
+// enclosing transaction keeps state of its own that it writes when it finishes and that would
+// overwrite the changes of the nested call.
+func callEVM(ctx sdk.Context, evmKeeper *evmkeeper.Keeper, from common.Address, to common.Address, data []byte, value *big.Int, static bool) ([]byte, error) {
+	if pcommon.IsPrecompileCall(ctx) {
+		return nil, errors.New("cannot call the EVM from a contract executed by the EVM")
+	}
+
+	cfg, err := evmKeeper.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), evmKeeper.ChainID())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	if !cfg.Params.EnableCall {
+		return nil, errorsmod.Wrap(evmtypes.ErrCallDisabled, "failed to call contract")
+	}
+
+	gasLimit := ctx.GasMeter().GasRemaining()
+	if gasLimit > config.DefaultGasCap {
+		gasLimit = config.DefaultGasCap
+	}
+	msg := ethtypes.NewMessage(
+		from,
+		&to,
+		evmKeeper.GetNonce(ctx, from),
+		value,
+		gasLimit,
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{},
+		static, // isFake
+	)
+
+	// the call pays for its store accesses with EVM gas
+	evmCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	stateDB := statedb.New(evmCtx, evmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := evmKeeper.NewEVM(evmCtx, msg, cfg, evmtypes.NewNoOpTracer(), stateDB)
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	stateDB.PrepareAccessList(from, &to, evm.ActivePrecompiles(rules), nil)
+
+	var (
+		ret         []byte
+		leftoverGas uint64
+		vmErr       error
+	)
+	if static {
+		ret, leftoverGas, vmErr = evm.StaticCall(vm.AccountRef(from), to, data, gasLimit)
+	} else {
+		ret, leftoverGas, vmErr = evm.Call(vm.AccountRef(from), to, data, gasLimit, value)
+	}
+	ctx.GasMeter().ConsumeGas(gasLimit-leftoverGas, "evm call")
+	if vmErr != nil {
+		if errors.Is(vmErr, vm.ErrExecutionReverted) {
+			if reason, err := abi.UnpackRevert(ret); err == nil {
+				return nil, errorsmod.Wrapf(evmtypes.ErrVMExecution, "%s: %s", vmErr, reason)
+			}
+		}
+		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, vmErr.Error())
+	}
+
+	if !static {
+		if err := stateDB.Commit(); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+		}
+		if err := emitLogs(ctx, stateDB.Logs()); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// emitLogs emits logs as the attributes of a tx_log event.
+func emitLogs(ctx sdk.Context, logs []*ethtypes.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	txLogAttrs := make([]sdk.Attribute, len(logs))
+	for i, log := range evmtypes.NewLogsFromEth(logs) {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(evmtypes.AttributeKeyTxLog, string(value))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(evmtypes.EventTypeTxLog, txLogAttrs...))
+	return nil
+}
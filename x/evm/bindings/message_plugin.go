@@ -0,0 +1,167 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+
+	bindingstypes "github.com/CosmWasm/wasmd/x/evm/bindings/types"
+	precompiletypes "github.com/CosmWasm/wasmd/x/precompile/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+const (
+	EventTypeEvmCall = "evm_call"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyFrom     = "from"
+	AttributeKeyTo       = "to"
+)
+
+// PointerKeeper looks up the registered token pointers.
+type PointerKeeper interface {
+	GetPointer(ctx sdk.Context, t precompiletypes.PointerType, pointee string) (precompiletypes.Pointer, bool)
+}
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(evmKeeper *evmkeeper.Keeper, pointerKeeper PointerKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:       old,
+			evmKeeper:     evmKeeper,
+			pointerKeeper: pointerKeeper,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped       wasmkeeper.Messenger
+	evmKeeper     *evmkeeper.Keeper
+	pointerKeeper PointerKeeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// custom messages of the other bindings are left for the wrapped messenger
+		var contractMsg bindingstypes.EvmCustomMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err == nil && contractMsg.Evm != nil {
+			if contractMsg.Evm.Call != nil {
+				return m.call(ctx, contractAddr, contractMsg.Evm.Call)
+			}
+			if contractMsg.Evm.DelegateCall != nil {
+				return m.delegateCall(ctx, contractAddr, contractMsg.Evm.DelegateCall)
+			}
+			return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "unknown evm msg variant")
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// call calls an EVM contract from the contract
+func (m *CustomMessenger) call(ctx sdk.Context, contractAddr sdk.AccAddress, call *bindingstypes.Call) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	bz, from, to, err := PerformCall(m.evmKeeper, ctx, contractAddr, call)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform evm call")
+	}
+	event := sdk.NewEvent(EventTypeEvmCall,
+		sdk.NewAttribute(AttributeKeyContract, contractAddr.String()),
+		sdk.NewAttribute(AttributeKeyFrom, from.Hex()),
+		sdk.NewAttribute(AttributeKeyTo, to.Hex()),
+	)
+	return []sdk.Event{event}, [][]byte{bz}, nil, nil
+}
+
+// delegateCall calls an ERC20 contract on behalf of an account from its CW20 pointer
+func (m *CustomMessenger) delegateCall(ctx sdk.Context, contractAddr sdk.AccAddress, call *bindingstypes.DelegateCall) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	bz, from, to, err := PerformDelegateCall(m.evmKeeper, m.pointerKeeper, ctx, contractAddr, call)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform evm delegate call")
+	}
+	event := sdk.NewEvent(EventTypeEvmCall,
+		sdk.NewAttribute(AttributeKeyContract, contractAddr.String()),
+		sdk.NewAttribute(AttributeKeyFrom, from.Hex()),
+		sdk.NewAttribute(AttributeKeyTo, to.Hex()),
+	)
+	return []sdk.Event{event}, [][]byte{bz}, nil, nil
+}
+
+// PerformCall is used with call to call an EVM contract; validates the call.
+func PerformCall(k *evmkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, call *bindingstypes.Call) ([]byte, common.Address, common.Address, error) {
+	if call == nil {
+		return nil, common.Address{}, common.Address{}, wasmvmtypes.InvalidRequest{Err: "evm call null call"}
+	}
+	if !common.IsHexAddress(call.To) {
+		return nil, common.Address{}, common.Address{}, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("evm call invalid address %s", call.To)}
+	}
+	to := common.HexToAddress(call.To)
+	value := big.NewInt(0)
+	if !call.Value.IsNil() {
+		if call.Value.IsNegative() {
+			return nil, common.Address{}, common.Address{}, wasmvmtypes.InvalidRequest{Err: "evm call negative value"}
+		}
+		value = call.Value.BigInt()
+	}
+
+	from, err := mapContractAddress(ctx, k, contractAddr)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+	ret, err := callEVM(ctx, k, from, to, call.Data, value, false)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	bz, err := json.Marshal(bindingstypes.CallResponse{Data: ret})
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, errorsmod.Wrap(err, "failed to marshal CallResponse")
+	}
+	return bz, from, to, nil
+}
+
+// PerformDelegateCall is used with delegateCall to call an ERC20 contract from the account of
+// call.From; validates that the contract is the registered CW20 pointer of the ERC20.
+func PerformDelegateCall(k *evmkeeper.Keeper, pointerKeeper PointerKeeper, ctx sdk.Context, contractAddr sdk.AccAddress, call *bindingstypes.DelegateCall) ([]byte, common.Address, common.Address, error) {
+	if call == nil {
+		return nil, common.Address{}, common.Address{}, wasmvmtypes.InvalidRequest{Err: "evm delegate call null call"}
+	}
+	if !common.IsHexAddress(call.To) {
+		return nil, common.Address{}, common.Address{}, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("evm delegate call invalid address %s", call.To)}
+	}
+	to := common.HexToAddress(call.To)
+	fromAddr, err := sdk.AccAddressFromBech32(call.From)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("evm delegate call invalid sender %s", call.From)}
+	}
+
+	// only a pointer acts for the senders of its messages, a contract could drain anyone otherwise
+	pointer, found := pointerKeeper.GetPointer(ctx, precompiletypes.PointerTypeERC20, to.Hex())
+	if !found || pointer.Pointer != contractAddr.String() {
+		return nil, common.Address{}, common.Address{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "contract %s is not the pointer of erc20 %s", contractAddr, to.Hex())
+	}
+
+	from, err := mapContractAddress(ctx, k, fromAddr)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+	ret, err := callEVM(ctx, k, from, to, call.Data, big.NewInt(0), false)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	bz, err := json.Marshal(bindingstypes.CallResponse{Data: ret})
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, errorsmod.Wrap(err, "failed to marshal CallResponse")
+	}
+	return bz, from, to, nil
+}
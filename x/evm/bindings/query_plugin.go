@@ -0,0 +1,276 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+
+	bindingstypes "github.com/CosmWasm/wasmd/x/evm/bindings/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// erc20ABI is the part of the ERC20 interface the queries use.
+var erc20ABI = contract.MustParseABI(`[{
+	"inputs": [{ "internalType": "address", "name": "account", "type": "address" }],
+	"name": "balanceOf",
+	"outputs": [{ "internalType": "uint256", "name": "", "type": "uint256" }],
+	"stateMutability": "view",
+	"type": "function"
+}, {
+	"inputs": [
+		{ "internalType": "address", "name": "owner", "type": "address" },
+		{ "internalType": "address", "name": "spender", "type": "address" }
+	],
+	"name": "allowance",
+	"outputs": [{ "internalType": "uint256", "name": "", "type": "uint256" }],
+	"stateMutability": "view",
+	"type": "function"
+}, {
+	"inputs": [],
+	"name": "name",
+	"outputs": [{ "internalType": "string", "name": "", "type": "string" }],
+	"stateMutability": "view",
+	"type": "function"
+}, {
+	"inputs": [],
+	"name": "symbol",
+	"outputs": [{ "internalType": "string", "name": "", "type": "string" }],
+	"stateMutability": "view",
+	"type": "function"
+}, {
+	"inputs": [],
+	"name": "decimals",
+	"outputs": [{ "internalType": "uint8", "name": "", "type": "uint8" }],
+	"stateMutability": "view",
+	"type": "function"
+}, {
+	"inputs": [],
+	"name": "totalSupply",
+	"outputs": [{ "internalType": "uint256", "name": "", "type": "uint256" }],
+	"stateMutability": "view",
+	"type": "function"
+}]`)
+
+// CustomQueryDecorator returns decorator for custom CosmWasm bindings queries. Queries are
+// answered from the querying contract, so they decorate the query handler rather than being
+// registered as a custom query plugin.
+func CustomQueryDecorator(evmKeeper *evmkeeper.Keeper) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return &QueryPlugin{
+			wrapped:   old,
+			evmKeeper: evmKeeper,
+		}
+	}
+}
+
+type QueryPlugin struct {
+	wrapped   wasmkeeper.WasmVMQueryHandler
+	evmKeeper *evmkeeper.Keeper
+}
+
+var _ wasmkeeper.WasmVMQueryHandler = (*QueryPlugin)(nil)
+
+// HandleQuery dispatches custom CosmWasm bindings queries.
+func (qp *QueryPlugin) HandleQuery(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+	if request.Custom == nil {
+		return qp.wrapped.HandleQuery(ctx, caller, request)
+	}
+	// custom queries of the other bindings are left for the wrapped handler
+	var contractQuery bindingstypes.EvmCustomQuery
+	if err := json.Unmarshal(request.Custom, &contractQuery); err != nil || contractQuery.Evm == nil {
+		return qp.wrapped.HandleQuery(ctx, caller, request)
+	}
+	evmQuery := contractQuery.Evm
+
+	switch {
+	case evmQuery.StaticCall != nil:
+		res, err := qp.StaticCall(ctx, caller, evmQuery.StaticCall)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal StaticCallResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case evmQuery.Erc20Balance != nil:
+		res, err := qp.Erc20Balance(ctx, caller, evmQuery.Erc20Balance)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal Erc20BalanceResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case evmQuery.Erc20TokenInfo != nil:
+		res, err := qp.Erc20TokenInfo(ctx, caller, evmQuery.Erc20TokenInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal Erc20TokenInfoResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case evmQuery.Erc20Allowance != nil:
+		res, err := qp.Erc20Allowance(ctx, caller, evmQuery.Erc20Allowance)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal Erc20AllowanceResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case evmQuery.AddressMapping != nil:
+		res, err := qp.AddressMapping(ctx, evmQuery.AddressMapping.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal AddressMappingResponse: %w", err)
+		}
+
+		return bz, nil
+
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown evm query variant"}
+	}
+}
+
+// StaticCall calls a view function of an EVM contract from the querying contract.
+func (qp QueryPlugin) StaticCall(ctx sdk.Context, caller sdk.AccAddress, query *bindingstypes.StaticCall) (*bindingstypes.StaticCallResponse, error) {
+	if !common.IsHexAddress(query.To) {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("evm static call invalid address %s", query.To)}
+	}
+	ret, err := callEVM(ctx, qp.evmKeeper, EvmAddress(ctx, qp.evmKeeper, caller), common.HexToAddress(query.To), query.Data, big.NewInt(0), true)
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.StaticCallResponse{Data: ret}, nil
+}
+
+// Erc20Balance queries balanceOf on an ERC20 contract.
+func (qp QueryPlugin) Erc20Balance(ctx sdk.Context, caller sdk.AccAddress, query *bindingstypes.Erc20Balance) (*bindingstypes.Erc20BalanceResponse, error) {
+	if !common.IsHexAddress(query.Contract) {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("erc20 balance invalid contract %s", query.Contract)}
+	}
+	account, err := resolveEvmAddress(ctx, qp.evmKeeper, query.Address)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	outputs, err := qp.callErc20(ctx, caller, query.Contract, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.Erc20BalanceResponse{Balance: sdkmath.NewIntFromBigInt(outputs[0].(*big.Int))}, nil
+}
+
+// Erc20TokenInfo queries the name, symbol, decimals and total supply of an ERC20 contract.
+func (qp QueryPlugin) Erc20TokenInfo(ctx sdk.Context, caller sdk.AccAddress, query *bindingstypes.Erc20TokenInfo) (*bindingstypes.Erc20TokenInfoResponse, error) {
+	if !common.IsHexAddress(query.Contract) {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("erc20 token info invalid contract %s", query.Contract)}
+	}
+
+	outputs := make(map[string]interface{}, 4)
+	for _, method := range []string{"name", "symbol", "decimals", "totalSupply"} {
+		res, err := qp.callErc20(ctx, caller, query.Contract, method)
+		if err != nil {
+			return nil, err
+		}
+		outputs[method] = res[0]
+	}
+	return &bindingstypes.Erc20TokenInfoResponse{
+		Name:        outputs["name"].(string),
+		Symbol:      outputs["symbol"].(string),
+		Decimals:    outputs["decimals"].(uint8),
+		TotalSupply: sdkmath.NewIntFromBigInt(outputs["totalSupply"].(*big.Int)),
+	}, nil
+}
+
+// Erc20Allowance queries allowance on an ERC20 contract.
+func (qp QueryPlugin) Erc20Allowance(ctx sdk.Context, caller sdk.AccAddress, query *bindingstypes.Erc20Allowance) (*bindingstypes.Erc20AllowanceResponse, error) {
+	if !common.IsHexAddress(query.Contract) {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("erc20 allowance invalid contract %s", query.Contract)}
+	}
+	owner, err := resolveEvmAddress(ctx, qp.evmKeeper, query.Owner)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+	spender, err := resolveEvmAddress(ctx, qp.evmKeeper, query.Spender)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	outputs, err := qp.callErc20(ctx, caller, query.Contract, "allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.Erc20AllowanceResponse{Allowance: sdkmath.NewIntFromBigInt(outputs[0].(*big.Int))}, nil
+}
+
+// callErc20 calls the view function method of the ERC20 contract and returns its outputs.
+func (qp QueryPlugin) callErc20(ctx sdk.Context, caller sdk.AccAddress, contract string, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := erc20ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := callEVM(ctx, qp.evmKeeper, EvmAddress(ctx, qp.evmKeeper, caller), common.HexToAddress(contract), data, big.NewInt(0), true)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := erc20ABI.Unpack(method, ret)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "contract %s is not an erc20 token", contract)
+	}
+	return outputs, nil
+}
+
+// AddressMapping returns the EVM and cosmos address of an account given either of them.
+func (qp QueryPlugin) AddressMapping(ctx sdk.Context, address string) (*bindingstypes.AddressMappingResponse, error) {
+	if common.IsHexAddress(address) {
+		evmAddress := common.HexToAddress(address)
+		cosmosAddress := qp.evmKeeper.GetCosmosAddressMapping(ctx, evmAddress)
+		mapped, err := qp.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+		return &bindingstypes.AddressMappingResponse{
+			CosmosAddress: cosmosAddress.String(),
+			EvmAddress:    evmAddress.Hex(),
+			Mapped:        err == nil && *mapped == evmAddress,
+		}, nil
+	}
+
+	cosmosAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("address %s is neither a hex nor a bech32 address", address)}
+	}
+	_, err = qp.evmKeeper.GetEvmAddressMapping(ctx, cosmosAddress)
+	return &bindingstypes.AddressMappingResponse{
+		CosmosAddress: cosmosAddress.String(),
+		EvmAddress:    EvmAddress(ctx, qp.evmKeeper, cosmosAddress).Hex(),
+		Mapped:        err == nil,
+	}, nil
+}
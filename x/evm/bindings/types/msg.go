@@ -0,0 +1,38 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+type EvmCustomMsg struct {
+	Evm *EvmMsg `json:"evm,omitempty"`
+}
+
+type EvmMsg struct {
+	/// Calls an EVM contract from the address of the contract, as returned by
+	/// `EvmQuery::AddressMapping`. The value is paid from the contract's balance.
+	Call *Call `json:"call,omitempty"`
+	/// Calls an ERC20 contract from the address of From. Only the registered CW20 pointer of
+	/// the ERC20 may send it, on behalf of the sender of the message it executes.
+	DelegateCall *DelegateCall `json:"delegate_call,omitempty"`
+}
+
+// Call calls the EVM contract To with the ABI encoded Data, sending Value in the EVM denom
+// (aorai, 18 decimals).
+type Call struct {
+	To    string   `json:"to"`
+	Data  []byte   `json:"data"`
+	Value math.Int `json:"value"`
+}
+
+// DelegateCall calls the ERC20 contract To with the ABI encoded Data from the EVM address of the
+// bech32 account From.
+type DelegateCall struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Data []byte `json:"data"`
+}
+
+type CallResponse struct {
+	Data []byte `json:"data"`
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+type EvmCustomQuery struct {
+	Evm *EvmQuery `json:"evm,omitempty"`
+}
+
+type EvmQuery struct {
+	/// Calls a view function of an EVM contract from the address of the querying contract.
+	StaticCall *StaticCall `json:"static_call,omitempty"`
+	/// Returns the balance of an ERC20 token held by an address.
+	Erc20Balance *Erc20Balance `json:"erc20_balance,omitempty"`
+	/// Returns the name, symbol, decimals and total supply of an ERC20 token.
+	Erc20TokenInfo *Erc20TokenInfo `json:"erc20_token_info,omitempty"`
+	/// Returns the amount of an ERC20 token an owner allows a spender to transfer.
+	Erc20Allowance *Erc20Allowance `json:"erc20_allowance,omitempty"`
+	/// Returns the EVM and cosmos address pair of an address given in either form.
+	AddressMapping *AddressMapping `json:"address_mapping,omitempty"`
+}
+
+// query types
+
+type StaticCall struct {
+	To   string `json:"to"`
+	Data []byte `json:"data"`
+}
+
+// Erc20Balance queries the balance of Address, given as an EVM or a bech32 address, in the
+// ERC20 token Contract.
+type Erc20Balance struct {
+	Contract string `json:"contract"`
+	Address  string `json:"address"`
+}
+
+type Erc20TokenInfo struct {
+	Contract string `json:"contract"`
+}
+
+// Erc20Allowance queries the allowance of Spender over the tokens of Owner, both given as EVM or
+// bech32 addresses, in the ERC20 token Contract.
+type Erc20Allowance struct {
+	Contract string `json:"contract"`
+	Owner    string `json:"owner"`
+	Spender  string `json:"spender"`
+}
+
+type AddressMapping struct {
+	Address string `json:"address"`
+}
+
+// responses
+
+type StaticCallResponse struct {
+	Data []byte `json:"data"`
+}
+
+type Erc20BalanceResponse struct {
+	Balance math.Int `json:"balance"`
+}
+
+type Erc20TokenInfoResponse struct {
+	Name        string   `json:"name"`
+	Symbol      string   `json:"symbol"`
+	Decimals    uint8    `json:"decimals"`
+	TotalSupply math.Int `json:"total_supply"`
+}
+
+type Erc20AllowanceResponse struct {
+	Allowance math.Int `json:"allowance"`
+}
+
+// AddressMappingResponse is the pair of addresses that share one account. Mapped is false when
+// no mapping was stored and the EVM address is derived from the cosmos address or vice versa.
+type AddressMappingResponse struct {
+	CosmosAddress string `json:"cosmos_address"`
+	EvmAddress    string `json:"evm_address"`
+	Mapped        bool   `json:"mapped"`
+}
@@ -0,0 +1,27 @@
+package bindings
+
+import (
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// RegisterCustomPlugins returns the options adding the EVM bindings to the wasm keeper. They pass
+// on the custom messages and queries of other bindings, so they must be applied after them.
+func RegisterCustomPlugins(
+	evmKeeper *evmkeeper.Keeper,
+	pointerKeeper PointerKeeper,
+) []wasmkeeper.Option {
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(evmKeeper),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(evmKeeper, pointerKeeper),
+	)
+
+	return []wasm.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
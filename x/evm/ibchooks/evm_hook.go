@@ -0,0 +1,192 @@
+// Package ibchooks adds an EVM hook to the ibc-hooks middleware: an ICS-20 packet whose memo
+// is shaped like
+//
+//	{"evm": {"contract": "0x...", "calldata": "0x..."}}
+//
+// calls the EVM contract once the transferred funds are received. As with wasm hooks, the
+// funds are credited to an intermediate account derived from the channel and the sender, which
+// makes the call, and any failure is returned as an error acknowledgement so the transfer is
+// refunded on the source chain.
+package ibchooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/x/evm/bindings"
+	bindingstypes "github.com/CosmWasm/wasmd/x/evm/bindings/types"
+)
+
+// MemoKey is the memo key routing a packet to the EVM hook.
+const MemoKey = "evm"
+
+const errBadMetadataFormatMsg = "evm metadata not properly formatted for: '%v'. %s"
+
+// ErrEvmError is acknowledged when the call of the EVM contract fails.
+var ErrEvmError = errorsmod.Register("evm-hooks", 2, "evm error")
+
+// BankKeeper moves received funds other than orai to the called contract.
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EvmHooks serves the EVM memos of incoming ICS-20 packets and leaves every other packet, and
+// the callbacks of outgoing ones, to the wrapped wasm hooks.
+type EvmHooks struct {
+	*ibchooks.WasmHooks
+	evmKeeper           *evmkeeper.Keeper
+	bankKeeper          BankKeeper
+	bech32PrefixAccAddr string
+}
+
+func NewEvmHooks(wasmHooks *ibchooks.WasmHooks, evmKeeper *evmkeeper.Keeper, bankKeeper BankKeeper, bech32PrefixAccAddr string) EvmHooks {
+	return EvmHooks{
+		WasmHooks:           wasmHooks,
+		evmKeeper:           evmKeeper,
+		bankKeeper:          bankKeeper,
+		bech32PrefixAccAddr: bech32PrefixAccAddr,
+	}
+}
+
+func (h EvmHooks) ProperlyConfigured() bool {
+	return h.evmKeeper != nil && h.bankKeeper != nil
+}
+
+func (h EvmHooks) OnRecvPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil || !h.ProperlyConfigured() {
+		return h.WasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
+	}
+
+	contractAccount := func(contract common.Address) sdk.AccAddress {
+		return h.evmKeeper.GetCosmosAddressMapping(ctx, contract)
+	}
+	isEvmRouted, contract, calldata, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver, contractAccount)
+	if !isEvmRouted {
+		return h.WasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
+	}
+	if err != nil {
+		return ibchooks.NewEmitErrorAcknowledgement(ctx, ibchookstypes.ErrMsgValidation, err.Error())
+	}
+
+	// the funds are received by the account derived from the channel and the sender, which then
+	// calls the contract, the same way wasm hooks execute contracts
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender(packet.GetDestChannel(), data.GetSender(), h.bech32PrefixAccAddr)
+	if err != nil {
+		return ibchooks.NewEmitErrorAcknowledgement(ctx, ibchookstypes.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", packet.GetDestChannel(), data.GetSender(), err.Error()))
+	}
+	data.Receiver = senderBech32
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return ibchooks.NewEmitErrorAcknowledgement(ctx, ibchookstypes.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.GetAmount())
+	if !ok {
+		return ibchooks.NewEmitErrorAcknowledgement(ctx, ibchookstypes.ErrInvalidPacket, "Amount is not an int")
+	}
+	denom := ibchooks.MustExtractDenomFromPacketOnRecv(packet)
+
+	ret, err := h.callContract(ctx, sdk.MustAccAddressFromBech32(senderBech32), contract, calldata, sdk.NewCoin(denom, amount))
+	if err != nil {
+		return ibchooks.NewEmitErrorAcknowledgement(ctx, ErrEvmError, err.Error())
+	}
+
+	fullAck := ibchooks.ContractAck{ContractResult: ret, IbcAck: ack.Acknowledgement()}
+	bz, err = json.Marshal(fullAck)
+	if err != nil {
+		return ibchooks.NewEmitErrorAcknowledgement(ctx, ibchookstypes.ErrBadResponse, err.Error())
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// callContract calls contract from sender with the received funds: orai is sent as msg.value and
+// other denoms are transferred to the cosmos account mapped to the contract before the call. The
+// logs of the call are emitted as tx_log events by bindings.PerformCall.
+func (h EvmHooks) callContract(ctx sdk.Context, sender sdk.AccAddress, contract common.Address, calldata []byte, funds sdk.Coin) ([]byte, error) {
+	value := sdkmath.ZeroInt()
+	if funds.Denom == appconfig.CosmosDenom {
+		value = sdkmath.NewIntFromBigInt(appconfig.ToEvmAmount(funds.Amount.BigInt()))
+	} else if err := h.bankKeeper.SendCoins(ctx, sender, h.evmKeeper.GetCosmosAddressMapping(ctx, contract), sdk.NewCoins(funds)); err != nil {
+		return nil, err
+	}
+
+	res, _, _, err := bindings.PerformCall(h.evmKeeper, ctx, sender, &bindingstypes.Call{
+		To:    contract.Hex(),
+		Data:  calldata,
+		Value: value,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ValidateAndParseMemo returns the contract and calldata of an EVM memo. The receiver of the
+// packet must be the contract in hex form, or its cosmos account given by contractAccount in
+// bech32 form.
+func ValidateAndParseMemo(memo string, receiver string, contractAccount func(common.Address) sdk.AccAddress) (isEvmRouted bool, contract common.Address, calldata []byte, err error) {
+	if len(memo) == 0 {
+		return false, common.Address{}, nil, nil
+	}
+	var metadata map[string]interface{}
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return false, common.Address{}, nil, nil
+	}
+	evmRaw, ok := metadata[MemoKey]
+	if !ok {
+		return false, common.Address{}, nil, nil
+	}
+
+	evm, ok := evmRaw.(map[string]interface{})
+	if !ok {
+		return true, common.Address{}, nil,
+			fmt.Errorf(errBadMetadataFormatMsg, memo, "evm metadata is not a valid JSON map object")
+	}
+
+	contractHex, ok := evm["contract"].(string)
+	if !ok || !common.IsHexAddress(contractHex) {
+		return true, common.Address{}, nil,
+			fmt.Errorf(errBadMetadataFormatMsg, memo, `evm["contract"] is not a hex address`)
+	}
+	contract = common.HexToAddress(contractHex)
+	if !strings.EqualFold(receiver, contract.Hex()) && receiver != contractAccount(contract).String() {
+		return true, common.Address{}, nil,
+			fmt.Errorf(errBadMetadataFormatMsg, memo, `evm["contract"] should be the same as the receiver of the packet`)
+	}
+
+	calldataHex, ok := evm["calldata"].(string)
+	if !ok {
+		return true, common.Address{}, nil,
+			fmt.Errorf(errBadMetadataFormatMsg, memo, `Could not find key evm["calldata"]`)
+	}
+	calldata, err = hexutil.Decode(calldataHex)
+	if err != nil {
+		return true, common.Address{}, nil,
+			fmt.Errorf(errBadMetadataFormatMsg, memo, `evm["calldata"] is not 0x prefixed hex`)
+	}
+
+	return true, contract, calldata, nil
+}
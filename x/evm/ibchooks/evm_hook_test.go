@@ -0,0 +1,214 @@
+package ibchooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/app"
+	appconfig "github.com/CosmWasm/wasmd/cmd/config"
+	"github.com/CosmWasm/wasmd/x/evm/bindings"
+	evmibchooks "github.com/CosmWasm/wasmd/x/evm/ibchooks"
+)
+
+var (
+	// stores the caller in slot 0 and the value in slot 1
+	recorderCode = common.FromHex("0x336000553460015500")
+	// reverts without a reason
+	revertCode = common.FromHex("0x60006000fd")
+	// logs 42 as its only topic
+	loggerCode = common.FromHex("0x602a60006000a100")
+)
+
+// transferApp credits the funds of received packets to their receiver like the transfer app.
+type transferApp struct {
+	porttypes.IBCModule
+	tApp     *app.WasmApp
+	received []transfertypes.FungibleTokenPacketData
+}
+
+func (a *transferApp) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	a.received = append(a.received, data)
+	amount, _ := sdkmath.NewIntFromString(data.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(ibchooks.MustExtractDenomFromPacketOnRecv(packet), amount))
+	if err := a.tApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if err := a.tApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, sdk.MustAccAddressFromBech32(data.Receiver), coins); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func setupHooks(t *testing.T) (*app.WasmApp, sdk.Context, *transferApp, ibchooks.IBCMiddleware, map[string]common.Address) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	// the coinbase of the EVM is the block proposer
+	validators, err := tApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+	params := tApp.EvmKeeper.GetParams(ctx)
+	params.EvmDenom = appconfig.EvmDenom
+	require.NoError(t, tApp.EvmKeeper.SetParams(ctx, params))
+
+	contracts := map[string]common.Address{}
+	stateDB := statedb.New(ctx, tApp.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	for name, code := range map[string][]byte{"recorder": recorderCode, "revert": revertCode, "logger": loggerCode} {
+		contracts[name] = common.BytesToAddress(crypto.Keccak256([]byte(name)))
+		stateDB.SetCode(contracts[name], code)
+	}
+	require.NoError(t, stateDB.Commit())
+
+	wasmHooks := ibchooks.NewWasmHooks(&tApp.IBCHooksKeeper, nil, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	hooks := evmibchooks.NewEvmHooks(&wasmHooks, tApp.EvmKeeper, tApp.BankKeeper, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	ics4 := ibchooks.NewICS4Middleware(nil, hooks)
+	transfer := &transferApp{tApp: tApp}
+	return tApp, ctx, transfer, ibchooks.NewIBCMiddleware(transfer, &ics4), contracts
+}
+
+func packet(t *testing.T, denom, amount, receiver, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "cosmos1sender", receiver, memo)
+	bz, err := json.Marshal(data)
+	require.NoError(t, err)
+	return channeltypes.NewPacket(bz, 1, "transfer", "channel-1", "transfer", "channel-0", channeltypes.Packet{}.TimeoutHeight, 0)
+}
+
+func evmMemo(contract common.Address, calldata string) string {
+	return fmt.Sprintf(`{"evm":{"contract":"%s","calldata":"%s"}}`, contract.Hex(), calldata)
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	tApp, ctx, transfer, im, contracts := setupHooks(t)
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-0", "cosmos1sender", sdk.GetConfig().GetBech32AccountAddrPrefix())
+	require.NoError(t, err)
+	sender := sdk.MustAccAddressFromBech32(senderBech32)
+	recorder := contracts["recorder"]
+
+	// orai coming back from the counterparty is sent as msg.value
+	ack := im.OnRecvPacket(ctx, packet(t, "transfer/channel-1/orai", "5", recorder.Hex(), evmMemo(recorder, "0x")), nil)
+	require.True(t, ack.Success(), string(ack.Acknowledgement()))
+	require.Equal(t, senderBech32, transfer.received[0].Receiver)
+	var contractAck ibchooks.ContractAck
+	var result channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &result))
+	require.NoError(t, json.Unmarshal(result.GetResult(), &contractAck))
+	require.JSONEq(t, `{"data":null}`, string(contractAck.ContractResult))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(), contractAck.IbcAck)
+
+	senderEvmAddr := bindings.EvmAddress(ctx, tApp.EvmKeeper, sender)
+	require.Equal(t, common.BytesToHash(senderEvmAddr.Bytes()), tApp.EvmKeeper.GetState(ctx, recorder, common.Hash{}))
+	require.Equal(t, common.BigToHash(appconfig.ToEvmAmount(big.NewInt(5))), tApp.EvmKeeper.GetState(ctx, recorder, common.BigToHash(big.NewInt(1))))
+	require.Equal(t, sender, tApp.EvmKeeper.GetCosmosAddressMapping(ctx, senderEvmAddr))
+	require.True(t, tApp.BankKeeper.GetBalance(ctx, sender, appconfig.CosmosDenom).IsZero())
+
+	// other denoms are moved to the cosmos account mapped to the contract, which can be the receiver
+	ibcDenom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	recorderAccount := sdk.AccAddress(crypto.Keccak256([]byte("recorder account"))[:20])
+	tApp.EvmKeeper.SetAddressMapping(ctx, recorderAccount, recorder)
+	ack = im.OnRecvPacket(ctx, packet(t, "uatom", "7", recorderAccount.String(), evmMemo(recorder, "0x01")), nil)
+	require.True(t, ack.Success(), string(ack.Acknowledgement()))
+	require.Equal(t, sdkmath.NewInt(7), tApp.BankKeeper.GetBalance(ctx, recorderAccount, ibcDenom).Amount)
+	require.True(t, tApp.BankKeeper.GetBalance(ctx, sdk.AccAddress(recorder.Bytes()), ibcDenom).IsZero())
+	require.True(t, tApp.BankKeeper.GetBalance(ctx, sender, ibcDenom).IsZero())
+	ack = im.OnRecvPacket(ctx, packet(t, "uatom", "7", sdk.AccAddress(recorder.Bytes()).String(), evmMemo(recorder, "0x01")), nil)
+	require.False(t, ack.Success())
+
+	// failing calls and invalid memos are acknowledged as errors so the transfer is refunded
+	revert := contracts["revert"]
+	ack = im.OnRecvPacket(ctx, packet(t, "uatom", "7", revert.Hex(), evmMemo(revert, "0x")), nil)
+	require.False(t, ack.Success())
+	ack = im.OnRecvPacket(ctx, packet(t, "uatom", "7", revert.Hex(), evmMemo(recorder, "0x")), nil)
+	require.False(t, ack.Success())
+	require.Len(t, transfer.received, 3)
+
+	// packets without an evm memo are passed on untouched
+	ack = im.OnRecvPacket(ctx, packet(t, "uatom", "7", sender.String(), `{"wasm":{}}`), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, sender.String(), transfer.received[3].Receiver)
+}
+
+func TestOnRecvPacketEmitsLogs(t *testing.T) {
+	_, ctx, _, im, contracts := setupHooks(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	logger := contracts["logger"]
+
+	ack := im.OnRecvPacket(ctx, packet(t, "transfer/channel-1/orai", "5", logger.Hex(), evmMemo(logger, "0x")), nil)
+	require.True(t, ack.Success(), string(ack.Acknowledgement()))
+
+	// the logs of the contract are emitted like those of an ethereum transaction
+	var logs []*evmtypes.Log
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			var log evmtypes.Log
+			require.NoError(t, json.Unmarshal([]byte(attr.Value), &log))
+			logs = append(logs, &log)
+		}
+	}
+	require.Len(t, logs, 1)
+	require.Equal(t, logger.Hex(), logs[0].Address)
+	require.Equal(t, []string{common.BigToHash(big.NewInt(42)).Hex()}, logs[0].Topics)
+}
+
+func TestValidateAndParseMemo(t *testing.T) {
+	contract := common.HexToAddress("0x9000000000000000000000000000000000000001")
+	account := sdk.AccAddress(common.HexToAddress("0x9000000000000000000000000000000000000003").Bytes())
+	contractAccount := func(common.Address) sdk.AccAddress { return account }
+	specs := map[string]struct {
+		memo        string
+		receiver    string
+		expRouted   bool
+		expCalldata []byte
+		expErr      bool
+	}{
+		"no memo":         {},
+		"other hook":      {memo: `{"wasm":{"contract":"orai1","msg":{}}}`},
+		"not json":        {memo: "evm"},
+		"hex receiver":    {memo: evmMemo(contract, "0x0102"), receiver: contract.Hex(), expRouted: true, expCalldata: []byte{1, 2}},
+		"bech32 receiver": {memo: evmMemo(contract, "0x"), receiver: account.String(), expRouted: true, expCalldata: []byte{}},
+		"unmapped bech32": {memo: evmMemo(contract, "0x"), receiver: sdk.AccAddress(contract.Bytes()).String(), expRouted: true, expErr: true},
+		"not a map":       {memo: `{"evm":"0x"}`, expRouted: true, expErr: true},
+		"other receiver":  {memo: evmMemo(contract, "0x"), receiver: "0x9000000000000000000000000000000000000002", expRouted: true, expErr: true},
+		"bad contract":    {memo: `{"evm":{"contract":"orai1","calldata":"0x"}}`, receiver: "orai1", expRouted: true, expErr: true},
+		"no calldata":     {memo: fmt.Sprintf(`{"evm":{"contract":"%s"}}`, contract.Hex()), receiver: contract.Hex(), expRouted: true, expErr: true},
+		"bad calldata":    {memo: evmMemo(contract, "0102"), receiver: contract.Hex(), expRouted: true, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			routed, gotContract, calldata, err := evmibchooks.ValidateAndParseMemo(spec.memo, spec.receiver, contractAccount)
+			require.Equal(t, spec.expRouted, routed)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if routed {
+				require.Equal(t, contract, gotContract)
+				require.Equal(t, spec.expCalldata, calldata)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/CosmWasm/wasmd/x/precompile/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetParams(),
+		GetCmdActivePrecompiles(),
+		GetCmdPointer(),
+		GetCmdPointee(),
+	)
+
+	return cmd
+}
+
+// GetParams returns the params for the module
+func GetParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params [flags]",
+		Short: "Get the params for the x/precompile module",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdActivePrecompiles returns the precompiles callable at the latest height
+func GetCmdActivePrecompiles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active [flags]",
+		Short: "Get the active precompiles and the hash of their ABI",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActivePrecompiles(cmd.Context(), &types.QueryActivePrecompilesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPointer returns the pointer registered for a token contract
+func GetCmdPointer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer [cw20|erc20] [pointee] [flags]",
+		Short: "Get the pointer registered for a CW20 or ERC20 token contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pointerType, found := types.PointerType_value["POINTER_TYPE_"+strings.ToUpper(args[0])]
+			if !found || pointerType == int32(types.PointerTypeUnspecified) {
+				return fmt.Errorf("unknown pointer type %s", args[0])
+			}
+
+			res, err := queryClient.Pointer(cmd.Context(), &types.QueryPointerRequest{
+				Type:    types.PointerType(pointerType),
+				Pointee: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPointee returns the token contract a pointer is registered for
+func GetCmdPointee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointee [pointer] [flags]",
+		Short: "Get the token contract a pointer is registered for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pointee(cmd.Context(), &types.QueryPointeeRequest{Pointer: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
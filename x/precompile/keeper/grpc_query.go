@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CosmWasm/wasmd/x/precompile/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	keeper   Keeper
+	registry types.PrecompileRegistry
+}
+
+// NewQueryServer returns the precompile query server. registry provides the precompiles
+// deployed in the app.
+func NewQueryServer(keeper Keeper, registry types.PrecompileRegistry) types.QueryServer {
+	return queryServer{keeper: keeper, registry: registry}
+}
+
+// Params returns the precompile allow-list.
+func (q queryServer) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: q.keeper.GetParams(ctx)}, nil
+}
+
+// ActivePrecompiles returns the precompiles callable at the current block height together with
+// the hash of their ABI.
+func (q queryServer) ActivePrecompiles(goCtx context.Context, _ *types.QueryActivePrecompilesRequest) (*types.QueryActivePrecompilesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.keeper.GetParams(ctx)
+
+	active := []types.ActivePrecompile{}
+	for _, address := range q.registry.Addresses() {
+		if !params.IsActive(address, ctx.BlockHeight()) {
+			continue
+		}
+		abiHash, _ := q.registry.ABIHash(address)
+		active = append(active, types.ActivePrecompile{Address: address.Hex(), AbiHash: abiHash.Hex()})
+	}
+	return &types.QueryActivePrecompilesResponse{Precompiles: active}, nil
+}
+
+// Pointer returns the pointer registered for a token contract.
+func (q queryServer) Pointer(goCtx context.Context, req *types.QueryPointerRequest) (*types.QueryPointerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := types.NormalizePointee(req.Type, req.Pointee); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pointer, found := q.keeper.GetPointer(ctx, req.Type, req.Pointee)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no pointer for %s %s", req.Type, req.Pointee)
+	}
+	return &types.QueryPointerResponse{Pointer: pointer}, nil
+}
+
+// Pointee returns the token contract a pointer is registered for.
+func (q queryServer) Pointee(goCtx context.Context, req *types.QueryPointeeRequest) (*types.QueryPointeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := types.NormalizeAddress(req.Pointer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pointer, found := q.keeper.GetPointee(ctx, req.Pointer)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s is not a pointer", req.Pointer)
+	}
+	return &types.QueryPointeeResponse{Pointer: pointer}, nil
+}
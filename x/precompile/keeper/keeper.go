@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/CosmWasm/wasmd/precompile/contracts/cw20"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/CosmWasm/wasmd/x/precompile/types"
+)
+
+// Keeper stores the governance controlled allow-list of stateful precompiles and the registry
+// of token pointers.
+type Keeper struct {
+	storeService corestoretypes.KVStoreService
+	cdc          codec.BinaryCodec
+	evmKeeper    statedb.Keeper
+	wasmKeeper   types.WasmKeeper
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+	params    collections.Item[types.Params]
+	pointers  collections.Map[collections.Pair[int32, string], types.Pointer]
+	pointees  collections.Map[string, types.Pointer]
+}
+
+// NewKeeper returns a new instance of the x/precompile keeper
+func NewKeeper(cdc codec.BinaryCodec, storeService corestoretypes.KVStoreService, evmKeeper statedb.Keeper, wasmKeeper types.WasmKeeper, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	return Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		evmKeeper:    evmKeeper,
+		wasmKeeper:   wasmKeeper,
+		authority:    authority,
+		params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		pointers: collections.NewMap(sb, types.PointersKeyPrefix, "pointers",
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey), codec.CollValue[types.Pointer](cdc)),
+		pointees: collections.NewMap(sb, types.PointeesKeyPrefix, "pointees", collections.StringKey, codec.CollValue[types.Pointer](cdc)),
+	}
+}
+
+// GetAuthority returns the x/precompile module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the total set of precompile parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	p, err := k.params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// SetParams sets all precompile parameters.
+func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) error {
+	if err := ps.Validate(); err != nil {
+		return err
+	}
+	return k.params.Set(ctx, ps)
+}
+
+// IsPrecompileActive reports whether the precompile at address may be called at the current
+// block height. Reading the allow-list is not charged to the calling transaction. Precompiles
+// are inactive until the allow-list is initialised.
+func (k Keeper) IsPrecompileActive(ctx sdk.Context, address common.Address) bool {
+	params, err := k.params.Get(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return false
+	}
+	return params.IsActive(address, ctx.BlockHeight())
+}
+
+// RegisterPointer registers pointer as the pointer of the token contract pointee of type t and
+// returns the registered pointer. The ERC20 pointer of a CW20 is deployed to the EVM at the
+// address derived from the CW20, pointer must be empty for it. The CW20 pointer of an ERC20 is
+// a contract instantiated beforehand from the reference pointer code.
+func (k Keeper) RegisterPointer(ctx sdk.Context, t types.PointerType, pointee, pointer string) (types.Pointer, error) {
+	pointee, err := types.NormalizePointee(t, pointee)
+	if err != nil {
+		return types.Pointer{}, errorsmod.Wrap(types.ErrInvalidPointer, err.Error())
+	}
+	if _, found := k.GetPointer(ctx, t, pointee); found {
+		return types.Pointer{}, errorsmod.Wrapf(types.ErrPointerExists, "%s %s", t, pointee)
+	}
+
+	switch t {
+	case types.PointerTypeCW20:
+		if pointer, err = k.deployCw20Pointer(ctx, sdk.MustAccAddressFromBech32(pointee)); err != nil {
+			return types.Pointer{}, err
+		}
+	case types.PointerTypeERC20:
+		if pointer, err = k.checkErc20Pointer(ctx, common.HexToAddress(pointee), pointer); err != nil {
+			return types.Pointer{}, err
+		}
+	}
+
+	p := types.Pointer{Type: t, Pointee: pointee, Pointer: pointer}
+	if err := k.SetPointer(ctx, p); err != nil {
+		return types.Pointer{}, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterPointer,
+		sdk.NewAttribute(types.AttributeKeyPointerType, t.String()),
+		sdk.NewAttribute(types.AttributeKeyPointee, p.Pointee),
+		sdk.NewAttribute(types.AttributeKeyPointer, p.Pointer),
+	))
+	return p, nil
+}
+
+// deployCw20Pointer deploys the ERC20 pointer of the CW20 contract cw20Addr to the EVM.
+func (k Keeper) deployCw20Pointer(ctx sdk.Context, cw20Addr sdk.AccAddress) (string, error) {
+	if k.wasmKeeper.GetContractInfo(ctx, cw20Addr) == nil {
+		return "", errorsmod.Wrapf(types.ErrInvalidPointer, "contract %s does not exist", cw20Addr)
+	}
+	if _, err := k.wasmKeeper.QuerySmart(ctx, cw20Addr, []byte(`{"token_info":{}}`)); err != nil {
+		return "", errorsmod.Wrapf(types.ErrInvalidPointer, "contract %s is not a cw20 token: %s", cw20Addr, err)
+	}
+
+	stateDB := statedb.New(ctx, k.evmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	pointer, err := cw20.DeployPointer(stateDB, registry.Cw20ContractAddress, cw20Addr)
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrPointerExists, err.Error())
+	}
+	if err := stateDB.Commit(); err != nil {
+		return "", err
+	}
+	return pointer.Hex(), nil
+}
+
+// checkErc20Pointer checks that the ERC20 is a contract and pointer is an instantiated CosmWasm
+// contract, and returns the canonical address of pointer.
+func (k Keeper) checkErc20Pointer(ctx sdk.Context, erc20 common.Address, pointer string) (string, error) {
+	if account := k.evmKeeper.GetAccount(ctx, erc20); account == nil || !account.IsContract() {
+		return "", errorsmod.Wrapf(types.ErrInvalidPointer, "erc20 %s is not a contract", erc20.Hex())
+	}
+	pointer, err := types.NormalizePointer(types.PointerTypeERC20, pointer)
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrInvalidPointer, err.Error())
+	}
+	if k.wasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(pointer)) == nil {
+		return "", errorsmod.Wrapf(types.ErrInvalidPointer, "contract %s does not exist", pointer)
+	}
+	return pointer, nil
+}
+
+// SetPointer stores the pointer p, indexed by its pointee and by its address.
+func (k Keeper) SetPointer(ctx sdk.Context, p types.Pointer) error {
+	if err := p.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPointer, err.Error())
+	}
+	if has, err := k.pointees.Has(ctx, p.Pointer); err != nil {
+		return err
+	} else if has {
+		return errorsmod.Wrapf(types.ErrPointerExists, "%s is already a pointer", p.Pointer)
+	}
+	if err := k.pointers.Set(ctx, collections.Join(int32(p.Type), p.Pointee), p); err != nil {
+		return err
+	}
+	return k.pointees.Set(ctx, p.Pointer, p)
+}
+
+// GetPointer returns the pointer registered for the token contract pointee of type t.
+func (k Keeper) GetPointer(ctx sdk.Context, t types.PointerType, pointee string) (types.Pointer, bool) {
+	pointee, err := types.NormalizePointee(t, pointee)
+	if err != nil {
+		return types.Pointer{}, false
+	}
+	p, err := k.pointers.Get(ctx, collections.Join(int32(t), pointee))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.Pointer{}, false
+	}
+	return p, true
+}
+
+// GetPointee returns the registered pointer with the address pointer.
+func (k Keeper) GetPointee(ctx sdk.Context, pointer string) (types.Pointer, bool) {
+	pointer, err := types.NormalizeAddress(pointer)
+	if err != nil {
+		return types.Pointer{}, false
+	}
+	p, err := k.pointees.Get(ctx, pointer)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			panic(err)
+		}
+		return types.Pointer{}, false
+	}
+	return p, true
+}
+
+// GetAllPointers returns all registered pointers ordered by type and pointee.
+func (k Keeper) GetAllPointers(ctx sdk.Context) []types.Pointer {
+	pointers := []types.Pointer{}
+	err := k.pointers.Walk(ctx, nil, func(_ collections.Pair[int32, string], p types.Pointer) (bool, error) {
+		pointers = append(pointers, p)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return pointers
+}
+
+// InitGenesis initializes the precompile module's state from a provided genesis state. The code
+// of ERC20 pointers is part of the EVM state and imported with it.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	for _, p := range genState.Pointers {
+		if err := k.SetPointer(ctx, p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the precompile module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:   k.GetParams(ctx),
+		Pointers: k.GetAllPointers(ctx),
+	}
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CosmWasm/wasmd/app"
+	"github.com/CosmWasm/wasmd/precompile/registry"
+	"github.com/CosmWasm/wasmd/x/precompile/keeper"
+	"github.com/CosmWasm/wasmd/x/precompile/types"
+)
+
+func TestIsPrecompileActive(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false).WithBlockHeight(10)
+	k := tApp.PrecompileKeeper
+
+	// every precompile is enabled from genesis by default
+	for _, address := range registry.Addresses() {
+		assert.True(t, k.IsPrecompileActive(ctx, address), address.Hex())
+	}
+
+	require.NoError(t, k.SetParams(ctx, types.Params{Precompiles: []types.PrecompileActivation{
+		{Address: registry.BankContractAddress.Hex(), Enabled: true},
+		{Address: registry.BankContractAddress.Hex(), Enabled: false, ActivationHeight: 11},
+		{Address: registry.JsonContractAddress.Hex(), Enabled: true, ActivationHeight: 11},
+	}}))
+	assert.True(t, k.IsPrecompileActive(ctx, registry.BankContractAddress))
+	assert.False(t, k.IsPrecompileActive(ctx, registry.JsonContractAddress))
+	assert.False(t, k.IsPrecompileActive(ctx, registry.WasmdContractAddress))
+	ctx = ctx.WithBlockHeight(11)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	assert.False(t, k.IsPrecompileActive(ctx, registry.BankContractAddress))
+	assert.True(t, k.IsPrecompileActive(ctx, registry.JsonContractAddress))
+
+	// reading the allow-list is not charged
+	assert.Equal(t, gasBefore, ctx.GasMeter().GasConsumed())
+}
+
+func TestIsPrecompileActiveWithoutParams(t *testing.T) {
+	// the store of a chain that upgrades to the precompile module before its genesis is initialised
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), nil, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	assert.False(t, k.IsPrecompileActive(ctx, registry.BankContractAddress))
+
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	assert.True(t, k.IsPrecompileActive(ctx, registry.BankContractAddress))
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	msgServer := keeper.NewMsgServerImpl(tApp.PrecompileKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	disabled := types.Params{Precompiles: []types.PrecompileActivation{
+		{Address: registry.BankContractAddress.Hex(), Enabled: false},
+	}}
+
+	specs := map[string]struct {
+		msg    *types.MsgUpdateParams
+		expErr bool
+	}{
+		"all good": {
+			msg: &types.MsgUpdateParams{Authority: authority, Params: disabled},
+		},
+		"not the authority": {
+			msg:    &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress("other").String(), Params: disabled},
+			expErr: true,
+		},
+		"invalid params": {
+			msg: &types.MsgUpdateParams{Authority: authority, Params: types.Params{Precompiles: []types.PrecompileActivation{
+				{Address: "bank", Enabled: true},
+			}}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			before := tApp.PrecompileKeeper.GetParams(ctx)
+
+			_, err := msgServer.UpdateParams(ctx, spec.msg)
+
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, before, tApp.PrecompileKeeper.GetParams(ctx))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.msg.Params, tApp.PrecompileKeeper.GetParams(ctx))
+		})
+	}
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress("other").String(), Params: disabled})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+}
+
+func TestQueryActivePrecompiles(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false).WithBlockHeight(10)
+	queryServer := keeper.NewQueryServer(tApp.PrecompileKeeper, tApp.Precompiles)
+
+	params := types.Params{Precompiles: []types.PrecompileActivation{
+		{Address: registry.BankContractAddress.Hex(), Enabled: true},
+		{Address: registry.JsonContractAddress.Hex(), Enabled: true, ActivationHeight: 11},
+		{Address: registry.WasmdContractAddress.Hex(), Enabled: false},
+	}}
+	require.NoError(t, tApp.PrecompileKeeper.SetParams(ctx, params))
+
+	paramsRes, err := queryServer.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, params, paramsRes.Params)
+
+	res, err := queryServer.ActivePrecompiles(ctx, &types.QueryActivePrecompilesRequest{})
+	require.NoError(t, err)
+	abiHash, ok := tApp.Precompiles.ABIHash(registry.BankContractAddress)
+	require.True(t, ok)
+	assert.Equal(t, []types.ActivePrecompile{{Address: registry.BankContractAddress.Hex(), AbiHash: abiHash.Hex()}}, res.Precompiles)
+
+	res, err = queryServer.ActivePrecompiles(ctx.WithBlockHeight(11), &types.QueryActivePrecompilesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Precompiles, 2)
+}
+
+func TestQueryPointer(t *testing.T) {
+	tApp := app.Setup(t)
+	ctx := tApp.NewContext(false)
+	queryServer := keeper.NewQueryServer(tApp.PrecompileKeeper, tApp.Precompiles)
+	erc20 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	cw20 := authtypes.NewModuleAddress("cw20").String()
+	pointer := types.Pointer{Type: types.PointerTypeERC20, Pointee: erc20.Hex(), Pointer: cw20}
+	require.NoError(t, tApp.PrecompileKeeper.SetPointer(ctx, pointer))
+
+	// the pointer address cannot point to another token
+	err := tApp.PrecompileKeeper.SetPointer(ctx, types.Pointer{Type: types.PointerTypeERC20, Pointee: common.HexToAddress("0x02").Hex(), Pointer: cw20})
+	require.ErrorIs(t, err, types.ErrPointerExists)
+
+	pointerRes, err := queryServer.Pointer(ctx, &types.QueryPointerRequest{Type: types.PointerTypeERC20, Pointee: erc20.Hex()})
+	require.NoError(t, err)
+	assert.Equal(t, pointer, pointerRes.Pointer)
+	pointeeRes, err := queryServer.Pointee(ctx, &types.QueryPointeeRequest{Pointer: cw20})
+	require.NoError(t, err)
+	assert.Equal(t, pointer, pointeeRes.Pointer)
+
+	_, err = queryServer.Pointer(ctx, &types.QueryPointerRequest{Type: types.PointerTypeCW20, Pointee: cw20})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = queryServer.Pointer(ctx, &types.QueryPointerRequest{Type: types.PointerTypeERC20, Pointee: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.Pointee(ctx, &types.QueryPointeeRequest{Pointer: erc20.Hex()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = queryServer.Pointee(ctx, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.Equal(t, []types.Pointer{pointer}, tApp.PrecompileKeeper.GetAllPointers(ctx))
+}
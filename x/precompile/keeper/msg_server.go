@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/precompile/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams replaces the precompile allow-list, it is only executable by the module authority.
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if m.authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", m.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterPointer registers the pointer of a token contract, it is only executable by the module
+// authority.
+func (m msgServer) RegisterPointer(goCtx context.Context, req *types.MsgRegisterPointer) (*types.MsgRegisterPointerResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if m.authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", m.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pointer, err := m.Keeper.RegisterPointer(ctx, req.Type, req.Pointee, req.Pointer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterPointerResponse{Pointer: pointer.Pointer}, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/precompile module sentinel errors
+var (
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidPointer   = errorsmod.Register(ModuleName, 3, "invalid pointer")
+	ErrPointerExists    = errorsmod.Register(ModuleName, 4, "pointer already exists")
+	ErrPointerNotFound  = errorsmod.Register(ModuleName, 5, "pointer not found")
+)
@@ -0,0 +1,10 @@
+package types
+
+// precompile module event types
+const (
+	EventTypeRegisterPointer = "register_pointer"
+
+	AttributeKeyPointerType = "pointer_type"
+	AttributeKeyPointee     = "pointee"
+	AttributeKeyPointer     = "pointer"
+)
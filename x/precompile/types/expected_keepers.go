@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// PrecompileRegistry is the set of precompiles the app can serve.
+type PrecompileRegistry interface {
+	Addresses() []common.Address
+	ABIHash(address common.Address) (common.Hash, bool)
+}
+
+// WasmKeeper is the part of the wasm keeper used to check the contracts of pointers.
+type WasmKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
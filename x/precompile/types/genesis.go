@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default precompile genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	pointees := make(map[string]struct{}, len(gs.Pointers))
+	pointers := make(map[string]struct{}, len(gs.Pointers))
+	for _, p := range gs.Pointers {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s", p.Type, p.Pointee)
+		if _, found := pointees[key]; found {
+			return fmt.Errorf("duplicate pointer for %s", p.Pointee)
+		}
+		if _, found := pointers[p.Pointer]; found {
+			return fmt.Errorf("duplicate pointer %s", p.Pointer)
+		}
+		pointees[key], pointers[p.Pointer] = struct{}{}, struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidatePointers(t *testing.T) {
+	cw20 := sdk.AccAddress(make([]byte, 32)).String()
+	erc20 := "0x9000000000000000000000000000000000000001"
+	pointer := Pointer{Type: PointerTypeCW20, Pointee: cw20, Pointer: erc20}
+	specs := map[string]struct {
+		src    []Pointer
+		expErr bool
+	}{
+		"cw20 and erc20 pointers": {
+			src: []Pointer{pointer, {Type: PointerTypeERC20, Pointee: "0x9000000000000000000000000000000000000002", Pointer: cw20}},
+		},
+		"unknown type": {
+			src:    []Pointer{{Pointee: cw20, Pointer: erc20}},
+			expErr: true,
+		},
+		"swapped addresses": {
+			src:    []Pointer{{Type: PointerTypeCW20, Pointee: erc20, Pointer: cw20}},
+			expErr: true,
+		},
+		"not canonical": {
+			src:    []Pointer{{Type: PointerTypeCW20, Pointee: cw20, Pointer: "0xabcdef0000000000000000000000000000000001"}},
+			expErr: true,
+		},
+		"duplicate pointee": {
+			src:    []Pointer{pointer, {Type: PointerTypeCW20, Pointee: cw20, Pointer: "0x9000000000000000000000000000000000000002"}},
+			expErr: true,
+		},
+		"duplicate pointer": {
+			src:    []Pointer{pointer, {Type: PointerTypeCW20, Pointee: sdk.AccAddress(make([]byte, 20)).String(), Pointer: erc20}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := GenesisState{Params: DefaultParams(), Pointers: spec.src}.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "precompile"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the precompile module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ParamsKey is the store key of the module parameters.
+	ParamsKey = []byte{0x01}
+	// PointersKeyPrefix indexes the registered pointers by type and pointee.
+	PointersKeyPrefix = []byte{0x02}
+	// PointeesKeyPrefix indexes the registered pointers by pointer address.
+	PointeesKeyPrefix = []byte{0x03}
+)
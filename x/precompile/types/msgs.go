@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterPointer{}
+)
+
+// ValidateBasic performs stateless validation of MsgUpdateParams.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return m.Params.Validate()
+}
+
+// ValidateBasic performs stateless validation of MsgRegisterPointer.
+func (m MsgRegisterPointer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := NormalizePointee(m.Type, m.Pointee); err != nil {
+		return errorsmod.Wrap(ErrInvalidPointer, err.Error())
+	}
+	// the ERC20 pointer of a CW20 is deployed by the module, a CW20 pointer is instantiated beforehand
+	if m.Type == PointerTypeCW20 {
+		if m.Pointer != "" {
+			return errorsmod.Wrap(ErrInvalidPointer, "the pointer of a cw20 is derived from its address")
+		}
+		return nil
+	}
+	if _, err := NormalizePointer(m.Type, m.Pointer); err != nil {
+		return errorsmod.Wrap(ErrInvalidPointer, err.Error())
+	}
+	return nil
+}
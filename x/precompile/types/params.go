@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/CosmWasm/wasmd/precompile/registry"
+)
+
+// DefaultParams enables every precompile known to the registry from genesis.
+func DefaultParams() Params {
+	addresses := registry.Addresses()
+	precompiles := make([]PrecompileActivation, 0, len(addresses))
+	for _, address := range addresses {
+		precompiles = append(precompiles, PrecompileActivation{Address: address.Hex(), Enabled: true})
+	}
+	return Params{Precompiles: precompiles}
+}
+
+// Validate performs basic validation of the precompile allow-list.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.Precompiles))
+	for _, entry := range p.Precompiles {
+		if !common.IsHexAddress(entry.Address) {
+			return fmt.Errorf("invalid precompile address %q", entry.Address)
+		}
+		if entry.ActivationHeight < 0 {
+			return fmt.Errorf("negative activation height %d for precompile %s", entry.ActivationHeight, entry.Address)
+		}
+		key := fmt.Sprintf("%s/%d", common.HexToAddress(entry.Address).Hex(), entry.ActivationHeight)
+		if _, found := seen[key]; found {
+			return fmt.Errorf("duplicate entry for precompile %s at height %d", entry.Address, entry.ActivationHeight)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// IsActive reports whether the precompile at address is enabled at height. The entry with the
+// highest activation height not above height is in effect, without one the precompile is disabled.
+func (p Params) IsActive(address common.Address, height int64) bool {
+	var (
+		effective *PrecompileActivation
+		found     bool
+	)
+	for i, entry := range p.Precompiles {
+		if entry.ActivationHeight > height || common.HexToAddress(entry.Address) != address {
+			continue
+		}
+		if !found || entry.ActivationHeight > effective.ActivationHeight {
+			effective, found = &p.Precompiles[i], true
+		}
+	}
+	return found && effective.Enabled
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	address := "0x9000000000000000000000000000000000000001"
+	specs := map[string]struct {
+		src    Params
+		expErr bool
+	}{
+		"default": {
+			src: DefaultParams(),
+		},
+		"empty": {
+			src: Params{},
+		},
+		"same address at different heights": {
+			src: Params{Precompiles: []PrecompileActivation{
+				{Address: address, Enabled: true},
+				{Address: address, Enabled: false, ActivationHeight: 10},
+			}},
+		},
+		"invalid address": {
+			src:    Params{Precompiles: []PrecompileActivation{{Address: "0x1234", Enabled: true}}},
+			expErr: true,
+		},
+		"negative height": {
+			src:    Params{Precompiles: []PrecompileActivation{{Address: address, ActivationHeight: -1}}},
+			expErr: true,
+		},
+		"duplicate entry": {
+			src: Params{Precompiles: []PrecompileActivation{
+				{Address: address, Enabled: true, ActivationHeight: 10},
+				{Address: "0x9000000000000000000000000000000000000001", Enabled: false, ActivationHeight: 10},
+			}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsIsActive(t *testing.T) {
+	address := common.HexToAddress("0x9000000000000000000000000000000000000001")
+	params := Params{Precompiles: []PrecompileActivation{
+		{Address: address.Hex(), Enabled: true, ActivationHeight: 10},
+		{Address: address.Hex(), Enabled: false, ActivationHeight: 20},
+	}}
+
+	assert.False(t, params.IsActive(address, 9))
+	assert.True(t, params.IsActive(address, 10))
+	assert.True(t, params.IsActive(address, 19))
+	assert.False(t, params.IsActive(address, 20))
+	assert.False(t, params.IsActive(common.HexToAddress("0x9000000000000000000000000000000000000002"), 15))
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NormalizePointee returns the canonical address of the token contract pointee of type t.
+func NormalizePointee(t PointerType, pointee string) (string, error) {
+	switch t {
+	case PointerTypeCW20:
+		return normalizeBech32(pointee)
+	case PointerTypeERC20:
+		return normalizeHex(pointee)
+	default:
+		return "", fmt.Errorf("unknown pointer type %s", t)
+	}
+}
+
+// NormalizePointer returns the canonical address of the pointer of a token contract of type t.
+func NormalizePointer(t PointerType, pointer string) (string, error) {
+	switch t {
+	case PointerTypeCW20:
+		return normalizeHex(pointer)
+	case PointerTypeERC20:
+		return normalizeBech32(pointer)
+	default:
+		return "", fmt.Errorf("unknown pointer type %s", t)
+	}
+}
+
+// NormalizeAddress returns the canonical form of a 0x hex or bech32 address.
+func NormalizeAddress(address string) (string, error) {
+	if common.IsHexAddress(address) {
+		return normalizeHex(address)
+	}
+	return normalizeBech32(address)
+}
+
+// Validate checks that p links addresses of the kinds its type requires in canonical form.
+func (p Pointer) Validate() error {
+	pointee, err := NormalizePointee(p.Type, p.Pointee)
+	if err != nil {
+		return err
+	}
+	pointer, err := NormalizePointer(p.Type, p.Pointer)
+	if err != nil {
+		return err
+	}
+	if pointee != p.Pointee || pointer != p.Pointer {
+		return fmt.Errorf("pointer %s of %s is not in canonical form", p.Pointer, p.Pointee)
+	}
+	return nil
+}
+
+func normalizeBech32(address string) (string, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid bech32 address %q: %w", address, err)
+	}
+	return addr.String(), nil
+}
+
+func normalizeHex(address string) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid hex address %q", address)
+	}
+	return common.HexToAddress(address).Hex(), nil
+}
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/precompile/v1/pointer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PointerType is the token standard of the contract a pointer is backed by.
+type PointerType int32
+
+const (
+	// POINTER_TYPE_UNSPECIFIED is not a valid pointer type.
+	PointerTypeUnspecified PointerType = 0
+	// POINTER_TYPE_CW20 is an ERC20 pointer in the EVM backed by a CW20
+	// contract.
+	PointerTypeCW20 PointerType = 1
+	// POINTER_TYPE_ERC20 is a CW20 pointer contract backed by an ERC20 contract
+	// in the EVM.
+	PointerTypeERC20 PointerType = 2
+)
+
+var PointerType_name = map[int32]string{
+	0: "POINTER_TYPE_UNSPECIFIED",
+	1: "POINTER_TYPE_CW20",
+	2: "POINTER_TYPE_ERC20",
+}
+
+var PointerType_value = map[string]int32{
+	"POINTER_TYPE_UNSPECIFIED": 0,
+	"POINTER_TYPE_CW20":        1,
+	"POINTER_TYPE_ERC20":       2,
+}
+
+func (x PointerType) String() string {
+	return proto.EnumName(PointerType_name, int32(x))
+}
+
+func (PointerType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e4985bf7a6c6fedc, []int{0}
+}
+
+// Pointer links a token contract to the contract exposing it in the other VM.
+type Pointer struct {
+	// type is the token standard of the pointee.
+	Type PointerType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmwasm.precompile.v1.PointerType" json:"type,omitempty"`
+	// pointee is the address of the token contract, bech32 for a CW20 and 0x
+	// hex for an ERC20.
+	Pointee string `protobuf:"bytes,2,opt,name=pointee,proto3" json:"pointee,omitempty"`
+	// pointer is the address of the contract exposing the pointee, 0x hex for
+	// the ERC20 pointer of a CW20 and bech32 for the CW20 pointer of an ERC20.
+	Pointer string `protobuf:"bytes,3,opt,name=pointer,proto3" json:"pointer,omitempty"`
+}
+
+func (m *Pointer) Reset()         { *m = Pointer{} }
+func (m *Pointer) String() string { return proto.CompactTextString(m) }
+func (*Pointer) ProtoMessage()    {}
+func (*Pointer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4985bf7a6c6fedc, []int{0}
+}
+func (m *Pointer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pointer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pointer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pointer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pointer.Merge(m, src)
+}
+func (m *Pointer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pointer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pointer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pointer proto.InternalMessageInfo
+
+func (m *Pointer) GetType() PointerType {
+	if m != nil {
+		return m.Type
+	}
+	return PointerTypeUnspecified
+}
+
+func (m *Pointer) GetPointee() string {
+	if m != nil {
+		return m.Pointee
+	}
+	return ""
+}
+
+func (m *Pointer) GetPointer() string {
+	if m != nil {
+		return m.Pointer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cosmwasm.precompile.v1.PointerType", PointerType_name, PointerType_value)
+	proto.RegisterType((*Pointer)(nil), "cosmwasm.precompile.v1.Pointer")
+}
+
+func init() {
+	proto.RegisterFile("cosmwasm/precompile/v1/pointer.proto", fileDescriptor_e4985bf7a6c6fedc)
+}
+
+var fileDescriptor_e4985bf7a6c6fedc = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x10, 0xc7, 0xbb, 0x7c, 0xe4, 0x23, 0xae, 0x89, 0xd6, 0x95, 0x90, 0x66, 0x0f, 0x9b, 0x46, 0x3d,
+	0x10, 0x42, 0x76, 0x01, 0x0f, 0x7a, 0xb6, 0x96, 0x84, 0x0b, 0x36, 0x15, 0x42, 0xf4, 0x42, 0xa4,
+	0xac, 0xd8, 0xc4, 0xb2, 0x9b, 0xb6, 0xa2, 0xbc, 0x81, 0xe1, 0xe4, 0x0b, 0x70, 0xf2, 0xe0, 0xab,
+	0x78, 0xe4, 0xe8, 0xd1, 0xc0, 0x8b, 0x98, 0xb6, 0x12, 0x96, 0xc4, 0xdb, 0xee, 0xcc, 0xef, 0x37,
+	0x99, 0xfc, 0x07, 0x9e, 0x78, 0x22, 0x0a, 0x9e, 0xef, 0xa2, 0x80, 0xc9, 0x90, 0x7b, 0x22, 0x90,
+	0xfe, 0x23, 0x67, 0x93, 0x3a, 0x93, 0xc2, 0x1f, 0xc7, 0x3c, 0xa4, 0x32, 0x14, 0xb1, 0x40, 0xa5,
+	0x35, 0x45, 0x37, 0x14, 0x9d, 0xd4, 0x71, 0x71, 0x24, 0x46, 0x22, 0x45, 0x58, 0xf2, 0xca, 0xe8,
+	0xa3, 0x09, 0x2c, 0x38, 0x99, 0x8e, 0xce, 0x60, 0x3e, 0x9e, 0x4a, 0x6e, 0x00, 0x13, 0x94, 0xf7,
+	0x1a, 0xc7, 0xf4, 0xef, 0x39, 0xf4, 0x17, 0xef, 0x4c, 0x25, 0x77, 0x53, 0x01, 0x19, 0xb0, 0x90,
+	0xad, 0xc0, 0x8d, 0x9c, 0x09, 0xca, 0x3b, 0xee, 0xfa, 0xbb, 0xe9, 0x84, 0xc6, 0x3f, 0xb5, 0x13,
+	0x56, 0x3e, 0x00, 0xdc, 0x55, 0x26, 0xa1, 0x73, 0x68, 0x38, 0x57, 0xad, 0x76, 0xc7, 0x76, 0xfb,
+	0x9d, 0x1b, 0xc7, 0xee, 0x77, 0xdb, 0xd7, 0x8e, 0x6d, 0xb5, 0x9a, 0x2d, 0xfb, 0x52, 0xd7, 0x30,
+	0x9e, 0xcd, 0xcd, 0x92, 0x82, 0x77, 0xc7, 0x91, 0xe4, 0x9e, 0x7f, 0xef, 0xf3, 0x21, 0xaa, 0xc0,
+	0x83, 0x2d, 0xd3, 0xea, 0x35, 0x6a, 0x3a, 0xc0, 0x87, 0xb3, 0xb9, 0xb9, 0xaf, 0x28, 0x49, 0x19,
+	0x55, 0x21, 0xda, 0x62, 0x6d, 0xd7, 0x6a, 0xd4, 0xf4, 0x1c, 0x2e, 0xce, 0xe6, 0xa6, 0xae, 0xc0,
+	0x69, 0x1d, 0xe7, 0x5f, 0xdf, 0x89, 0x76, 0xd1, 0xfc, 0x5c, 0x12, 0xb0, 0x58, 0x12, 0xf0, 0xbd,
+	0x24, 0xe0, 0x6d, 0x45, 0xb4, 0xc5, 0x8a, 0x68, 0x5f, 0x2b, 0xa2, 0xdd, 0x56, 0x47, 0x7e, 0xfc,
+	0xf0, 0x34, 0xa0, 0x9e, 0x08, 0x98, 0x25, 0xa2, 0xa0, 0x97, 0x9c, 0x26, 0x49, 0x6c, 0xc8, 0x5e,
+	0xd4, 0x13, 0x25, 0x21, 0x45, 0x83, 0xff, 0x69, 0xe0, 0xa7, 0x3f, 0x03, 0x00, 0x9e, 0x11, 0xf1,
+	0xef, 0xc6, 0x01, 0x00, 0x00,
+}
+
+func (m *Pointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pointer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pointer) > 0 {
+		i -= len(m.Pointer)
+		copy(dAtA[i:], m.Pointer)
+		i = encodeVarintPointer(dAtA, i, uint64(len(m.Pointer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pointee) > 0 {
+		i -= len(m.Pointee)
+		copy(dAtA[i:], m.Pointee)
+		i = encodeVarintPointer(dAtA, i, uint64(len(m.Pointee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPointer(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPointer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPointer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pointer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPointer(uint64(m.Type))
+	}
+	l = len(m.Pointee)
+	if l > 0 {
+		n += 1 + l + sovPointer(uint64(l))
+	}
+	l = len(m.Pointer)
+	if l > 0 {
+		n += 1 + l + sovPointer(uint64(l))
+	}
+	return n
+}
+
+func sovPointer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPointer(x uint64) (n int) {
+	return sovPointer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pointer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPointer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pointer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pointer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPointer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPointer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPointer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPointer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPointer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPointer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPointer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPointer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPointer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPointer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPointer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPointer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPointer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPointer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPointer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -712,23 +712,26 @@ func ProposalSetGaslessContractsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-gasless [contract-addresses]",
 		Short: "Submit a set gasless contracts proposal",
-		Args:  cobra.MinimumNArgs(1),
+		Long: "Submit a set gasless contracts proposal. Executions are gasless only for the execute messages with the allowed top-level keys, " +
+			"or all messages when none are given, until the expiry height, if any. They are bounded by the gas limits, and the fees of " +
+			"transactions only executing the contracts are charged to the sponsor, or waived without one.",
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
-
-			msg := types.SetGasLessContractsProposal{
-				Title:             proposalTitle,
-				Description:       summary,
-				ContractAddresses: args,
+			config, err := parseGaslessConfigFlags(cmd.Flags())
+			if err != nil {
+				return err
 			}
+
+			msg := types.NewSetGaslessContractsProposal(proposalTitle, summary, args, config)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
 			if err != nil {
 				return err
 			}
@@ -737,11 +740,44 @@ func ProposalSetGaslessContractsCmd() *cobra.Command {
 		},
 		SilenceUsage: true,
 	}
+	defaultConfig := types.DefaultGaslessConfig()
+	cmd.Flags().StringSlice(flagAllowedMsgKeys, []string{}, "Top-level keys of the execute messages that are gasless, all messages when empty")
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Last block height in which executions are gasless, 0 for no expiry")
+	cmd.Flags().Uint64(flagMaxGasPerExecution, defaultConfig.MaxGasPerExecution, "Gas limit of a single execution")
+	cmd.Flags().Uint64(flagMaxGasPerBlock, defaultConfig.MaxGasPerBlock, "Gas all executions of a contract can use in a block")
+	cmd.Flags().Uint64(flagMaxGasPerSenderPerBlock, 0, "Gas the executions of a single sender can use in a block, 0 for no limit")
+	cmd.Flags().String(flagSponsor, "", "Account charged the fees of transactions that only execute the contracts, the min fee is waived when empty")
 	// proposal flags
 	addCommonProposalFlags(cmd)
 	return cmd
 }
 
+func parseGaslessConfigFlags(flags *flag.FlagSet) (types.GaslessConfig, error) {
+	var (
+		config types.GaslessConfig
+		err    error
+	)
+	if config.AllowedMessages, err = flags.GetStringSlice(flagAllowedMsgKeys); err != nil {
+		return config, fmt.Errorf("allowed msg keys: %s", err)
+	}
+	if config.ExpiryHeight, err = flags.GetUint64(flagExpiryHeight); err != nil {
+		return config, fmt.Errorf("expiry height: %s", err)
+	}
+	if config.MaxGasPerExecution, err = flags.GetUint64(flagMaxGasPerExecution); err != nil {
+		return config, fmt.Errorf("max gas per execution: %s", err)
+	}
+	if config.MaxGasPerBlock, err = flags.GetUint64(flagMaxGasPerBlock); err != nil {
+		return config, fmt.Errorf("max gas per block: %s", err)
+	}
+	if config.MaxGasPerSenderPerBlock, err = flags.GetUint64(flagMaxGasPerSenderPerBlock); err != nil {
+		return config, fmt.Errorf("max gas per sender per block: %s", err)
+	}
+	if config.Sponsor, err = flags.GetString(flagSponsor); err != nil {
+		return config, fmt.Errorf("sponsor: %s", err)
+	}
+	return config, nil
+}
+
 func ProposalUnsetGaslessContractsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unset-gasless [contract-addresses]",
@@ -158,3 +158,38 @@ func TestParseCodeInfoFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGaslessConfigFlags(t *testing.T) {
+	specs := map[string]struct {
+		args   []string
+		expCfg types.GaslessConfig
+	}{
+		"defaults": {
+			args:   []string{},
+			expCfg: types.GaslessConfig{MaxGasPerExecution: 10_000_000, MaxGasPerBlock: 100_000_000, AllowedMessages: []string{}},
+		},
+		"all set": {
+			args: []string{
+				"--max-gas-per-execution=1000", "--max-gas-per-block=10000", "--max-gas-per-sender-per-block=5000",
+				"--sponsor=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x", "--allow-msg-keys=claim,stake", "--expiry-height=100",
+			},
+			expCfg: types.GaslessConfig{
+				MaxGasPerExecution:      1_000,
+				MaxGasPerBlock:          10_000,
+				MaxGasPerSenderPerBlock: 5_000,
+				Sponsor:                 "cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x",
+				AllowedMessages:         []string{"claim", "stake"},
+				ExpiryHeight:            100,
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := ProposalSetGaslessContractsCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotCfg, gotErr := parseGaslessConfigFlags(flags)
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expCfg, gotCfg)
+		})
+	}
+}
@@ -48,6 +48,11 @@ const (
 	flagNoTokenTransfer           = "no-token-transfer"
 	flagAuthority                 = "authority"
 	flagExpedite                  = "expedite"
+	flagExpiryHeight              = "expiry-height"
+	flagMaxGasPerExecution        = "max-gas-per-execution"
+	flagMaxGasPerBlock            = "max-gas-per-block"
+	flagMaxGasPerSenderPerBlock   = "max-gas-per-sender-per-block"
+	flagSponsor                   = "sponsor"
 )
 
 // GetTxCmd returns the transaction commands for this module
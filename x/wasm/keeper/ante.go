@@ -1,13 +1,18 @@
 package keeper
 
 import (
+	"context"
 	"encoding/binary"
 
 	corestoretypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -134,3 +139,156 @@ func (d TxContractsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	txContracts := types.NewTxContracts()
 	return next(types.WithTxContracts(ctx, txContracts), tx, simulate)
 }
+
+// GaslessConfigSource returns the configuration of gasless contracts and reserves their gas budgets
+type GaslessConfigSource interface {
+	GetGaslessConfig(ctx context.Context, contractAddr sdk.AccAddress) (types.GaslessConfig, bool)
+	ReserveGaslessGas(ctx sdk.Context, contractAddr, sender sdk.AccAddress, config types.GaslessConfig) (sdk.Context, error)
+}
+
+// GaslessFeeDecorator ante decorator to sponsor the fees of transactions that only execute gasless contracts.
+// It wraps the decorators checking the min fee and deducting the fee of other transactions.
+type GaslessFeeDecorator struct {
+	keeper        GaslessConfigSource
+	accountKeeper ante.AccountKeeper
+	bankKeeper    authtypes.BankKeeper
+	maxGas        storetypes.Gas
+	minGasPrices  func(ctx sdk.Context) sdk.DecCoins
+	minFee        sdk.AnteHandler
+	deductFee     sdk.AnteHandler
+}
+
+// NewGaslessFeeDecorator constructor. Transactions with a gas limit above maxGas are never sponsored.
+// The fee charged to a sponsor is capped at the min fee derived from minGasPrices, which must be
+// deterministic as the cap applies when finalizing blocks.
+func NewGaslessFeeDecorator(
+	k GaslessConfigSource,
+	ak ante.AccountKeeper,
+	bk authtypes.BankKeeper,
+	maxGas storetypes.Gas,
+	minGasPrices func(ctx sdk.Context) sdk.DecCoins,
+	minFee sdk.AnteDecorator,
+	deductFee sdk.AnteDecorator,
+) *GaslessFeeDecorator {
+	return &GaslessFeeDecorator{
+		keeper:        k,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		maxGas:        maxGas,
+		minGasPrices:  minGasPrices,
+		minFee:        sdk.ChainAnteDecorators(minFee),
+		deductFee:     sdk.ChainAnteDecorators(deductFee),
+	}
+}
+
+// AnteHandle sponsors transactions made up only of MsgExecuteContract to gasless contracts that share the
+// same sponsor and cover the executed messages, within the gas limit. When the contracts have a sponsor, the
+// fee must meet the min fee without exceeding it and is charged to the sponsor. Otherwise the min fee is
+// waived and a fee, if any, is charged to the fee payer. The gas of each execution is reserved in the block
+// and sender budgets of its contract, and such transactions are rejected once a budget is used up.
+// Any other transaction, or one with a fee granter, goes through the wrapped decorators.
+func (d GaslessFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	ctx, sponsor, sponsored, err := d.sponsorOf(ctx, feeTx)
+	if err != nil {
+		return ctx, err
+	}
+	if !sponsored {
+		newCtx, err := d.minFee(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		newCtx, err = d.deductFee(newCtx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return next(newCtx, tx, simulate)
+	}
+
+	payer := sdk.AccAddress(feeTx.FeePayer())
+	fee := feeTx.GetFee()
+	if sponsor != "" {
+		newCtx, err := d.minFee(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		ctx = newCtx
+		if maxFee := d.requiredFee(ctx, feeTx.GetGas()); !fee.IsAllLTE(maxFee) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sponsored fee %s exceeds the min fee %s", fee, maxFee)
+		}
+		payer = sdk.MustAccAddressFromBech32(sponsor)
+	}
+	payerAcc := d.accountKeeper.GetAccount(ctx, payer)
+	if payerAcc == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", payer)
+	}
+	if !fee.IsZero() {
+		if err := ante.DeductFees(d.bankKeeper, ctx, payerAcc, fee); err != nil {
+			return ctx, err
+		}
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, payer.String()),
+	))
+	return next(ctx, tx, simulate)
+}
+
+// sponsorOf returns the sponsor of the gasless contracts executed by tx, empty when their min fee is waived,
+// and a context holding the gas reserved for their executions. It fails when the gas budget of a contract is
+// used up for a sender.
+func (d GaslessFeeDecorator) sponsorOf(ctx sdk.Context, tx sdk.FeeTx) (sdk.Context, string, bool, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 || tx.FeeGranter() != nil || tx.GetGas() > d.maxGas {
+		return ctx, "", false, nil
+	}
+	type gaslessBudget struct {
+		contract, sender sdk.AccAddress
+		config           types.GaslessConfig
+	}
+	budgets := make([]gaslessBudget, 0, len(msgs))
+	var sponsor string
+	for i, msg := range msgs {
+		execMsg, ok := msg.(*types.MsgExecuteContract)
+		if !ok {
+			return ctx, "", false, nil
+		}
+		contractAddr, err := sdk.AccAddressFromBech32(execMsg.Contract)
+		if err != nil {
+			return ctx, "", false, nil
+		}
+		senderAddr, err := sdk.AccAddressFromBech32(execMsg.Sender)
+		if err != nil {
+			return ctx, "", false, nil
+		}
+		config, ok := d.keeper.GetGaslessConfig(ctx, contractAddr)
+		if !ok || !config.Covers(ctx.BlockHeight(), execMsg.Msg) || (i > 0 && config.Sponsor != sponsor) {
+			return ctx, "", false, nil
+		}
+		sponsor = config.Sponsor
+		budgets = append(budgets, gaslessBudget{contract: contractAddr, sender: senderAddr, config: config})
+	}
+	// only reserved once the transaction is known to be sponsored, others go through the wrapped decorators
+	for _, b := range budgets {
+		var err error
+		if ctx, err = d.keeper.ReserveGaslessGas(ctx, b.contract, b.sender, b.config); err != nil {
+			return ctx, "", false, err
+		}
+	}
+	return ctx, sponsor, true, nil
+}
+
+// requiredFee returns the min fee of a transaction with the given gas limit, fee = ceil(minGasPrice * gas).
+func (d GaslessFeeDecorator) requiredFee(ctx sdk.Context, gas uint64) sdk.Coins {
+	minGasPrices := d.minGasPrices(ctx)
+	fees := make(sdk.Coins, 0, len(minGasPrices))
+	gasDec := math.LegacyNewDec(int64(gas))
+	for _, gp := range minGasPrices {
+		fees = append(fees, sdk.NewCoin(gp.Denom, gp.Amount.Mul(gasDec).Ceil().RoundInt()))
+	}
+	return sdk.NewCoins(fees...)
+}
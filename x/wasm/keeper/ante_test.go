@@ -9,7 +9,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
@@ -311,3 +315,225 @@ func TestTxContractsDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestGaslessFeeDecorator(t *testing.T) {
+	ctx, keepers := keeper.CreateDefaultTestInput(t)
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	waived := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	sponsored := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	other := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+
+	sponsor := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100))
+	poorSponsor := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("other", 1))
+	sender := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100))
+	config := types.DefaultGaslessConfig()
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, waived, config))
+	config.Sponsor = sponsor.String()
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, sponsored, config))
+	poorSponsored := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	config.Sponsor = poorSponsor.String()
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, poorSponsored, config))
+	scoped := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	config.Sponsor, config.AllowedMessages = "", []string{"claim"}
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, scoped, config))
+	expired := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	config.AllowedMessages, config.ExpiryHeight = nil, uint64(ctx.BlockHeight()-1)
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, expired, config))
+
+	// executions running out of gas use up what is left of their budgets
+	mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1_000_000 * types.DefaultGasMultiplier, nil
+	}
+	senderBudgetUsed := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, senderBudgetUsed, types.GaslessConfig{
+		MaxGasPerExecution: 50_000, MaxGasPerBlock: 1_000_000, MaxGasPerSenderPerBlock: 50_000, Sponsor: sponsor.String(),
+	}))
+	_, err := keepers.ContractKeeper.Execute(ctx, senderBudgetUsed, sender, []byte(`{}`), nil)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+	blockBudgetUsed := keeper.SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	require.NoError(t, keepers.ContractKeeper.SetGasless(ctx, blockBudgetUsed, types.GaslessConfig{
+		MaxGasPerExecution: 50_000, MaxGasPerBlock: 50_000, Sponsor: sponsor.String(),
+	}))
+	_, err = keepers.ContractKeeper.Execute(ctx, blockBudgetUsed, sponsor, []byte(`{}`), nil)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+
+	executeMsg := func(contract sdk.AccAddress, msg string) sdk.Msg {
+		return &types.MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Msg: []byte(msg)}
+	}
+	execute := func(contract sdk.AccAddress) sdk.Msg {
+		return executeMsg(contract, `{}`)
+	}
+	const maxGas = 100_000
+	// the min fee of a transaction with maxGas
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	minGasPrices := func(sdk.Context) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdkmath.LegacyNewDecWithPrec(1, 4)))
+	}
+	specs := map[string]struct {
+		tx        feeTx
+		expCalls  []string
+		expPayer  sdk.AccAddress
+		expCharge sdk.AccAddress
+		expErr    bool
+	}{
+		"min fee waived": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(waived), execute(waived)}, gas: maxGas, payer: sender},
+			expPayer: sender,
+		},
+		"min fee waived, fee paid": {
+			tx:        feeTx{msgs: []sdk.Msg{execute(waived)}, gas: maxGas, fee: fee, payer: sender},
+			expPayer:  sender,
+			expCharge: sender,
+		},
+		"fee charged to sponsor": {
+			tx:        feeTx{msgs: []sdk.Msg{execute(sponsored)}, gas: maxGas, fee: fee, payer: sender},
+			expCalls:  []string{"min fee"},
+			expPayer:  sponsor,
+			expCharge: sponsor,
+		},
+		"sponsored fee above the min fee of its gas limit": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(sponsored)}, gas: maxGas / 2, fee: fee, payer: sender},
+			expCalls: []string{"min fee"},
+			expErr:   true,
+		},
+		"sponsored fee above the min fee": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(sponsored)}, gas: maxGas, fee: fee.Add(sdk.NewInt64Coin("denom", 1)), payer: sender},
+			expCalls: []string{"min fee"},
+			expErr:   true,
+		},
+		"sponsored fee in another denom": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(sponsored)}, gas: maxGas, fee: sdk.NewCoins(sdk.NewInt64Coin("other", 1)), payer: sender},
+			expCalls: []string{"min fee"},
+			expErr:   true,
+		},
+		"sender budget used": {
+			tx:     feeTx{msgs: []sdk.Msg{execute(sponsored), execute(senderBudgetUsed)}, gas: maxGas, fee: fee, payer: sender},
+			expErr: true,
+		},
+		"budget left for other sender": {
+			tx: feeTx{msgs: []sdk.Msg{&types.MsgExecuteContract{Sender: sponsor.String(), Contract: senderBudgetUsed.String(), Msg: []byte(`{}`)}},
+				gas: maxGas, fee: fee, payer: sponsor},
+			expCalls:  []string{"min fee"},
+			expPayer:  sponsor,
+			expCharge: sponsor,
+		},
+		"block budget used": {
+			tx:     feeTx{msgs: []sdk.Msg{execute(blockBudgetUsed)}, gas: maxGas, fee: fee, payer: sender},
+			expErr: true,
+		},
+		"sponsor without funds": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(poorSponsored)}, gas: maxGas, fee: fee, payer: sender},
+			expCalls: []string{"min fee"},
+			expErr:   true,
+		},
+		"not gasless": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(other)}, gas: maxGas, payer: sender},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+		"allowed execute message": {
+			tx:       feeTx{msgs: []sdk.Msg{executeMsg(scoped, `{"claim":{}}`)}, gas: maxGas, payer: sender},
+			expPayer: sender,
+		},
+		"execute message not allowed": {
+			tx:       feeTx{msgs: []sdk.Msg{executeMsg(scoped, `{"claim":{}}`), executeMsg(scoped, `{"swap":{}}`)}, gas: maxGas, payer: sender},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+		"expired": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(expired)}, gas: maxGas, payer: sender},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+		"other message": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(waived), &types.MsgClearAdmin{Sender: sender.String(), Contract: waived.String()}}, gas: maxGas, payer: sender},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+		"different sponsors": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(waived), execute(sponsored)}, gas: maxGas, payer: sender},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+		"above gas limit": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(waived)}, gas: maxGas + 1, payer: sender},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+		"fee granter": {
+			tx:       feeTx{msgs: []sdk.Msg{execute(waived)}, gas: maxGas, payer: sender, granter: sponsor},
+			expCalls: []string{"min fee", "deduct fee"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			var calls []string
+			decorator := keeper.NewGaslessFeeDecorator(keepers.WasmKeeper, keepers.AccountKeeper, keepers.BankKeeper, maxGas, minGasPrices,
+				recordingDecorator{name: "min fee", calls: &calls},
+				recordingDecorator{name: "deduct fee", calls: &calls},
+			)
+			var nextCalled bool
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+			balances := map[string]sdk.Coins{}
+			for _, addr := range []sdk.AccAddress{sender, sponsor} {
+				balances[addr.String()] = keepers.BankKeeper.GetAllBalances(ctx, addr)
+			}
+
+			// when
+			_, gotErr := decorator.AnteHandle(ctx, spec.tx, false, next)
+
+			// then
+			assert.Equal(t, spec.expCalls, calls)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.False(t, nextCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			for _, addr := range []sdk.AccAddress{sender, sponsor} {
+				exp := balances[addr.String()]
+				if addr.Equals(spec.expCharge) {
+					exp = exp.Sub(fee...)
+				}
+				assert.Equal(t, exp, keepers.BankKeeper.GetAllBalances(ctx, addr), addr.String())
+			}
+			if spec.expPayer == nil {
+				assert.Empty(t, em.Events())
+				return
+			}
+			txEvent := em.Events()[len(em.Events())-1]
+			require.Equal(t, sdk.EventTypeTx, txEvent.Type)
+			payer, ok := txEvent.GetAttribute(sdk.AttributeKeyFeePayer)
+			require.True(t, ok)
+			assert.Equal(t, spec.expPayer.String(), payer.Value)
+		})
+	}
+}
+
+// feeTx is a minimal sdk.FeeTx
+type feeTx struct {
+	sdk.FeeTx
+	msgs           []sdk.Msg
+	gas            uint64
+	fee            sdk.Coins
+	payer, granter sdk.AccAddress
+}
+
+func (t feeTx) GetMsgs() []sdk.Msg { return t.msgs }
+func (t feeTx) GetGas() uint64     { return t.gas }
+func (t feeTx) GetFee() sdk.Coins  { return t.fee }
+func (t feeTx) FeePayer() []byte   { return t.payer }
+func (t feeTx) FeeGranter() []byte { return t.granter }
+
+// recordingDecorator records that it was called
+type recordingDecorator struct {
+	name  string
+	calls *[]string
+}
+
+func (d recordingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.calls = append(*d.calls, d.name)
+	return next(ctx, tx, simulate)
+}
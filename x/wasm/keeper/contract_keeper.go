@@ -29,7 +29,7 @@ type decoratedKeeper interface {
 	setContractAdmin(ctx context.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ types.AuthorizationPolicy) error
 	pinCode(ctx context.Context, codeID uint64) error
 	unpinCode(ctx context.Context, codeID uint64) error
-	setGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	setGasless(ctx sdk.Context, contractAddress sdk.AccAddress, config types.GaslessConfig) error
 	unsetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error
 	execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
@@ -123,8 +123,8 @@ func (p PermissionedKeeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.unpinCode(ctx, codeID)
 }
 
-func (p PermissionedKeeper) SetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error {
-	return p.nested.setGasless(ctx, contractAddress)
+func (p PermissionedKeeper) SetGasless(ctx sdk.Context, contractAddress sdk.AccAddress, config types.GaslessConfig) error {
+	return p.nested.setGasless(ctx, contractAddress, config)
 }
 
 func (p PermissionedKeeper) UnsetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error {
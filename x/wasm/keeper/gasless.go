@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// gaslessGasTracker records the gas used by gasless executions since the node started, by contract
+// address, for metrics. The gas used in the block being finalized is state kept in the transient store.
+type gaslessGasTracker struct {
+	mu     sync.Mutex
+	totals map[string]gaslessTotal
+}
+
+type gaslessTotal struct {
+	gasUsed    uint64
+	executions uint64
+}
+
+func newGaslessGasTracker() *gaslessGasTracker {
+	return &gaslessGasTracker{totals: map[string]gaslessTotal{}}
+}
+
+// tracksGas returns true when executions in ctx count against the block budgets. Transactions
+// checked or simulated against the mempool state are only bounded by the per execution limit.
+func tracksGas(ctx sdk.Context) bool {
+	return !ctx.IsCheckTx() && !ctx.IsReCheckTx() && ctx.ExecMode() != sdk.ExecModeSimulate
+}
+
+func (t *gaslessGasTracker) add(ctx sdk.Context, contract sdk.AccAddress, gas uint64) {
+	if !tracksGas(ctx) {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	total := t.totals[contract.String()]
+	total.gasUsed += gas
+	total.executions++
+	t.totals[contract.String()] = total
+}
+
+// gaslessTotals returns the gas used and the number of executions of each contract since the node started.
+func (t *gaslessGasTracker) gaslessTotals() map[string]gaslessTotal {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.totals)
+}
+
+// gaslessBlockStore returns the transient store holding the gas used by gasless executions in the
+// block. Its accesses are bookkeeping of the budgets and are not charged to the executions.
+func (k Keeper) gaslessBlockStore(ctx sdk.Context) storetypes.KVStore {
+	return runtime.KVStoreAdapter(k.transientStoreService.OpenTransientStore(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())))
+}
+
+// gaslessBlockGas returns the gas used in the block by the executions of contract, and by those of sender.
+func (k Keeper) gaslessBlockGas(ctx sdk.Context, contract, sender sdk.AccAddress) (contractGas, senderGas uint64) {
+	store := k.gaslessBlockStore(ctx)
+	if bz := store.Get(types.GetGaslessBlockGasKey(contract)); bz != nil {
+		contractGas = sdk.BigEndianToUint64(bz)
+	}
+	if bz := store.Get(types.GetGaslessSenderBlockGasKey(contract, sender)); bz != nil {
+		senderGas = sdk.BigEndianToUint64(bz)
+	}
+	return contractGas, senderGas
+}
+
+// addGaslessBlockGas adds gas to the gas used in the block by the executions of contract and by those of sender.
+func (k Keeper) addGaslessBlockGas(ctx sdk.Context, contract, sender sdk.AccAddress, gas uint64) {
+	contractGas, senderGas := k.gaslessBlockGas(ctx, contract, sender)
+	store := k.gaslessBlockStore(ctx)
+	store.Set(types.GetGaslessBlockGasKey(contract), sdk.Uint64ToBigEndian(contractGas+gas))
+	store.Set(types.GetGaslessSenderBlockGasKey(contract, sender), sdk.Uint64ToBigEndian(senderGas+gas))
+}
+
+// releaseGaslessBlockGas removes reserved gas that an execution did not use from the gas used in the block.
+func (k Keeper) releaseGaslessBlockGas(ctx sdk.Context, contract, sender sdk.AccAddress, gas uint64) {
+	contractGas, senderGas := k.gaslessBlockGas(ctx, contract, sender)
+	store := k.gaslessBlockStore(ctx)
+	store.Set(types.GetGaslessBlockGasKey(contract), sdk.Uint64ToBigEndian(contractGas-gas))
+	store.Set(types.GetGaslessSenderBlockGasKey(contract, sender), sdk.Uint64ToBigEndian(senderGas-gas))
+}
+
+type gaslessReservationsKey struct{}
+
+// gaslessReservation is the gas an execution of a sponsored transaction can use. It was added to the
+// gas used in the block by the ante handler.
+type gaslessReservation struct {
+	contract, sender sdk.AccAddress
+	limit            uint64
+	budget           string
+}
+
+type gaslessReservations struct {
+	pending []gaslessReservation
+}
+
+// ReserveGaslessGas adds the gas an execution of a gasless contract by sender can use to the gas used in the
+// block, and returns a context the execution takes its reservation from. It fails when a budget is used up.
+//
+// The gas the execution does not use is released once it succeeds. The state changes of a failed transaction
+// are reverted except for those of the ante handler, so failed executions count with all the gas they could use.
+func (k Keeper) ReserveGaslessGas(ctx sdk.Context, contract, sender sdk.AccAddress, config types.GaslessConfig) (sdk.Context, error) {
+	limit, budget, err := k.gaslessGasLimit(ctx, contract, sender, config)
+	if err != nil || !tracksGas(ctx) {
+		return ctx, err
+	}
+	k.addGaslessBlockGas(ctx, contract, sender, limit)
+	reservations, ok := ctx.Value(gaslessReservationsKey{}).(*gaslessReservations)
+	if !ok {
+		reservations = &gaslessReservations{}
+		ctx = ctx.WithValue(gaslessReservationsKey{}, reservations)
+	}
+	reservations.pending = append(reservations.pending, gaslessReservation{contract: contract, sender: sender, limit: limit, budget: budget})
+	return ctx, nil
+}
+
+// takeGaslessReservation removes the first reservation for an execution of contract by sender from ctx.
+func takeGaslessReservation(ctx sdk.Context, contract, sender sdk.AccAddress) (gaslessReservation, bool) {
+	reservations, ok := ctx.Value(gaslessReservationsKey{}).(*gaslessReservations)
+	if !ok {
+		return gaslessReservation{}, false
+	}
+	for i, r := range reservations.pending {
+		if r.contract.Equals(contract) && r.sender.Equals(sender) {
+			reservations.pending = append(reservations.pending[:i], reservations.pending[i+1:]...)
+			return r, true
+		}
+	}
+	return gaslessReservation{}, false
+}
+
+// gaslessConfigFor returns the configuration of a gasless contract when it covers the execution of msg.
+// Contracts that are not gasless are only charged for checking the index.
+func (k Keeper) gaslessConfigFor(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte) (types.GaslessConfig, bool) {
+	if !k.IsGasless(ctx, contractAddr) {
+		return types.GaslessConfig{}, false
+	}
+	config, ok := k.GetGaslessConfig(ctx, contractAddr)
+	if !ok || !config.Covers(ctx.BlockHeight(), msg) {
+		return types.GaslessConfig{}, false
+	}
+	return config, true
+}
+
+// gaslessGasLimit returns the gas an execution of a gasless contract can use, which is the
+// smallest of the budgets left, and names that budget.
+func (k Keeper) gaslessGasLimit(ctx sdk.Context, contract, sender sdk.AccAddress, config types.GaslessConfig) (uint64, string, error) {
+	limit, budget := config.MaxGasPerExecution, "execution"
+	if !tracksGas(ctx) {
+		return limit, budget, nil
+	}
+	contractGas, senderGas := k.gaslessBlockGas(ctx, contract, sender)
+	if contractGas >= config.MaxGasPerBlock {
+		return 0, "", errorsmod.Wrapf(types.ErrGaslessBudgetExhausted, "block budget of %d used", config.MaxGasPerBlock)
+	}
+	if left := config.MaxGasPerBlock - contractGas; left < limit {
+		limit, budget = left, "block"
+	}
+	if config.MaxGasPerSenderPerBlock == 0 {
+		return limit, budget, nil
+	}
+	if senderGas >= config.MaxGasPerSenderPerBlock {
+		return 0, "", errorsmod.Wrapf(types.ErrGaslessBudgetExhausted, "sender budget of %d used", config.MaxGasPerSenderPerBlock)
+	}
+	if left := config.MaxGasPerSenderPerBlock - senderGas; left < limit {
+		limit, budget = left, "sender"
+	}
+	return limit, budget, nil
+}
+
+// PersistGaslessGasUsage adds the gas used by the gasless executions of the block to the usage of their contracts
+// in the current epoch.
+func (k Keeper) PersistGaslessGasUsage(ctx sdk.Context) error {
+	epoch := types.GaslessUsageEpoch(ctx.BlockHeight())
+	store := k.storeService.OpenKVStore(ctx)
+	iter := prefix.NewStore(k.gaslessBlockStore(ctx), types.GaslessBlockGasPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := types.GetGaslessGasUsageKey(iter.Key(), epoch)
+		bz, err := store.Get(key)
+		if err != nil {
+			return err
+		}
+		var gasUsed uint64
+		if bz != nil {
+			gasUsed = sdk.BigEndianToUint64(bz)
+		}
+		if err := store.Set(key, sdk.Uint64ToBigEndian(gasUsed+sdk.BigEndianToUint64(iter.Value()))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGaslessGasUsage returns the gas used by the gasless executions of a contract in an epoch
+func (k Keeper) GetGaslessGasUsage(ctx context.Context, contractAddr sdk.AccAddress, epoch uint64) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetGaslessGasUsageKey(contractAddr, epoch))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateGaslessGasUsage iterates over the gas used by the gasless executions of a contract in each epoch
+func (k Keeper) IterateGaslessGasUsage(ctx context.Context, contractAddr sdk.AccAddress, cb func(types.GaslessGasUsage) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetGaslessGasUsagePrefix(contractAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		usage := types.GaslessGasUsage{Epoch: sdk.BigEndianToUint64(iter.Key()), GasUsed: sdk.BigEndianToUint64(iter.Value())}
+		if cb(usage) {
+			return
+		}
+	}
+}
+
+// importGaslessGasUsage stores the gas used by the gasless executions of a contract in each epoch, as exported in genesis
+func (k Keeper) importGaslessGasUsage(ctx context.Context, contractAddr sdk.AccAddress, usages []types.GaslessGasUsage) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, usage := range usages {
+		if err := store.Set(types.GetGaslessGasUsageKey(contractAddr, usage.Epoch), sdk.Uint64ToBigEndian(usage.GasUsed)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
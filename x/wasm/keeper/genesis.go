@@ -52,6 +52,14 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "contract number %d", i)
 		}
+		if contract.Gasless != nil {
+			if err := keeper.setGasless(ctx, contractAddr, *contract.Gasless); err != nil {
+				return nil, errorsmod.Wrapf(err, "gasless contract number %d", i)
+			}
+		}
+		if err := keeper.importGaslessGasUsage(ctx, contractAddr, contract.GaslessGasUsage); err != nil {
+			return nil, errorsmod.Wrapf(err, "gasless gas usage of contract number %d", i)
+		}
 	}
 
 	for i, seq := range data.Sequences {
@@ -111,11 +119,23 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 
 		contractCodeHistory := keeper.GetContractHistory(ctx, addr)
 
+		var gasless *types.GaslessConfig
+		if config, ok := keeper.GetGaslessConfig(ctx, addr); ok {
+			gasless = &config
+		}
+		var gaslessGasUsage []types.GaslessGasUsage
+		keeper.IterateGaslessGasUsage(ctx, addr, func(usage types.GaslessGasUsage) bool {
+			gaslessGasUsage = append(gaslessGasUsage, usage)
+			return false
+		})
+
 		genState.Contracts = append(genState.Contracts, types.Contract{
 			ContractAddress:     addr.String(),
 			ContractInfo:        contract,
 			ContractState:       state,
 			ContractCodeHistory: contractCodeHistory,
+			Gasless:             gasless,
+			GaslessGasUsage:     gaslessGasUsage,
 		})
 		return false
 	})
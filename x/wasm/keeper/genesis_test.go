@@ -56,6 +56,7 @@ func TestGenesisExportImport(t *testing.T) {
 			history           []types.ContractCodeHistoryEntry
 			pinned            bool
 			contractExtension bool
+			gasless           bool
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
@@ -63,6 +64,7 @@ func TestGenesisExportImport(t *testing.T) {
 		f.NilChance(0).Fuzz(&history)
 		f.Fuzz(&pinned)
 		f.Fuzz(&contractExtension)
+		f.Fuzz(&gasless)
 
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
@@ -88,6 +90,11 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...))
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		if gasless {
+			config := types.GaslessConfig{MaxGasPerExecution: uint64(i + 1), MaxGasPerBlock: uint64(2*i + 2), Sponsor: creatorAddr.String()}
+			require.NoError(t, wasmKeeper.setGasless(srcCtx, contractAddr, config))
+			require.NoError(t, wasmKeeper.importGaslessGasUsage(srcCtx, contractAddr, []types.GaslessGasUsage{{Epoch: uint64(i), GasUsed: uint64(i + 1)}}))
+		}
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -660,10 +667,12 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context) {
 	t.Cleanup(func() { os.RemoveAll(tempDir) })
 
 	keyWasm := storetypes.NewKVStoreKey(types.StoreKey)
+	tkeyWasm := storetypes.NewTransientStoreKey(types.TStoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
 	ms.MountStoreWithDB(keyWasm, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyWasm, storetypes.StoreTypeTransient, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
 	ctx := sdk.NewContext(ms, cmtproto.Header{
@@ -685,6 +694,7 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context) {
 	srcKeeper := NewKeeper(
 		encodingConfig.Codec,
 		runtime.NewKVStoreService(keyWasm),
+		runtime.NewTransientStoreService(tkeyWasm),
 		authkeeper.AccountKeeper{},
 		&bankkeeper.BaseKeeper{},
 		stakingkeeper.Keeper{},
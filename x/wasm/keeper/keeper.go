@@ -86,6 +86,7 @@ var defaultAcceptedAccountTypes = map[reflect.Type]struct{}{
 type Keeper struct {
 	// The (unexposed) keys used to access the stores from the Context.
 	storeService          corestoretypes.KVStoreService
+	transientStoreService corestoretypes.TransientStoreService
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
@@ -105,6 +106,8 @@ type Keeper struct {
 	params               collections.Item[types.Params]
 	// propagate gov authZ to sub-messages
 	propagateGovAuthorization map[types.AuthorizationPolicyAction]struct{}
+	// gas used by gasless executions since the node started
+	gaslessGas *gaslessGasTracker
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -391,13 +394,44 @@ func (k Keeper) instantiate(
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (data []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	isGasLess := k.IsGasless(sdkCtx, contractAddress)
-	// infinite gas meter
-	if isGasLess {
-		sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	// gasless contracts are not charged to the transaction but run in their own bounded gas meter
+	if config, ok := k.gaslessConfigFor(sdkCtx, contractAddress, msg); ok {
+		// sponsored transactions reserved the gas of their executions in the ante handler
+		reservation, reserved := takeGaslessReservation(sdkCtx, contractAddress, caller)
+		limit, budget := reservation.limit, reservation.budget
+		if !reserved {
+			var limitErr error
+			limit, budget, limitErr = k.gaslessGasLimit(sdkCtx, contractAddress, caller, config)
+			if limitErr != nil {
+				return nil, limitErr
+			}
+		}
+		gasMeter := storetypes.NewGasMeter(limit)
+		sdkCtx = sdkCtx.WithGasMeter(gasMeter)
+		defer func() {
+			gasUsed := gasMeter.GasConsumedToLimit()
+			if reserved {
+				k.releaseGaslessBlockGas(sdkCtx, contractAddress, caller, limit-gasUsed)
+			} else if tracksGas(sdkCtx) {
+				k.addGaslessBlockGas(sdkCtx, contractAddress, caller, gasUsed)
+			}
+			k.gaslessGas.add(sdkCtx, contractAddress, gasUsed)
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeGaslessExecute,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+				sdk.NewAttribute(sdk.AttributeKeySender, caller.String()),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			))
+			if r := recover(); r != nil {
+				if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+					panic(r)
+				}
+				data, err = nil, errorsmod.Wrapf(types.ErrGaslessBudgetExhausted, "out of gas with %s budget of %d", budget, limit)
+			}
+		}()
 	}
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
@@ -440,7 +474,7 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 	))
 
-	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
+	data, err = k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, err
 	}
@@ -1518,17 +1552,17 @@ func (h DefaultWasmVMContractResponseHandler) Handle(ctx sdk.Context, contractAd
 	return result, nil
 }
 
-// setGaslessContract set the gasless contract
-func (k Keeper) setGasless(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+// setGaslessContract set the gasless contract with the gas budget of its executions
+func (k Keeper) setGasless(ctx sdk.Context, contractAddr sdk.AccAddress, config types.GaslessConfig) error {
 	info := k.GetContractInfo(ctx, contractAddr)
 	if info == nil {
 		return errorsmod.Wrap(types.ErrNotFound, "contract info")
 	}
+	if err := config.ValidateBasic(); err != nil {
+		return err
+	}
 	store := k.storeService.OpenKVStore(ctx)
-	// store 1 byte to not run into `nil` debugging issues
-	store.Set(types.GetGaslessContractIndexPrefix(contractAddr), []byte{1})
-
-	return nil
+	return store.Set(types.GetGaslessContractIndexPrefix(contractAddr), k.cdc.MustMarshal(&config))
 }
 
 // unsetGaslessContract removes the gasless contract
@@ -1551,4 +1585,19 @@ func (k Keeper) IsGasless(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
 		return false
 	}
 	return ok
+}
+
+// GetGaslessConfig returns the configuration of a gasless contract
+func (k Keeper) GetGaslessConfig(ctx context.Context, contractAddr sdk.AccAddress) (types.GaslessConfig, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetGaslessContractIndexPrefix(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.GaslessConfig{}, false
+	}
+	var config types.GaslessConfig
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
 }
\ No newline at end of file
@@ -20,6 +20,7 @@ import (
 func NewKeeper(
 	cdc codec.Codec,
 	storeService corestoretypes.KVStoreService,
+	transientStoreService corestoretypes.TransientStoreService,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
@@ -39,24 +40,26 @@ func NewKeeper(
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	keeper := &Keeper{
-		storeService:         storeService,
-		cdc:                  cdc,
-		wasmVM:               nil,
-		accountKeeper:        accountKeeper,
-		bank:                 NewBankCoinTransferrer(bankKeeper),
-		accountPruner:        NewVestingCoinBurner(bankKeeper),
-		portKeeper:           portKeeper,
-		capabilityKeeper:     capabilityKeeper,
-		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
-		gasRegister:          types.NewDefaultWasmGasRegister(),
-		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
-		maxCallDepth:         types.DefaultMaxCallDepth,
-		acceptedAccountTypes: defaultAcceptedAccountTypes,
-		params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		storeService:          storeService,
+		transientStoreService: transientStoreService,
+		cdc:                   cdc,
+		wasmVM:                nil,
+		accountKeeper:         accountKeeper,
+		bank:                  NewBankCoinTransferrer(bankKeeper),
+		accountPruner:         NewVestingCoinBurner(bankKeeper),
+		portKeeper:            portKeeper,
+		capabilityKeeper:      capabilityKeeper,
+		queryGasLimit:         wasmConfig.SmartQueryGasLimit,
+		gasRegister:           types.NewDefaultWasmGasRegister(),
+		maxQueryStackSize:     types.DefaultMaxQueryStackSize,
+		maxCallDepth:          types.DefaultMaxCallDepth,
+		acceptedAccountTypes:  defaultAcceptedAccountTypes,
+		params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		propagateGovAuthorization: map[types.AuthorizationPolicyAction]struct{}{
 			types.AuthZActionInstantiate: {},
 		},
-		authority: authority,
+		gaslessGas: newGaslessGasTracker(),
+		authority:  authority,
 	}
 	keeper.messenger = NewDefaultMessageHandler(keeper, router, ics4Wrapper, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource)
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distrKeeper, channelKeeper, keeper)
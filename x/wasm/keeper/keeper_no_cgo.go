@@ -15,6 +15,7 @@ import (
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	stdrand "math/rand"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	fuzz "github.com/google/gofuzz"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -2704,7 +2706,7 @@ func TestSetGaslessContract(t *testing.T) {
 	require.NoError(t, err)
 
 	// when
-	gotErr := k.setGasless(ctx, example.Contract)
+	gotErr := k.setGasless(ctx, example.Contract, types.DefaultGaslessConfig())
 	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
 
 	// then
@@ -2713,7 +2715,7 @@ func TestSetGaslessContract(t *testing.T) {
 
 	_, err = k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil)
 	require.NoError(t, err)
-	assert.True(t, ctx.GasMeter().GasConsumed() == 5687)
+	assert.True(t, ctx.GasMeter().GasConsumed() == 6816)
 }
 
 func TestUnsetGaslessContract(t *testing.T) {
@@ -2724,7 +2726,7 @@ func TestUnsetGaslessContract(t *testing.T) {
 	example := SeedNewContractInstance(t, ctx, keepers, &mock)
 
 	// when
-	gotErr := k.setGasless(ctx, example.Contract)
+	gotErr := k.setGasless(ctx, example.Contract, types.DefaultGaslessConfig())
 
 	// then
 	require.NoError(t, gotErr)
@@ -2736,6 +2738,206 @@ func TestUnsetGaslessContract(t *testing.T) {
 	assert.False(t, k.IsGasless(ctx, example.Contract))
 }
 
+func TestGaslessBudgets(t *testing.T) {
+	var gasUsed uint64
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasUsed * types.DefaultGasMultiplier, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	config := types.GaslessConfig{MaxGasPerExecution: 200_000, MaxGasPerBlock: 700_000, MaxGasPerSenderPerBlock: 400_000}
+	require.NoError(t, k.setGasless(ctx, example.Contract, config))
+	gotConfig, ok := k.GetGaslessConfig(ctx, example.Contract)
+	require.True(t, ok)
+	assert.Equal(t, config, gotConfig)
+
+	alice, bob := RandomAccountAddress(t), RandomAccountAddress(t)
+	execute := func(ctx sdk.Context, sender sdk.AccAddress, gas uint64) error {
+		gasUsed = gas
+		_, err := k.execute(ctx.WithGasMeter(storetypes.NewGasMeter(20_000)), example.Contract, sender, []byte(`{}`), nil)
+		return err
+	}
+
+	// gasless executions are not charged to the transaction
+	require.NoError(t, execute(ctx, alice, 60_000))
+
+	// an execution is bounded by the per execution limit, and the gas it used still counts
+	err := execute(ctx, alice, 200_000)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+	assert.Contains(t, err.Error(), "execution budget")
+
+	// alice is bounded by what is left of her budget in this block, until she used it
+	err = execute(ctx, alice, 60_000)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+	assert.Contains(t, err.Error(), "sender budget")
+	err = execute(ctx, alice, 1)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+	assert.Contains(t, err.Error(), "sender budget of 400000 used")
+
+	// bob is bounded by what is left of the budget of the contract in this block
+	require.NoError(t, execute(ctx, bob, 60_000))
+	require.NoError(t, execute(ctx, bob, 60_000))
+	err = execute(ctx, bob, 60_000)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+	assert.Contains(t, err.Error(), "block budget")
+	err = execute(ctx, bob, 1)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+	assert.Contains(t, err.Error(), "block budget of 700000 used")
+
+	// checked transactions are only bounded by the per execution limit
+	require.NoError(t, execute(ctx.WithIsCheckTx(true), alice, 60_000))
+
+	// budgets are renewed with the next block
+	keepers.MultiStore.GetCommitKVStore(keepers.WasmTStoreKey).Commit()
+	require.NoError(t, execute(ctx.WithBlockHeight(ctx.BlockHeight()+1), alice, 60_000))
+}
+
+func TestGaslessReservations(t *testing.T) {
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 60_000 * types.DefaultGasMultiplier, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	config := types.GaslessConfig{MaxGasPerExecution: 200_000, MaxGasPerBlock: 700_000, MaxGasPerSenderPerBlock: 400_000}
+	require.NoError(t, k.setGasless(ctx, example.Contract, config))
+	sender := RandomAccountAddress(t)
+
+	// the limit of an execution is reserved before it runs
+	execCtx, err := k.ReserveGaslessGas(ctx, example.Contract, sender, config)
+	require.NoError(t, err)
+	contractGas, senderGas := k.gaslessBlockGas(ctx, example.Contract, sender)
+	assert.Equal(t, uint64(200_000), contractGas)
+	assert.Equal(t, uint64(200_000), senderGas)
+
+	// and stays used when the transaction fails
+	cacheCtx, _ := execCtx.CacheContext()
+	_, err = k.execute(cacheCtx, example.Contract, sender, []byte(`{}`), nil)
+	require.NoError(t, err)
+	contractGas, senderGas = k.gaslessBlockGas(ctx, example.Contract, sender)
+	assert.Equal(t, uint64(200_000), contractGas)
+	assert.Equal(t, uint64(200_000), senderGas)
+
+	// the gas left is released once it succeeds
+	execCtx, err = k.ReserveGaslessGas(ctx, example.Contract, sender, config)
+	require.NoError(t, err)
+	em := sdk.NewEventManager()
+	cacheCtx, commit := execCtx.WithEventManager(em).CacheContext()
+	_, err = k.execute(cacheCtx, example.Contract, sender, []byte(`{}`), nil)
+	require.NoError(t, err)
+	commit()
+	var gasUsed uint64
+	for _, e := range em.ABCIEvents() {
+		if e.Type == types.EventTypeGaslessExecute {
+			gasUsed = must(strconv.ParseUint(attrsToStringMap(e.Attributes)[types.AttributeKeyGasUsed], 10, 64))
+		}
+	}
+	require.NotZero(t, gasUsed)
+	contractGas, senderGas = k.gaslessBlockGas(ctx, example.Contract, sender)
+	assert.Equal(t, 200_000+gasUsed, contractGas)
+	assert.Equal(t, 200_000+gasUsed, senderGas)
+
+	// reservations are bounded by the budgets left
+	execCtx, err = k.ReserveGaslessGas(ctx, example.Contract, sender, config)
+	require.NoError(t, err)
+	_, err = k.ReserveGaslessGas(execCtx, example.Contract, sender, config)
+	require.ErrorIs(t, err, types.ErrGaslessBudgetExhausted)
+}
+
+func TestGaslessScope(t *testing.T) {
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	config := types.DefaultGaslessConfig()
+	config.AllowedMessages, config.ExpiryHeight = []string{"claim"}, uint64(ctx.BlockHeight()+1)
+	require.NoError(t, k.setGasless(ctx, example.Contract, config))
+
+	// returns the gas charged to the transaction
+	execute := func(ctx sdk.Context, msg string) storetypes.Gas {
+		gasMeter := storetypes.NewInfiniteGasMeter()
+		_, err := k.execute(ctx.WithGasMeter(gasMeter), example.Contract, RandomAccountAddress(t), []byte(msg), nil)
+		require.NoError(t, err)
+		return gasMeter.GasConsumed()
+	}
+	setupCost := k.gasRegister.SetupContractCost(false, len(`{"swap":{}}`))
+
+	// allowed messages are gasless until the expiry height
+	assert.Less(t, execute(ctx, `{"claim":{}}`), setupCost)
+	assert.Less(t, execute(ctx.WithBlockHeight(ctx.BlockHeight()+1), `{"claim":{}}`), setupCost)
+	// other messages, and all messages after the expiry height, are metered as usual
+	assert.Greater(t, execute(ctx, `{"swap":{}}`), setupCost)
+	assert.Greater(t, execute(ctx.WithBlockHeight(ctx.BlockHeight()+2), `{"claim":{}}`), setupCost)
+}
+
+func TestGaslessGasUsage(t *testing.T) {
+	mock := wasmtesting.MockWasmEngine{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	require.NoError(t, k.setGasless(ctx, example.Contract, types.DefaultGaslessConfig()))
+	sender := RandomAccountAddress(t)
+
+	var gasUsed uint64
+	ctx = ctx.WithBlockHeight(2*types.GaslessUsageEpochLength - 1)
+	for i := 0; i < 2; i++ {
+		em := sdk.NewEventManager()
+		_, err := k.execute(ctx.WithEventManager(em), example.Contract, sender, []byte(`{}`), nil)
+		require.NoError(t, err)
+
+		// the gas used is emitted
+		var event *abci.Event
+		for _, e := range em.ABCIEvents() {
+			if e.Type == types.EventTypeGaslessExecute {
+				event = &e
+			}
+		}
+		require.NotNil(t, event)
+		attrs := attrsToStringMap(event.Attributes)
+		assert.Equal(t, example.Contract.String(), attrs[types.AttributeKeyContractAddr])
+		assert.Equal(t, sender.String(), attrs[sdk.AttributeKeySender])
+		gas, err := strconv.ParseUint(attrs[types.AttributeKeyGasUsed], 10, 64)
+		require.NoError(t, err)
+		assert.NotZero(t, gas)
+		gasUsed += gas
+	}
+	// executions in a checked transaction are not persisted
+	_, err := k.execute(ctx.WithIsCheckTx(true), example.Contract, sender, []byte(`{}`), nil)
+	require.NoError(t, err)
+
+	// the gas used in the block is persisted in its epoch
+	require.NoError(t, k.PersistGaslessGasUsage(ctx))
+	assert.Equal(t, gasUsed, k.GetGaslessGasUsage(ctx, example.Contract, 1))
+
+	// and added to with the next block, in the next epoch
+	keepers.MultiStore.GetCommitKVStore(keepers.WasmTStoreKey).Commit()
+	ctx = ctx.WithBlockHeight(2 * types.GaslessUsageEpochLength)
+	_, err = k.execute(ctx, example.Contract, sender, []byte(`{}`), nil)
+	require.NoError(t, err)
+	require.NoError(t, k.PersistGaslessGasUsage(ctx))
+	assert.Equal(t, gasUsed, k.GetGaslessGasUsage(ctx, example.Contract, 1))
+	nextEpochGas := k.GetGaslessGasUsage(ctx, example.Contract, 2)
+	assert.NotZero(t, nextEpochGas)
+
+	q := Querier(k)
+	res, err := q.GaslessGasUsage(ctx, &types.QueryGaslessGasUsageRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []types.GaslessGasUsage{{Epoch: 1, GasUsed: gasUsed}, {Epoch: 2, GasUsed: nextEpochGas}}, res.Usages)
+
+	// and the totals since the node started are collected as metrics
+	assert.Equal(t, map[string]gaslessTotal{example.Contract.String(): {gasUsed: gasUsed + nextEpochGas, executions: 3}}, k.gaslessGas.gaslessTotals())
+	assert.Equal(t, 2, promtestutil.CollectAndCount(NewGaslessMetricsCollector(k.gaslessGas)))
+}
+
 func attrsToStringMap(attrs []abci.EventAttribute) map[string]string {
 	r := make(map[string]string, len(attrs))
 	for _, v := range attrs {
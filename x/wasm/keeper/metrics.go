@@ -86,3 +86,48 @@ func (p *WasmVMMetricsCollector) Collect(c chan<- prometheus.Metric) {
 	// We had to either scan the whole directory of potentially thousands of files or track the values when files are added or removed.
 	// Such a tracking would need to be on disk such that the values are not cleared when the node is restarted.
 }
+
+// gaslessMetricSource source of gasless execution metrics
+type gaslessMetricSource interface {
+	gaslessTotals() map[string]gaslessTotal
+}
+
+var _ prometheus.Collector = (*GaslessMetricsCollector)(nil)
+
+// GaslessMetricsCollector custom metrics collector of the gas used by gasless executions, to be used with Prometheus
+type GaslessMetricsCollector struct {
+	source          gaslessMetricSource
+	GasUsedDescr    *prometheus.Desc
+	ExecutionsDescr *prometheus.Desc
+}
+
+// NewGaslessMetricsCollector constructor
+func NewGaslessMetricsCollector(s gaslessMetricSource) *GaslessMetricsCollector {
+	if s == nil {
+		panic("gasless source must not be nil")
+	}
+	return &GaslessMetricsCollector{
+		source:          s,
+		GasUsedDescr:    prometheus.NewDesc("wasm_gasless_gas_used_total", "Total gas used by gasless executions of a contract", []string{"contract"}, nil),
+		ExecutionsDescr: prometheus.NewDesc("wasm_gasless_executions_total", "Total number of gasless executions of a contract", []string{"contract"}, nil),
+	}
+}
+
+// Register registers all metrics
+func (p *GaslessMetricsCollector) Register(r prometheus.Registerer) {
+	r.MustRegister(p)
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+func (p *GaslessMetricsCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- p.GasUsedDescr
+	descs <- p.ExecutionsDescr
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+func (p *GaslessMetricsCollector) Collect(c chan<- prometheus.Metric) {
+	for contract, total := range p.source.gaslessTotals() {
+		c <- prometheus.MustNewConstMetric(p.GasUsedDescr, prometheus.CounterValue, float64(total.gasUsed), contract)
+		c <- prometheus.MustNewConstMetric(p.ExecutionsDescr, prometheus.CounterValue, float64(total.executions), contract)
+	}
+}
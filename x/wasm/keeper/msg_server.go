@@ -500,7 +500,7 @@ func (m msgServer) SetGaslessContracts(ctx context.Context, msg *types.MsgSetGas
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for _, contract := range msg.Contracts {
 		contractAddress, _ := sdk.AccAddressFromBech32(contract)
-		if err := m.keeper.setGasless(sdkCtx, contractAddress); err != nil {
+		if err := m.keeper.setGasless(sdkCtx, contractAddress, msg.GaslessConfig()); err != nil {
 			// return nil, errorsmod.Wrapf(err, "set gas less failed for contract \"%s\"", contract)
 			return nil, err
 		}
@@ -131,6 +131,13 @@ func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	})
 }
 
+// WithGaslessMetrics registers the metrics of the gas used by gasless executions
+func WithGaslessMetrics(r prometheus.Registerer) Option {
+	return postOptsFn(func(k *Keeper) {
+		NewGaslessMetricsCollector(k.gaslessGas).Register(r)
+	})
+}
+
 // WithGasRegister set a new gas register to implement custom gas costs.
 // When the "gas multiplier" for wasmvm gas conversion is modified inside the new register,
 // make sure to also use `WithApiCosts` option for non default values
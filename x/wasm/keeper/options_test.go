@@ -24,6 +24,7 @@ import (
 
 func TestConstructorOptions(t *testing.T) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
 	codec := MakeEncodingConfig(t).Codec
 
 	specs := map[string]struct {
@@ -46,6 +47,15 @@ func TestConstructorOptions(t *testing.T) {
 			},
 			isPostOpt: true,
 		},
+		"gasless metrics": {
+			srcOpt: WithGaslessMetrics(prometheus.DefaultRegisterer),
+			verify: func(t *testing.T, k Keeper) {
+				t.Helper()
+				registered := prometheus.DefaultRegisterer.Unregister(NewGaslessMetricsCollector(k.gaslessGas))
+				assert.True(t, registered)
+			},
+			isPostOpt: true,
+		},
 		"decorate wasmvm": {
 			srcOpt: WithWasmEngineDecorator(func(old types.WasmEngine) types.WasmEngine {
 				require.IsType(t, &wasmvm.VM{}, old)
@@ -155,7 +165,7 @@ func TestConstructorOptions(t *testing.T) {
 			opt := spec.srcOpt
 			_, gotPostOptMarker := opt.(postOptsFn)
 			require.Equal(t, spec.isPostOpt, gotPostOptMarker)
-			k := NewKeeper(codec, runtime.NewKVStoreService(storeKey), authkeeper.AccountKeeper{}, &bankkeeper.BaseKeeper{}, stakingkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, nil, nil, tempDir, types.DefaultWasmConfig(), AvailableCapabilities, "", spec.srcOpt)
+			k := NewKeeper(codec, runtime.NewKVStoreService(storeKey), runtime.NewTransientStoreService(tStoreKey), authkeeper.AccountKeeper{}, &bankkeeper.BaseKeeper{}, stakingkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, nil, nil, tempDir, types.DefaultWasmConfig(), AvailableCapabilities, "", spec.srcOpt)
 			spec.verify(t, k)
 		})
 	}
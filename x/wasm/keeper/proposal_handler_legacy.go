@@ -334,12 +334,13 @@ func handleSetGaslessContractsProposal(ctx sdk.Context, k types.ContractOpsKeepe
 	if err := p.ValidateBasic(); err != nil {
 		return err
 	}
+	config := p.GaslessConfig()
 	for _, v := range p.ContractAddresses {
 		contractAddr, err := sdk.AccAddressFromBech32(v)
 		if err != nil {
 			return errorsmod.Wrap(err, "contract")
 		}
-		if err := k.SetGasless(ctx, contractAddr); err != nil {
+		if err := k.SetGasless(ctx, contractAddr, config); err != nil {
 			return errorsmod.Wrapf(err, "contract address: %s", v)
 		}
 	}
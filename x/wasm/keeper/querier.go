@@ -406,6 +406,40 @@ func (q GrpcQuerier) Params(c context.Context, _ *types.QueryParamsRequest) (*ty
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+func (q GrpcQuerier) GaslessGasUsage(c context.Context, req *types.QueryGaslessGasUsageRequest) (*types.QueryGaslessGasUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.GaslessGasUsage, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetGaslessGasUsagePrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, types.GaslessGasUsage{
+				Epoch:   sdk.BigEndianToUint64(key),
+				GasUsed: sdk.BigEndianToUint64(value),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryGaslessGasUsageResponse{
+		Usages:     r,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
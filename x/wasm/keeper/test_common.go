@@ -202,6 +202,7 @@ type TestKeepers struct {
 	MultiStore       storetypes.CommitMultiStore
 	ScopedWasmKeeper capabilitykeeper.ScopedKeeper
 	WasmStoreKey     *storetypes.KVStoreKey
+	WasmTStoreKey    *storetypes.TransientStoreKey
 }
 
 // CreateDefaultTestInput common settings for CreateTestInput
@@ -239,7 +240,7 @@ func createTestInput(
 	for _, v := range keys {
 		ms.MountStoreWithDB(v, storetypes.StoreTypeIAVL, db)
 	}
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, types.TStoreKey)
 	for _, v := range tkeys {
 		ms.MountStoreWithDB(v, storetypes.StoreTypeTransient, db)
 	}
@@ -393,6 +394,7 @@ func createTestInput(
 	keeper := NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[types.StoreKey]),
+		runtime.NewTransientStoreService(tkeys[types.TStoreKey]),
 		accountKeeper,
 		bankKeeper,
 		stakingKeeper,
@@ -453,6 +455,7 @@ func createTestInput(
 		MultiStore:       ms,
 		ScopedWasmKeeper: scopedWasmKeeper,
 		WasmStoreKey:     keys[types.StoreKey],
+		WasmTStoreKey:    tkeys[types.TStoreKey],
 	}
 	return ctx, keepers
 }
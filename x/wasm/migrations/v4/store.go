@@ -0,0 +1,37 @@
+package v4
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// MigrateStore migrates the x/wasm module state from the consensus version 4 to
+// version 5. Gasless contracts were stored with a single byte as value; they are
+// given the default gasless configuration so that their executions are bounded.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), types.GaslessContractIndexPrefix)
+	iter := store.Iterator(nil, nil)
+	var contracts [][]byte
+	for ; iter.Valid(); iter.Next() {
+		contracts = append(contracts, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	config := types.DefaultGaslessConfig()
+	bz, err := cdc.Marshal(&config)
+	if err != nil {
+		return err
+	}
+	for _, contract := range contracts {
+		store.Set(contract, bz)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	AvailableCapabilities := []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	store := ctx.KVStore(keepers.WasmStoreKey)
+	wasmKeeper := keepers.WasmKeeper
+
+	// gasless contracts were stored with a single byte
+	gasless1, gasless2 := keeper.RandomAccountAddress(t), keeper.RandomAccountAddress(t)
+	store.Set(types.GetGaslessContractIndexPrefix(gasless1), []byte{1})
+	store.Set(types.GetGaslessContractIndexPrefix(gasless2), []byte{1})
+	other := keeper.RandomAccountAddress(t)
+
+	// when
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate4to5(ctx)
+
+	// then
+	require.NoError(t, err)
+
+	for _, contract := range []sdk.AccAddress{gasless1, gasless2} {
+		config, ok := wasmKeeper.GetGaslessConfig(ctx, contract)
+		require.True(t, ok)
+		assert.Equal(t, types.DefaultGaslessConfig(), config)
+	}
+	_, ok := wasmKeeper.GetGaslessConfig(ctx, other)
+	assert.False(t, ok)
+}
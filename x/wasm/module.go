@@ -143,11 +143,18 @@ func (am AppModule) IsOnePerModuleType() { // marker
 func (am AppModule) IsAppModule() { // marker
 }
 
+var _ appmodule.HasEndBlocker = AppModule{}
+
+// EndBlock persists the gas used by the gasless executions of the block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.PersistGaslessGasUsage(sdk.UnwrapSDKContext(ctx))
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -166,6 +173,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the wasm module invariants.
@@ -365,6 +365,7 @@ func SimulateSetGaslessContractProposal(wasmKeeper WasmKeeper, contractSelector
 			simtypes.RandStringOfLength(r, 10),
 			simtypes.RandStringOfLength(r, 10),
 			[]string{contractAddr.String()},
+			types.DefaultGaslessConfig(),
 		)
 	}
 }
@@ -95,8 +95,12 @@ var (
 
 	// ErrUnsetGaslessFailed error for unsetting gasless contract failures
 	ErrUnsetGaslessFailed = errorsmod.Register(DefaultCodespace, 41, "unsetting gasless contract failed")
+
 	// ErrExceedMaxCallDepth error if max message stack size is exceeded
 	ErrExceedMaxCallDepth = errorsmod.Register(DefaultCodespace, 30, "max call depth exceeded")
+
+	// ErrGaslessBudgetExhausted error if a gasless execution runs out of its gas budget
+	ErrGaslessBudgetExhausted = errorsmod.Register(DefaultCodespace, 42, "gasless budget exhausted")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
@@ -22,6 +22,7 @@ const (
 	EventTypeUnpinCode              = "unpin_code"
 	EventTypeSetGasless             = "set_gasless"
 	EventTypeUnsetGasless           = "unset_gasless"
+	EventTypeGaslessExecute         = "gasless_execute"
 	EventTypeSudo                   = "sudo"
 	EventTypeReply                  = "reply"
 	EventTypeGovContractResult      = "gov_contract_result"
@@ -69,4 +70,5 @@ const (
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyGasUsed             = "gas_used"
 )
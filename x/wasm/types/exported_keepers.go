@@ -77,8 +77,8 @@ type ContractOpsKeeper interface {
 	// UnpinCode removes the wasm contract from wasmvm cache
 	UnpinCode(ctx sdk.Context, codeID uint64) error
 
-	// SetGasless set the gasless wasm contract in wasmvm cache
-	SetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	// SetGasless set the gasless wasm contract in wasmvm cache, with the gas budget of its executions
+	SetGasless(ctx sdk.Context, contractAddress sdk.AccAddress, config GaslessConfig) error
 
 	// UnsetGasless removes the gasless wasm contract from wasmvm cache
 	UnsetGasless(ctx sdk.Context, contractAddress sdk.AccAddress) error
@@ -0,0 +1,71 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GaslessUsageEpochLength is the number of blocks of an epoch in which the gas used by gasless executions is summed up
+const GaslessUsageEpochLength = 100_000
+
+// GaslessUsageEpoch returns the epoch of a block height
+func GaslessUsageEpoch(height int64) uint64 {
+	return uint64(height) / GaslessUsageEpochLength
+}
+
+// DefaultGaslessConfig is the configuration of contracts made gasless without one, by a legacy
+// proposal or before gas budgets existed.
+func DefaultGaslessConfig() GaslessConfig {
+	return GaslessConfig{
+		MaxGasPerExecution: 10_000_000,
+		MaxGasPerBlock:     100_000_000,
+	}
+}
+
+// ValidateBasic checks that the gas limits of the configuration are consistent.
+func (c GaslessConfig) ValidateBasic() error {
+	if c.MaxGasPerExecution == 0 {
+		return errorsmod.Wrap(ErrEmpty, "max gas per execution")
+	}
+	if c.MaxGasPerBlock < c.MaxGasPerExecution {
+		return errorsmod.Wrap(ErrInvalid, "max gas per block must not be less than max gas per execution")
+	}
+	if c.MaxGasPerSenderPerBlock != 0 &&
+		(c.MaxGasPerSenderPerBlock < c.MaxGasPerExecution || c.MaxGasPerSenderPerBlock > c.MaxGasPerBlock) {
+		return errorsmod.Wrap(ErrInvalid, "max gas per sender per block must be between max gas per execution and max gas per block")
+	}
+	if c.Sponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Sponsor); err != nil {
+			return errorsmod.Wrap(err, "sponsor")
+		}
+	}
+	return validateGaslessMessages(c.AllowedMessages)
+}
+
+func validateGaslessMessages(keys []string) error {
+	idx := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if key == "" {
+			return errorsmod.Wrap(ErrEmpty, "allowed message key")
+		}
+		if _, exists := idx[key]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "allowed message key %q", key)
+		}
+		idx[key] = struct{}{}
+	}
+	return nil
+}
+
+// Covers returns true when an execution with msg at the given block height is gasless: the
+// configuration has not expired and the top-level key of msg is allowed.
+func (c GaslessConfig) Covers(height int64, msg RawContractMessage) bool {
+	if c.ExpiryHeight != 0 && uint64(height) > c.ExpiryHeight {
+		return false
+	}
+	if len(c.AllowedMessages) == 0 {
+		return true
+	}
+	ok, err := isJSONObjectWithTopLevelKey(msg, c.AllowedMessages)
+	return err == nil && ok
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGaslessConfigCovers(t *testing.T) {
+	specs := map[string]struct {
+		config GaslessConfig
+		height int64
+		msg    RawContractMessage
+		exp    bool
+	}{
+		"all messages": {
+			config: GaslessConfig{},
+			height: 10,
+			msg:    []byte(`{"swap":{}}`),
+			exp:    true,
+		},
+		"allowed message": {
+			config: GaslessConfig{AllowedMessages: []string{"claim", "stake"}},
+			height: 10,
+			msg:    []byte(`{"claim":{"amount":"1"}}`),
+			exp:    true,
+		},
+		"other message": {
+			config: GaslessConfig{AllowedMessages: []string{"claim", "stake"}},
+			height: 10,
+			msg:    []byte(`{"swap":{}}`),
+		},
+		"multiple top-level keys": {
+			config: GaslessConfig{AllowedMessages: []string{"claim", "stake"}},
+			height: 10,
+			msg:    []byte(`{"claim":{},"stake":{}}`),
+		},
+		"not an object": {
+			config: GaslessConfig{AllowedMessages: []string{"claim"}},
+			height: 10,
+			msg:    []byte(`"claim"`),
+		},
+		"invalid json": {
+			config: GaslessConfig{AllowedMessages: []string{"claim"}},
+			height: 10,
+			msg:    []byte(`{"claim":`),
+		},
+		"at expiry height": {
+			config: GaslessConfig{ExpiryHeight: 10},
+			height: 10,
+			msg:    []byte(`{"swap":{}}`),
+			exp:    true,
+		},
+		"after expiry height": {
+			config: GaslessConfig{ExpiryHeight: 10},
+			height: 11,
+			msg:    []byte(`{"swap":{}}`),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.config.Covers(spec.height, spec.msg))
+		})
+	}
+}
@@ -74,6 +74,18 @@ func (c Contract) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "code history element %d", i)
 		}
 	}
+	if c.Gasless != nil {
+		if err := c.Gasless.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "gasless")
+		}
+	}
+	epochs := make(map[uint64]struct{}, len(c.GaslessGasUsage))
+	for _, usage := range c.GaslessGasUsage {
+		if _, exists := epochs[usage.Epoch]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "gasless gas usage of epoch %d", usage.Epoch)
+		}
+		epochs[usage.Epoch] = struct{}{}
+	}
 	return nil
 }
 
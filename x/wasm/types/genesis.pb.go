@@ -170,6 +170,10 @@ type Contract struct {
 	ContractInfo        ContractInfo               `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState       []Model                    `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// Gasless configuration, set when the contract is gasless
+	Gasless *GaslessConfig `protobuf:"bytes,5,opt,name=gasless,proto3" json:"gasless,omitempty"`
+	// Gas used by the gasless executions of the contract in each epoch
+	GaslessGasUsage []GaslessGasUsage `protobuf:"bytes,6,rep,name=gasless_gas_usage,json=gaslessGasUsage,proto3" json:"gasless_gas_usage"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -233,6 +237,20 @@ func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
 	return nil
 }
 
+func (m *Contract) GetGasless() *GaslessConfig {
+	if m != nil {
+		return m.Gasless
+	}
+	return nil
+}
+
+func (m *Contract) GetGaslessGasUsage() []GaslessGasUsage {
+	if m != nil {
+		return m.GaslessGasUsage
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -296,44 +314,48 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x84, 0x6a, 0xa4, 0x55, 0x91, 0x50,
-	0x35, 0x41, 0xa3, 0x8d, 0x23, 0x17, 0xc8, 0x86, 0xa0, 0x4c, 0x20, 0x94, 0x1e, 0x90, 0x76, 0xa9,
-	0xd2, 0xd8, 0xeb, 0x2c, 0x96, 0xb8, 0xc4, 0x6e, 0x21, 0xdf, 0x82, 0x4f, 0x81, 0x38, 0x72, 0xe0,
-	0x43, 0xec, 0xc6, 0x84, 0x84, 0xc4, 0xa9, 0x42, 0xed, 0x01, 0x89, 0x4f, 0x81, 0xfc, 0x27, 0x59,
-	0xd4, 0xae, 0x17, 0xab, 0xf6, 0xf3, 0xbe, 0xbf, 0xbe, 0xef, 0xe3, 0x37, 0x06, 0x76, 0x40, 0x59,
-	0xf8, 0xd1, 0x67, 0xa1, 0x23, 0x97, 0xc9, 0x9e, 0x33, 0xc4, 0x11, 0x66, 0x84, 0x75, 0x46, 0x31,
-	0xe5, 0x14, 0x6e, 0xa6, 0x7a, 0x47, 0x2e, 0x93, 0xbd, 0xfa, 0xd6, 0x90, 0x0e, 0xa9, 0x14, 0x1d,
-	0xf1, 0x4b, 0xc5, 0xd5, 0x77, 0x96, 0x38, 0x3c, 0x19, 0x61, 0x4d, 0xa9, 0xdf, 0xf4, 0x43, 0x12,
-	0x51, 0x47, 0xae, 0xfa, 0xe8, 0xae, 0x48, 0xa0, 0xac, 0xaf, 0x48, 0x6a, 0xa3, 0xa4, 0xd6, 0x8f,
-	0x22, 0xa8, 0xbd, 0x50, 0x55, 0xf4, 0xb8, 0xcf, 0x31, 0x7c, 0x02, 0xcc, 0x91, 0x1f, 0xfb, 0x21,
-	0xb3, 0x8c, 0xa6, 0xd1, 0x5e, 0xdf, 0xb7, 0x3a, 0x8b, 0x55, 0x75, 0xde, 0x4a, 0xdd, 0xad, 0x9e,
-	0x4f, 0x1b, 0x85, 0xaf, 0x7f, 0xbf, 0xed, 0x1a, 0x9e, 0x4e, 0x81, 0xaf, 0x40, 0x39, 0xa0, 0x08,
-	0x33, 0xab, 0xd8, 0x5c, 0x6b, 0xaf, 0xef, 0x6f, 0x2f, 0xe7, 0x1e, 0x50, 0x84, 0xdd, 0x1d, 0x91,
-	0xf9, 0x6f, 0xda, 0xd8, 0x90, 0xc1, 0x0f, 0x69, 0x48, 0x38, 0x0e, 0x47, 0x3c, 0x51, 0x30, 0x85,
-	0x80, 0xc7, 0xa0, 0x1a, 0xd0, 0x88, 0xc7, 0x7e, 0xc0, 0x99, 0xb5, 0x26, 0x79, 0xf5, 0xab, 0x78,
-	0x2a, 0xc4, 0x6d, 0x6a, 0xe6, 0xad, 0x2c, 0x69, 0x91, 0x7b, 0x89, 0x13, 0x6c, 0x86, 0x3f, 0x8c,
-	0x71, 0x14, 0x60, 0x66, 0x95, 0x56, 0xb1, 0x7b, 0x3a, 0xe4, 0x92, 0x9d, 0x25, 0x2d, 0xb1, 0x33,
-	0xa5, 0xf5, 0xc5, 0x00, 0x25, 0xd1, 0x25, 0xbc, 0x0f, 0xae, 0x89, 0x4e, 0xfa, 0x04, 0x49, 0x2b,
-	0x4b, 0x2e, 0x98, 0x4d, 0x1b, 0xa6, 0x90, 0xba, 0x87, 0x9e, 0x29, 0xa4, 0x2e, 0x82, 0xae, 0xe8,
-	0x52, 0x04, 0x45, 0x27, 0xd4, 0x2a, 0x4a, 0xc7, 0xeb, 0x57, 0xbb, 0xd6, 0x8d, 0x4e, 0x68, 0xde,
-	0xf3, 0x4a, 0xa0, 0x0f, 0xe1, 0x3d, 0x00, 0x24, 0x63, 0x90, 0x70, 0x2c, 0xac, 0x32, 0xda, 0x35,
-	0x4f, 0x52, 0x5d, 0x71, 0x00, 0xb7, 0x81, 0x39, 0x22, 0x51, 0x84, 0x91, 0x55, 0x6a, 0x1a, 0xed,
-	0x8a, 0xa7, 0x77, 0xad, 0x5f, 0x45, 0x50, 0x49, 0xed, 0x83, 0x07, 0x60, 0x33, 0xb5, 0xa7, 0xef,
-	0x23, 0x14, 0x63, 0xa6, 0x06, 0xa0, 0xea, 0x5a, 0x3f, 0xbf, 0x3f, 0xda, 0xd2, 0x33, 0xf3, 0x4c,
-	0x29, 0x3d, 0x1e, 0x93, 0x68, 0xe8, 0x6d, 0xa4, 0x19, 0xfa, 0x18, 0xbe, 0x01, 0xd7, 0x33, 0x48,
-	0xae, 0x21, 0x7b, 0xf5, 0xb5, 0x2d, 0x36, 0x55, 0x0b, 0x72, 0x02, 0xec, 0x82, 0x1b, 0x19, 0x8f,
-	0x89, 0xe9, 0xd4, 0x73, 0x70, 0x67, 0x19, 0xf8, 0x9a, 0x22, 0x7c, 0x96, 0x27, 0x65, 0x95, 0xa8,
-	0xb1, 0x26, 0xe0, 0x76, 0x86, 0x92, 0x66, 0x9d, 0x12, 0xc6, 0x69, 0x9c, 0xe8, 0xdb, 0xdf, 0x5d,
-	0x5d, 0xa2, 0xf0, 0xfe, 0xa5, 0x0a, 0x7e, 0x1e, 0xf1, 0x38, 0xc9, 0xff, 0x49, 0x36, 0x6c, 0xb9,
-	0xa0, 0x96, 0x0b, 0x2a, 0xe9, 0xe4, 0xc0, 0x26, 0x30, 0x09, 0xea, 0xbf, 0xc7, 0x89, 0x34, 0xb3,
-	0xe6, 0x56, 0x67, 0xd3, 0x46, 0xb9, 0x7b, 0x78, 0x84, 0x13, 0xaf, 0x4c, 0xd0, 0x11, 0x4e, 0xe0,
-	0x16, 0x28, 0x4f, 0xfc, 0xb3, 0x31, 0x96, 0x5e, 0x95, 0x3c, 0xb5, 0x71, 0x9f, 0x9e, 0xcf, 0x6c,
-	0xe3, 0x62, 0x66, 0x1b, 0x7f, 0x66, 0xb6, 0xf1, 0x79, 0x6e, 0x17, 0x2e, 0xe6, 0x76, 0xe1, 0xf7,
-	0xdc, 0x2e, 0x1c, 0x3f, 0x18, 0x12, 0x7e, 0x3a, 0x1e, 0x74, 0x02, 0x1a, 0x3a, 0x07, 0x94, 0x85,
-	0xef, 0xd2, 0x77, 0x00, 0x39, 0x9f, 0xd4, 0x7b, 0x20, 0x1f, 0x83, 0x81, 0x29, 0xbf, 0xef, 0xc7,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe3, 0xf2, 0x58, 0x75, 0x04, 0x00, 0x00,
+	// 641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0x36, 0x71, 0x93, 0x69, 0xee, 0x6d, 0x3b, 0x2d, 0xc5, 0x44, 0xc5, 0x09, 0x41,
+	0x42, 0x51, 0x05, 0x89, 0x5a, 0x56, 0x88, 0x0d, 0x38, 0x45, 0x25, 0x54, 0x20, 0xe4, 0x0a, 0x81,
+	0xba, 0x89, 0xa6, 0xf6, 0xd4, 0x1d, 0x51, 0x7b, 0x82, 0x67, 0x52, 0xf0, 0x5b, 0xf0, 0x14, 0x88,
+	0x25, 0x0b, 0x1e, 0xa2, 0x3b, 0x2a, 0x56, 0xac, 0x22, 0x94, 0x2e, 0x90, 0xfa, 0x14, 0x68, 0xfe,
+	0xd8, 0x0d, 0x71, 0xb3, 0x99, 0x78, 0xe6, 0xfb, 0xce, 0x2f, 0xe7, 0x9c, 0x39, 0x1a, 0x60, 0x7b,
+	0x94, 0x85, 0x1f, 0x11, 0x0b, 0x3b, 0x72, 0x39, 0xdd, 0xea, 0x04, 0x38, 0xc2, 0x8c, 0xb0, 0xf6,
+	0x20, 0xa6, 0x9c, 0xc2, 0xe5, 0x54, 0x6f, 0xcb, 0xe5, 0x74, 0xab, 0xb6, 0x16, 0xd0, 0x80, 0x4a,
+	0xb1, 0x23, 0xbe, 0x94, 0xaf, 0xb6, 0x91, 0xe3, 0xf0, 0x64, 0x80, 0x35, 0xa5, 0xb6, 0x82, 0x42,
+	0x12, 0xd1, 0x8e, 0x5c, 0xf5, 0xd1, 0x2d, 0x11, 0x40, 0x59, 0x5f, 0x91, 0xd4, 0x46, 0x49, 0xcd,
+	0x1f, 0x73, 0xa0, 0xba, 0xab, 0xb2, 0xd8, 0xe7, 0x88, 0x63, 0xf8, 0x18, 0x98, 0x03, 0x14, 0xa3,
+	0x90, 0x59, 0x46, 0xc3, 0x68, 0x2d, 0x6e, 0x5b, 0xed, 0xe9, 0xac, 0xda, 0xaf, 0xa5, 0xee, 0x54,
+	0xce, 0x46, 0xf5, 0xc2, 0xd7, 0x3f, 0xdf, 0x36, 0x0d, 0x57, 0x87, 0xc0, 0x17, 0xa0, 0xe4, 0x51,
+	0x1f, 0x33, 0x6b, 0xae, 0x31, 0xdf, 0x5a, 0xdc, 0x5e, 0xcf, 0xc7, 0x76, 0xa9, 0x8f, 0x9d, 0x0d,
+	0x11, 0x79, 0x39, 0xaa, 0x2f, 0x49, 0xf3, 0x7d, 0x1a, 0x12, 0x8e, 0xc3, 0x01, 0x4f, 0x14, 0x4c,
+	0x21, 0xe0, 0x01, 0xa8, 0x78, 0x34, 0xe2, 0x31, 0xf2, 0x38, 0xb3, 0xe6, 0x25, 0xaf, 0x76, 0x1d,
+	0x4f, 0x59, 0x9c, 0x86, 0x66, 0xae, 0x66, 0x41, 0xd3, 0xdc, 0x2b, 0x9c, 0x60, 0x33, 0xfc, 0x61,
+	0x88, 0x23, 0x0f, 0x33, 0xab, 0x38, 0x8b, 0xbd, 0xaf, 0x2d, 0x57, 0xec, 0x2c, 0x28, 0xc7, 0xce,
+	0x94, 0xe6, 0x17, 0x03, 0x14, 0x45, 0x95, 0xf0, 0x2e, 0x58, 0x10, 0x95, 0xf4, 0x89, 0x2f, 0x5b,
+	0x59, 0x74, 0xc0, 0x78, 0x54, 0x37, 0x85, 0xd4, 0xdb, 0x71, 0x4d, 0x21, 0xf5, 0x7c, 0xe8, 0x80,
+	0x8a, 0x32, 0x45, 0x47, 0xd4, 0x9a, 0x6b, 0x18, 0xd7, 0x67, 0x22, 0x83, 0xa2, 0x23, 0x3a, 0xd9,
+	0xf3, 0xb2, 0xa7, 0x0f, 0xe1, 0x6d, 0x00, 0x24, 0xe3, 0x30, 0xe1, 0x58, 0xb4, 0xca, 0x68, 0x55,
+	0x5d, 0x49, 0x75, 0xc4, 0x01, 0x5c, 0x07, 0xe6, 0x80, 0x44, 0x11, 0xf6, 0xad, 0x62, 0xc3, 0x68,
+	0x95, 0x5d, 0xbd, 0x6b, 0x5e, 0xce, 0x83, 0x72, 0xda, 0x3e, 0xd8, 0x05, 0xcb, 0x69, 0x7b, 0xfa,
+	0xc8, 0xf7, 0x63, 0xcc, 0xd4, 0x00, 0x54, 0x1c, 0xeb, 0xe7, 0xf7, 0x07, 0x6b, 0x7a, 0x66, 0x9e,
+	0x2a, 0x65, 0x9f, 0xc7, 0x24, 0x0a, 0xdc, 0xa5, 0x34, 0x42, 0x1f, 0xc3, 0x57, 0xe0, 0xbf, 0x0c,
+	0x32, 0x51, 0x90, 0x3d, 0xfb, 0xda, 0xa6, 0x8b, 0xaa, 0x7a, 0x13, 0x02, 0xec, 0x81, 0xff, 0x33,
+	0x1e, 0x13, 0xd3, 0xa9, 0xe7, 0xe0, 0x66, 0x1e, 0xf8, 0x92, 0xfa, 0xf8, 0x64, 0x92, 0x94, 0x65,
+	0xa2, 0xc6, 0x9a, 0x80, 0x1b, 0x19, 0x4a, 0x36, 0xeb, 0x98, 0x30, 0x4e, 0xe3, 0x44, 0xdf, 0xfe,
+	0xe6, 0xec, 0x14, 0x45, 0xef, 0x9f, 0x2b, 0xf3, 0xb3, 0x88, 0xc7, 0xc9, 0xe4, 0x9f, 0xac, 0x7a,
+	0x79, 0x13, 0x7c, 0x04, 0x16, 0x02, 0xc4, 0x4e, 0x44, 0x07, 0x4b, 0xb2, 0xfe, 0x7a, 0x1e, 0xbe,
+	0xab, 0x0c, 0x5d, 0x1a, 0x1d, 0x91, 0xc0, 0x4d, 0xfd, 0xf0, 0x1d, 0x58, 0xd1, 0x9f, 0xfd, 0x00,
+	0xb1, 0xfe, 0x90, 0xa1, 0x00, 0x5b, 0xa6, 0xcc, 0xf0, 0xce, 0x4c, 0xc8, 0x2e, 0x62, 0x6f, 0x84,
+	0x71, 0x32, 0xb1, 0xa5, 0xe0, 0x5f, 0xad, 0xe9, 0x80, 0x72, 0x3a, 0xce, 0xb0, 0x01, 0x4c, 0xe2,
+	0xf7, 0xdf, 0xe3, 0x44, 0xde, 0x70, 0xd5, 0xa9, 0x8c, 0x47, 0xf5, 0x52, 0x6f, 0x67, 0x0f, 0x27,
+	0x6e, 0x89, 0xf8, 0x7b, 0x38, 0x81, 0x6b, 0xa0, 0x74, 0x8a, 0x4e, 0x86, 0x58, 0x5e, 0x60, 0xd1,
+	0x55, 0x1b, 0xe7, 0xc9, 0xd9, 0xd8, 0x36, 0xce, 0xc7, 0xb6, 0xf1, 0x7b, 0x6c, 0x1b, 0x9f, 0x2f,
+	0xec, 0xc2, 0xf9, 0x85, 0x5d, 0xf8, 0x75, 0x61, 0x17, 0x0e, 0xee, 0x05, 0x84, 0x1f, 0x0f, 0x0f,
+	0xdb, 0x1e, 0x0d, 0x3b, 0x5d, 0xca, 0xc2, 0xb7, 0xe9, 0xe3, 0xe4, 0x77, 0x3e, 0xc9, 0x5f, 0xf5,
+	0x42, 0x1d, 0x9a, 0xf2, 0xd1, 0x79, 0xf8, 0x77, 0x00, 0x61, 0x4d, 0xe4, 0x3a, 0x0a, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -486,6 +508,32 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GaslessGasUsage) > 0 {
+		for iNdEx := len(m.GaslessGasUsage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaslessGasUsage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Gasless != nil {
+		{
+			size, err := m.Gasless.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ContractCodeHistory) > 0 {
 		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -654,6 +702,16 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Gasless != nil {
+		l = m.Gasless.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.GaslessGasUsage) > 0 {
+		for _, e := range m.GaslessGasUsage {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1182,6 +1240,76 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gasless", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gasless == nil {
+				m.Gasless = &GaslessConfig{}
+			}
+			if err := m.Gasless.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaslessGasUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaslessGasUsage = append(m.GaslessGasUsage, GaslessGasUsage{})
+			if err := m.GaslessGasUsage[len(m.GaslessGasUsage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
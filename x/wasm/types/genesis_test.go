@@ -149,6 +149,29 @@ func TestContractValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"gasless": {
+			srcMutator: func(c *Contract) {
+				config := DefaultGaslessConfig()
+				c.Gasless = &config
+			},
+		},
+		"gasless gas usage": {
+			srcMutator: func(c *Contract) {
+				c.GaslessGasUsage = []GaslessGasUsage{{Epoch: 1, GasUsed: 1}, {Epoch: 2, GasUsed: 2}}
+			},
+		},
+		"gasless gas usage duplicate epoch": {
+			srcMutator: func(c *Contract) {
+				c.GaslessGasUsage = []GaslessGasUsage{{Epoch: 1, GasUsed: 1}, {Epoch: 1, GasUsed: 2}}
+			},
+			expError: true,
+		},
+		"gasless config invalid": {
+			srcMutator: func(c *Contract) {
+				c.Gasless = &GaslessConfig{}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -37,11 +37,18 @@ var (
 	ParamsKey                                      = []byte{0x10}
 	AsyncAckKeyPrefix                              = []byte{0x11}
 	GaslessContractIndexPrefix                     = []byte{0x0a}
+	GaslessGasUsagePrefix                          = []byte{0x0b}
 
 	KeySequenceCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeySequenceInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
 )
 
+// prefixes of the transient store
+var (
+	GaslessBlockGasPrefix       = []byte{0x01}
+	GaslessSenderBlockGasPrefix = []byte{0x02}
+)
+
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
 func GetCodeKey(codeID uint64) []byte {
 	contractIDBz := sdk.Uint64ToBigEndian(codeID)
@@ -160,3 +167,24 @@ func GetGaslessContractIndexPrefix(contractAddr sdk.AccAddress) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetGaslessGasUsagePrefix returns the prefix of the gas used by the gasless executions of a contract in each epoch
+func GetGaslessGasUsagePrefix(contractAddr sdk.AccAddress) []byte {
+	return append(GaslessGasUsagePrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetGaslessGasUsageKey returns the key of the gas used by the gasless executions of a contract in an epoch
+func GetGaslessGasUsageKey(contractAddr sdk.AccAddress, epoch uint64) []byte {
+	return append(GetGaslessGasUsagePrefix(contractAddr), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetGaslessBlockGasKey returns the transient key of the gas used by the gasless executions of a contract in the block
+func GetGaslessBlockGasKey(contractAddr sdk.AccAddress) []byte {
+	return append(GaslessBlockGasPrefix, contractAddr...)
+}
+
+// GetGaslessSenderBlockGasKey returns the transient key of the gas used by the gasless executions of a contract by
+// a sender in the block
+func GetGaslessSenderBlockGasKey(contractAddr, sender sdk.AccAddress) []byte {
+	return append(append(GaslessSenderBlockGasPrefix, address.MustLengthPrefix(contractAddr)...), sender...)
+}
@@ -738,14 +738,37 @@ func NewSetGaslessContractsProposal(
 	title string,
 	description string,
 	contractAddresses []string,
+	config GaslessConfig,
 ) *SetGasLessContractsProposal {
 	return &SetGasLessContractsProposal{
-		Title:             title,
-		Description:       description,
-		ContractAddresses: contractAddresses,
+		Title:                   title,
+		Description:             description,
+		ContractAddresses:       contractAddresses,
+		AllowedMessages:         config.AllowedMessages,
+		ExpiryHeight:            config.ExpiryHeight,
+		MaxGasPerExecution:      config.MaxGasPerExecution,
+		MaxGasPerBlock:          config.MaxGasPerBlock,
+		MaxGasPerSenderPerBlock: config.MaxGasPerSenderPerBlock,
+		Sponsor:                 config.Sponsor,
 	}
 }
 
+// GaslessConfig returns the configuration the proposal sets, with the default gas limits
+// in place of the unset ones.
+func (p SetGasLessContractsProposal) GaslessConfig() GaslessConfig {
+	config := DefaultGaslessConfig()
+	if p.MaxGasPerExecution != 0 {
+		config.MaxGasPerExecution = p.MaxGasPerExecution
+	}
+	if p.MaxGasPerBlock != 0 {
+		config.MaxGasPerBlock = p.MaxGasPerBlock
+	}
+	config.MaxGasPerSenderPerBlock = p.MaxGasPerSenderPerBlock
+	config.Sponsor = p.Sponsor
+	config.AllowedMessages, config.ExpiryHeight = p.AllowedMessages, p.ExpiryHeight
+	return config
+}
+
 // ProposalRoute returns the routing key of a parameter change proposal.
 func (p SetGasLessContractsProposal) ProposalRoute() string { return RouterKey }
 
@@ -768,7 +791,7 @@ func (p SetGasLessContractsProposal) ValidateBasic() error {
 	if len(p.ContractAddresses) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "contract addresses")
 	}
-	return nil
+	return p.GaslessConfig().ValidateBasic()
 }
 
 // String implements the Stringer interface.
@@ -777,7 +800,14 @@ func (p SetGasLessContractsProposal) String() string {
   Title:       %s
   Description: %s
   ContractAddresses:       %v
-`, p.Title, p.Description, p.ContractAddresses)
+  AllowedMessages:       %v
+  ExpiryHeight:       %d
+  MaxGasPerExecution:       %d
+  MaxGasPerBlock:       %d
+  MaxGasPerSenderPerBlock:       %d
+  Sponsor:       %s
+`, p.Title, p.Description, p.ContractAddresses, p.AllowedMessages, p.ExpiryHeight,
+		p.MaxGasPerExecution, p.MaxGasPerBlock, p.MaxGasPerSenderPerBlock, p.Sponsor)
 }
 
 func NewUnsetGasLessContractsProposal(
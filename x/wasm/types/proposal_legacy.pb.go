@@ -560,6 +560,24 @@ type SetGasLessContractsProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
 	// ContractAddresses references the new WASM addresses
 	ContractAddresses []string `protobuf:"bytes,3,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty" yaml:"contract_addresses"`
+	// AllowedMessages are the top-level keys of the execute messages that are
+	// gasless, all messages when empty
+	AllowedMessages []string `protobuf:"bytes,4,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty" yaml:"allowed_messages"`
+	// ExpiryHeight is the last block height in which executions are gasless,
+	// zero for no expiry
+	ExpiryHeight uint64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// MaxGasPerExecution is the gas limit of a single execution, the default
+	// when zero
+	MaxGasPerExecution uint64 `protobuf:"varint,6,opt,name=max_gas_per_execution,json=maxGasPerExecution,proto3" json:"max_gas_per_execution,omitempty" yaml:"max_gas_per_execution"`
+	// MaxGasPerBlock is the gas all executions of a contract can use in a block,
+	// the default when zero
+	MaxGasPerBlock uint64 `protobuf:"varint,7,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty" yaml:"max_gas_per_block"`
+	// MaxGasPerSenderPerBlock is the gas the executions of a single sender can
+	// use in a block, zero for no limit
+	MaxGasPerSenderPerBlock uint64 `protobuf:"varint,8,opt,name=max_gas_per_sender_per_block,json=maxGasPerSenderPerBlock,proto3" json:"max_gas_per_sender_per_block,omitempty" yaml:"max_gas_per_sender_per_block"`
+	// Sponsor is the account charged the fees of transactions that only execute
+	// the contracts. When empty, the min fee of these transactions is waived.
+	Sponsor string `protobuf:"bytes,9,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
 }
 
 func (m *SetGasLessContractsProposal) Reset()      { *m = SetGasLessContractsProposal{} }
@@ -818,88 +836,100 @@ func init() {
 }
 
 var fileDescriptor_68e9c908a42bedfa = []byte{
-	// 1293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xf8, 0x63, 0x6d, 0x8f, 0x0d, 0x38, 0xdb, 0x34, 0x99, 0xf4, 0x63, 0xd7, 0x6c, 0xab,
-	0xc8, 0xaa, 0xa8, 0xad, 0x18, 0x84, 0x8a, 0xf9, 0x90, 0xbc, 0xa1, 0x85, 0x54, 0x8d, 0x54, 0x6d,
-	0x14, 0x55, 0xe2, 0xb2, 0x8c, 0x77, 0x27, 0x9b, 0x05, 0x7b, 0xd7, 0xda, 0x59, 0xe7, 0xe3, 0x8a,
-	0x38, 0x21, 0x21, 0x71, 0x2e, 0xff, 0x40, 0xe1, 0x80, 0x2a, 0xd1, 0x0b, 0x37, 0x40, 0x42, 0xaa,
-	0x38, 0x55, 0x9c, 0x7a, 0x32, 0xd4, 0x3d, 0xf4, 0xc0, 0x2d, 0x47, 0x0e, 0x08, 0xed, 0xcc, 0x6e,
-	0xe2, 0x3a, 0xfe, 0x48, 0x93, 0x90, 0x56, 0x82, 0xcb, 0x26, 0xb3, 0xef, 0x8d, 0xf7, 0xfd, 0x7e,
-	0xbf, 0x99, 0x37, 0xf3, 0x1e, 0x9c, 0x37, 0x5c, 0xda, 0xda, 0xc4, 0xb4, 0x55, 0x61, 0x8f, 0x8d,
-	0x85, 0x4a, 0xdb, 0x73, 0xdb, 0x2e, 0xc5, 0x4d, 0xbd, 0x49, 0x2c, 0x6c, 0x6c, 0x97, 0xdb, 0x9e,
-	0xeb, 0xbb, 0x62, 0x21, 0xf2, 0x2b, 0xb3, 0xc7, 0xc6, 0xc2, 0x99, 0x69, 0xcb, 0xb5, 0x5c, 0x66,
-	0xac, 0x04, 0xff, 0x71, 0xbf, 0x33, 0x73, 0x81, 0x9f, 0x4b, 0x75, 0x6e, 0xe0, 0x83, 0xd0, 0x24,
-	0xf1, 0x51, 0xa5, 0x81, 0x29, 0xa9, 0x6c, 0x2c, 0x34, 0x88, 0x8f, 0x17, 0x2a, 0x86, 0x6b, 0x3b,
-	0xa1, 0xfd, 0xdc, 0xbe, 0x50, 0xfc, 0xed, 0x36, 0x89, 0x66, 0x4f, 0xe1, 0x96, 0xed, 0xb8, 0x15,
-	0xf6, 0xe4, 0xaf, 0x94, 0x9f, 0x13, 0x70, 0x6a, 0xc5, 0x77, 0x3d, 0xb2, 0xe8, 0x9a, 0xe4, 0x66,
-	0x18, 0xb6, 0x38, 0x0d, 0x53, 0xbe, 0xed, 0x37, 0x09, 0x02, 0x45, 0x50, 0xca, 0x6a, 0x7c, 0x20,
-	0x16, 0x61, 0xce, 0x24, 0xd4, 0xf0, 0xec, 0xb6, 0x6f, 0xbb, 0x0e, 0x8a, 0x33, 0x5b, 0xff, 0x2b,
-	0xb1, 0x02, 0x05, 0xaf, 0xe3, 0xe8, 0x98, 0xa2, 0x44, 0x60, 0x54, 0xd1, 0x6f, 0xf7, 0x2e, 0x4f,
-	0x87, 0x00, 0xea, 0xa6, 0xe9, 0x11, 0x4a, 0x57, 0x7c, 0xcf, 0x76, 0x2c, 0x2d, 0xe5, 0x75, 0x9c,
-	0x3a, 0x15, 0xdf, 0x84, 0x2f, 0x07, 0x81, 0xea, 0x8d, 0x6d, 0x9f, 0xe8, 0x86, 0x6b, 0x12, 0x94,
-	0x2c, 0x82, 0x52, 0x5e, 0x2d, 0xf4, 0xba, 0x72, 0xfe, 0x56, 0x7d, 0x65, 0x59, 0xdd, 0xf6, 0x59,
-	0x68, 0x5a, 0x3e, 0xf0, 0x8b, 0x46, 0xe2, 0x2a, 0x9c, 0xb1, 0x1d, 0xea, 0x63, 0xc7, 0xb7, 0xb1,
-	0x4f, 0xf4, 0x36, 0xf1, 0x5a, 0x36, 0xa5, 0x41, 0x54, 0xe9, 0x22, 0x28, 0xe5, 0xaa, 0x52, 0x79,
-	0x90, 0xeb, 0x72, 0xdd, 0x30, 0x08, 0xa5, 0x8b, 0xae, 0xb3, 0x66, 0x5b, 0xda, 0xe9, 0xbe, 0xd9,
-	0x37, 0x77, 0x27, 0x8b, 0xe7, 0x21, 0xec, 0x38, 0x6d, 0xdb, 0xe1, 0xa1, 0x64, 0x8a, 0xa0, 0x94,
-	0xd1, 0xb2, 0xec, 0x0d, 0xfb, 0xea, 0x0c, 0x14, 0xa8, 0xdb, 0xf1, 0x0c, 0x82, 0xb2, 0x0c, 0x7b,
-	0x38, 0x12, 0x11, 0x4c, 0x37, 0x3a, 0x76, 0xd3, 0x24, 0x1e, 0x82, 0xcc, 0x10, 0x0d, 0xc5, 0xb3,
-	0x30, 0x1b, 0xfc, 0x94, 0xbe, 0x8e, 0xe9, 0x3a, 0xca, 0x05, 0xd0, 0xb4, 0x4c, 0xf0, 0xe2, 0x43,
-	0x4c, 0xd7, 0x6b, 0x6f, 0xff, 0x7a, 0xef, 0xf2, 0x99, 0x90, 0x1d, 0xcb, 0xdd, 0x28, 0x87, 0x7a,
-	0x96, 0x17, 0x5d, 0xc7, 0x27, 0x8e, 0xff, 0xc5, 0x93, 0xbb, 0x97, 0x66, 0x98, 0x8c, 0xfb, 0x04,
-	0x42, 0xe0, 0x7a, 0x32, 0x93, 0x2a, 0x08, 0xd7, 0x93, 0x19, 0xa1, 0x90, 0x56, 0xfe, 0x4c, 0xc0,
-	0xb3, 0x4b, 0x7b, 0x80, 0x82, 0xf9, 0x1e, 0x36, 0xfc, 0x93, 0x97, 0xb3, 0x0c, 0x53, 0xd8, 0x6c,
-	0xd9, 0x0e, 0x53, 0x71, 0xac, 0x3f, 0x73, 0x13, 0x2f, 0xc0, 0x34, 0xa3, 0xc7, 0x36, 0x51, 0xaa,
-	0x08, 0x4a, 0x49, 0x15, 0xf6, 0xba, 0xb2, 0x10, 0x20, 0x5d, 0x7a, 0x5f, 0x13, 0x02, 0xd3, 0x92,
-	0x19, 0x44, 0xdf, 0xc4, 0x0d, 0xd2, 0x44, 0x02, 0x8f, 0x9e, 0x0d, 0xc4, 0x2b, 0x30, 0xd1, 0xa2,
-	0x16, 0x93, 0x3b, 0xaf, 0xce, 0xff, 0xd5, 0x95, 0x45, 0x0d, 0x6f, 0x46, 0xc8, 0x97, 0x09, 0xa5,
-	0xd8, 0x22, 0xb7, 0x9f, 0xdc, 0xbd, 0x94, 0xb3, 0x9d, 0xa6, 0xed, 0x10, 0xfd, 0x13, 0xea, 0x3a,
-	0x5a, 0x30, 0x45, 0xdc, 0x84, 0xa9, 0xb5, 0x8e, 0x63, 0x52, 0x94, 0x29, 0x26, 0x4a, 0xb9, 0xea,
-	0x5c, 0x39, 0x8c, 0x30, 0xd8, 0x53, 0x7d, 0x1a, 0xd8, 0x8e, 0x7a, 0xed, 0x7e, 0x57, 0x8e, 0x7d,
-	0xfb, 0xbb, 0x5c, 0xb2, 0x6c, 0x7f, 0xbd, 0xd3, 0x28, 0x1b, 0x6e, 0x2b, 0xdc, 0x8e, 0xe1, 0x9f,
-	0xcb, 0xd4, 0xfc, 0x34, 0xdc, 0x61, 0xc1, 0x04, 0x1a, 0x7c, 0x30, 0xcf, 0xf7, 0xbc, 0x1e, 0xec,
-	0x4a, 0x7a, 0xe7, 0xc9, 0xdd, 0x4b, 0x40, 0xe3, 0xdf, 0xab, 0x5d, 0x9d, 0xac, 0x77, 0x91, 0xe9,
-	0x3d, 0x46, 0x4b, 0x04, 0x94, 0xdb, 0x49, 0x78, 0x6e, 0x88, 0x47, 0xf5, 0x7f, 0xb9, 0x5f, 0x28,
-	0xb9, 0x45, 0x11, 0x26, 0x29, 0x6e, 0xfa, 0x2c, 0x57, 0xe4, 0x35, 0xf6, 0xbf, 0x38, 0x0b, 0xd3,
-	0x6b, 0xf6, 0x96, 0x1e, 0x40, 0x81, 0x2c, 0xbb, 0x08, 0x6b, 0xf6, 0xd6, 0x32, 0xb5, 0x6a, 0xd7,
-	0x26, 0xaf, 0x8d, 0x57, 0x47, 0xad, 0x8d, 0x6a, 0xdf, 0xe2, 0xf8, 0x26, 0x0e, 0x67, 0x97, 0x6d,
-	0xcb, 0x3b, 0xce, 0x34, 0xf0, 0x06, 0xcc, 0x18, 0xe1, 0x6f, 0x4d, 0x54, 0x7a, 0xd7, 0xf3, 0x60,
-	0x62, 0x87, 0xb2, 0x0a, 0xcf, 0x2c, 0x6b, 0xad, 0x3e, 0x99, 0xb0, 0x73, 0x8c, 0xb0, 0x11, 0x6c,
-	0x20, 0xa0, 0xfc, 0x0d, 0xe0, 0xf4, 0x4a, 0xc7, 0x74, 0xff, 0x15, 0xa2, 0x12, 0x07, 0x26, 0x2a,
-	0xe4, 0x20, 0xf9, 0xec, 0x1c, 0xbc, 0x37, 0x99, 0x83, 0x39, 0x7e, 0x80, 0x0c, 0x41, 0x89, 0x80,
-	0xf2, 0x5d, 0x02, 0xce, 0x5e, 0xdd, 0x22, 0x46, 0xe7, 0x79, 0x9e, 0x19, 0x87, 0x5b, 0x5d, 0x21,
-	0x69, 0xa9, 0x23, 0xe4, 0x03, 0xe1, 0x84, 0xd3, 0xff, 0x81, 0x57, 0xec, 0x08, 0x49, 0x10, 0x50,
-	0xbe, 0x8c, 0xc3, 0x53, 0xab, 0x6d, 0x13, 0xfb, 0xa4, 0x1e, 0x24, 0xd4, 0x23, 0x8b, 0xb5, 0x04,
-	0xb3, 0x0e, 0xd9, 0xd4, 0x79, 0x12, 0xe7, 0x7a, 0xbd, 0xb6, 0xd3, 0x95, 0x0b, 0xdb, 0xb8, 0xd5,
-	0xac, 0x29, 0xbb, 0x26, 0x65, 0xb4, 0x20, 0x0e, 0xd9, 0x64, 0xa1, 0x1c, 0x4e, 0xc6, 0xda, 0xbb,
-	0x93, 0x39, 0x41, 0x8c, 0x93, 0x21, 0xa8, 0x11, 0x50, 0x7e, 0x04, 0x50, 0x5c, 0x6c, 0x12, 0xec,
-	0x1d, 0x0f, 0x1d, 0x87, 0xda, 0xbf, 0xb5, 0x77, 0x26, 0x63, 0x98, 0x65, 0x18, 0xf6, 0x47, 0x8a,
-	0x80, 0xf2, 0x13, 0x80, 0x85, 0x9b, 0xfc, 0x7e, 0x49, 0x8f, 0x0c, 0xe0, 0x2d, 0x98, 0x09, 0x73,
-	0x6e, 0xb0, 0xfd, 0x12, 0xa5, 0xa4, 0x2a, 0xf5, 0xba, 0x72, 0x9a, 0x27, 0x5d, 0xba, 0xd3, 0x95,
-	0x5f, 0xe1, 0xca, 0x46, 0x4e, 0x8a, 0x96, 0xe6, 0x89, 0x98, 0xd6, 0x6a, 0x93, 0x51, 0x9c, 0x66,
-	0x28, 0x06, 0x83, 0x45, 0x40, 0xf9, 0x05, 0x40, 0x71, 0x35, 0xba, 0x25, 0x3f, 0x57, 0x14, 0x07,
-	0xd6, 0x62, 0x7f, 0xb8, 0x08, 0x28, 0x9f, 0xc5, 0xe1, 0xd9, 0x15, 0xe2, 0x7f, 0x80, 0xe9, 0x0d,
-	0x5e, 0x2a, 0x30, 0x81, 0xf7, 0x00, 0xcd, 0x3f, 0x05, 0x48, 0x2d, 0xec, 0x74, 0xe5, 0x3c, 0x0f,
-	0x85, 0xbd, 0x56, 0x22, 0x88, 0x57, 0x86, 0x40, 0x54, 0x67, 0x76, 0xba, 0xb2, 0xc8, 0xbd, 0xfb,
-	0x8c, 0xca, 0xd3, 0xd0, 0x31, 0x14, 0xa3, 0x75, 0xa5, 0x63, 0xbe, 0xde, 0x08, 0x27, 0x21, 0xab,
-	0x56, 0x7b, 0x5d, 0x79, 0x2a, 0x0a, 0xaa, 0x1e, 0x19, 0x77, 0xba, 0xf2, 0x5c, 0x44, 0xc7, 0xe0,
-	0x44, 0x45, 0x9b, 0x32, 0x06, 0xfd, 0x6b, 0xd2, 0x78, 0x8a, 0x94, 0xcf, 0xe3, 0xf0, 0xfc, 0xaa,
-	0x43, 0xff, 0xeb, 0x34, 0x7c, 0x0d, 0xa0, 0xd8, 0x5f, 0x32, 0xf2, 0x04, 0xd4, 0x7f, 0xab, 0x01,
-	0x23, 0x6f, 0x35, 0x1f, 0x8f, 0xac, 0x4e, 0xe3, 0x07, 0xa9, 0x4e, 0xd5, 0x6c, 0x70, 0xf0, 0xf0,
-	0xb3, 0x63, 0x78, 0xa1, 0xaa, 0x7c, 0x1f, 0x87, 0x32, 0x8f, 0xe8, 0xe9, 0xfb, 0xe0, 0x9a, 0x6d,
-	0x9d, 0xa0, 0x4c, 0x06, 0x3c, 0x8d, 0x59, 0xdc, 0xba, 0xc1, 0x3e, 0xad, 0x77, 0x58, 0x48, 0x5c,
-	0xa9, 0x5c, 0xf5, 0xe2, 0x78, 0x98, 0x3c, 0xfe, 0x7e, 0xb0, 0xa7, 0xf0, 0x3e, 0x33, 0xad, 0x2d,
-	0x4d, 0xde, 0xd2, 0x17, 0xfb, 0x8e, 0x88, 0x91, 0x7c, 0x20, 0xa0, 0xfc, 0x90, 0x82, 0x17, 0x58,
-	0x2d, 0x5d, 0x77, 0xcc, 0x17, 0xa2, 0x5e, 0x3e, 0xfe, 0xf6, 0x47, 0xea, 0xf8, 0xda, 0x1f, 0xc2,
-	0x60, 0xfb, 0x63, 0x3a, 0x2a, 0xf7, 0xd2, 0x9c, 0x16, 0x5e, 0xd4, 0xed, 0xd6, 0x6b, 0x99, 0x21,
-	0xf5, 0x5a, 0xf6, 0x08, 0xf7, 0x33, 0x78, 0xc2, 0xf5, 0xda, 0x5e, 0x77, 0x27, 0x37, 0xaa, 0xbb,
-	0x93, 0x1f, 0xd3, 0xdd, 0x79, 0x69, 0xa0, 0xbb, 0xb3, 0x3c, 0x79, 0xdd, 0x96, 0xf6, 0xba, 0x3b,
-	0xe3, 0x57, 0x24, 0x02, 0xea, 0x8d, 0xfb, 0x8f, 0xa4, 0xd8, 0xc3, 0x47, 0x52, 0xec, 0x4e, 0x4f,
-	0x02, 0xf7, 0x7b, 0x12, 0x78, 0xd0, 0x93, 0xc0, 0x1f, 0x3d, 0x09, 0x7c, 0xf5, 0x58, 0x8a, 0x3d,
-	0x78, 0x2c, 0xc5, 0x1e, 0x3e, 0x96, 0x62, 0x1f, 0xcd, 0xf7, 0x51, 0xb1, 0xe8, 0xd2, 0xd6, 0xad,
-	0xa8, 0x15, 0x68, 0x56, 0xb6, 0x78, 0x4b, 0x90, 0xd1, 0xd1, 0x10, 0x58, 0xf7, 0xef, 0xf5, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x87, 0x3d, 0xf3, 0x5d, 0xbb, 0x14, 0x00, 0x00,
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6b, 0x1b, 0xc7,
+	0x17, 0xf7, 0x58, 0xbf, 0xc7, 0x4a, 0x62, 0x6f, 0xfc, 0x63, 0x1c, 0x3b, 0x5a, 0x7d, 0x37, 0xc1,
+	0x5f, 0x61, 0x1a, 0x09, 0xbb, 0xa5, 0xa4, 0x6a, 0x53, 0xd0, 0x3a, 0xbf, 0x0c, 0x31, 0x98, 0x15,
+	0x26, 0xd0, 0xcb, 0x76, 0xb4, 0x3b, 0x5e, 0x6f, 0x23, 0xed, 0x8a, 0x9d, 0x95, 0x2d, 0xdf, 0x7b,
+	0x2a, 0x14, 0x7a, 0x4e, 0xff, 0x81, 0xb4, 0x87, 0x12, 0x68, 0x28, 0xf4, 0xd6, 0x1e, 0x0a, 0xa1,
+	0xa7, 0x50, 0x28, 0xe4, 0xb4, 0x6d, 0x94, 0x43, 0x0e, 0xbd, 0xe9, 0xd8, 0x43, 0x29, 0x3b, 0xb3,
+	0x6b, 0xcb, 0xfa, 0x61, 0x39, 0xb1, 0xeb, 0x84, 0xd2, 0xcb, 0x4a, 0x33, 0xef, 0xcd, 0xdb, 0xf7,
+	0x3e, 0x9f, 0x37, 0x6f, 0x67, 0x1e, 0x5c, 0xd0, 0x6c, 0x5a, 0xdb, 0xc1, 0xb4, 0x56, 0x60, 0x8f,
+	0xed, 0xa5, 0x42, 0xdd, 0xb1, 0xeb, 0x36, 0xc5, 0x55, 0xb5, 0x4a, 0x0c, 0xac, 0xed, 0xe6, 0xeb,
+	0x8e, 0xed, 0xda, 0xc2, 0x78, 0xa8, 0x97, 0x67, 0x8f, 0xed, 0xa5, 0x0b, 0x93, 0x86, 0x6d, 0xd8,
+	0x4c, 0x58, 0xf0, 0xff, 0x71, 0xbd, 0x0b, 0xb3, 0xbe, 0x9e, 0x4d, 0x55, 0x2e, 0xe0, 0x83, 0x40,
+	0x94, 0xe1, 0xa3, 0x42, 0x05, 0x53, 0x52, 0xd8, 0x5e, 0xaa, 0x10, 0x17, 0x2f, 0x15, 0x34, 0xdb,
+	0xb4, 0x02, 0xf9, 0x7c, 0x8f, 0x2b, 0xee, 0x6e, 0x9d, 0x84, 0xab, 0x27, 0x70, 0xcd, 0xb4, 0xec,
+	0x02, 0x7b, 0xf2, 0x29, 0xe9, 0xbb, 0x08, 0x9c, 0x28, 0xbb, 0xb6, 0x43, 0x56, 0x6c, 0x9d, 0xac,
+	0x07, 0x6e, 0x0b, 0x93, 0x30, 0xe6, 0x9a, 0x6e, 0x95, 0x20, 0x90, 0x05, 0xb9, 0x94, 0xc2, 0x07,
+	0x42, 0x16, 0x8e, 0xe9, 0x84, 0x6a, 0x8e, 0x59, 0x77, 0x4d, 0xdb, 0x42, 0xa3, 0x4c, 0xd6, 0x39,
+	0x25, 0x14, 0x60, 0xdc, 0x69, 0x58, 0x2a, 0xa6, 0x28, 0xe2, 0x0b, 0x65, 0xf4, 0xcb, 0xa3, 0x2b,
+	0x93, 0x41, 0x00, 0x25, 0x5d, 0x77, 0x08, 0xa5, 0x65, 0xd7, 0x31, 0x2d, 0x43, 0x89, 0x39, 0x0d,
+	0xab, 0x44, 0x85, 0x77, 0xe1, 0x59, 0xdf, 0x51, 0xb5, 0xb2, 0xeb, 0x12, 0x55, 0xb3, 0x75, 0x82,
+	0xa2, 0x59, 0x90, 0x4b, 0xcb, 0xe3, 0x2d, 0x4f, 0x4c, 0xdf, 0x2d, 0x95, 0xd7, 0xe4, 0x5d, 0x97,
+	0xb9, 0xa6, 0xa4, 0x7d, 0xbd, 0x70, 0x24, 0x6c, 0xc0, 0x69, 0xd3, 0xa2, 0x2e, 0xb6, 0x5c, 0x13,
+	0xbb, 0x44, 0xad, 0x13, 0xa7, 0x66, 0x52, 0xea, 0x7b, 0x95, 0xc8, 0x82, 0xdc, 0xd8, 0x72, 0x26,
+	0xdf, 0x8d, 0x75, 0xbe, 0xa4, 0x69, 0x84, 0xd2, 0x15, 0xdb, 0xda, 0x34, 0x0d, 0x65, 0xaa, 0x63,
+	0xf5, 0xfa, 0xde, 0x62, 0xe1, 0x22, 0x84, 0x0d, 0xab, 0x6e, 0x5a, 0xdc, 0x95, 0x64, 0x16, 0xe4,
+	0x92, 0x4a, 0x8a, 0xcd, 0xb0, 0xb7, 0x4e, 0xc3, 0x38, 0xb5, 0x1b, 0x8e, 0x46, 0x50, 0x8a, 0xc5,
+	0x1e, 0x8c, 0x04, 0x04, 0x13, 0x95, 0x86, 0x59, 0xd5, 0x89, 0x83, 0x20, 0x13, 0x84, 0x43, 0x61,
+	0x0e, 0xa6, 0x7c, 0x53, 0xea, 0x16, 0xa6, 0x5b, 0x68, 0xcc, 0x0f, 0x4d, 0x49, 0xfa, 0x13, 0xb7,
+	0x31, 0xdd, 0x2a, 0xbe, 0xff, 0xf3, 0xa3, 0x2b, 0x17, 0x02, 0x74, 0x0c, 0x7b, 0x3b, 0x1f, 0xf0,
+	0x99, 0x5f, 0xb1, 0x2d, 0x97, 0x58, 0xee, 0x67, 0x2f, 0x1e, 0x2e, 0x4e, 0x33, 0x1a, 0x7b, 0x08,
+	0x42, 0x40, 0xfa, 0x23, 0x02, 0xe7, 0x56, 0xf7, 0x83, 0xf0, 0xd7, 0x38, 0x58, 0x73, 0x4f, 0x9f,
+	0xc2, 0x3c, 0x8c, 0x61, 0xbd, 0x66, 0x5a, 0x28, 0x3a, 0x4c, 0x9f, 0xa9, 0x09, 0x97, 0x60, 0x82,
+	0x41, 0x62, 0xea, 0x28, 0x96, 0x05, 0xb9, 0xa8, 0x0c, 0x5b, 0x9e, 0x18, 0xf7, 0xa3, 0x5b, 0xbd,
+	0xae, 0xc4, 0x7d, 0xd1, 0xaa, 0xee, 0x7b, 0x5f, 0xc5, 0x15, 0x52, 0x45, 0x71, 0xee, 0x3d, 0x1b,
+	0x08, 0x57, 0x61, 0xa4, 0x46, 0x0d, 0x46, 0x71, 0x5a, 0x5e, 0xf8, 0xd3, 0x13, 0x05, 0x05, 0xef,
+	0x84, 0x91, 0xaf, 0x11, 0x4a, 0xb1, 0x41, 0xee, 0xbf, 0x78, 0xb8, 0x38, 0x66, 0x5a, 0x55, 0xd3,
+	0x22, 0xea, 0x27, 0xd4, 0xb6, 0x14, 0x7f, 0x89, 0xb0, 0x03, 0x63, 0x9b, 0x0d, 0x4b, 0xa7, 0x28,
+	0x99, 0x8d, 0xe4, 0xc6, 0x96, 0x67, 0xf3, 0x81, 0x87, 0xfe, 0x3e, 0xea, 0xc0, 0xdd, 0xb4, 0xe4,
+	0x9b, 0x8f, 0x3d, 0x71, 0xe4, 0xeb, 0xdf, 0xc4, 0x9c, 0x61, 0xba, 0x5b, 0x8d, 0x4a, 0x5e, 0xb3,
+	0x6b, 0xc1, 0x16, 0x0c, 0x7e, 0xae, 0x50, 0xfd, 0x5e, 0xb0, 0xab, 0xfc, 0x05, 0xd4, 0x7f, 0x61,
+	0x9a, 0xef, 0x73, 0xd5, 0xdf, 0x89, 0xf4, 0xc1, 0x8b, 0x87, 0x8b, 0x40, 0xe1, 0xef, 0x2b, 0xde,
+	0x18, 0xce, 0x71, 0x96, 0x71, 0x7c, 0x08, 0x97, 0x08, 0x48, 0xf7, 0xa3, 0x70, 0xbe, 0x8f, 0xc6,
+	0xf2, 0x7f, 0x74, 0xbf, 0x51, 0x74, 0x0b, 0x02, 0x8c, 0x52, 0x5c, 0x75, 0x59, 0x7d, 0x48, 0x2b,
+	0xec, 0xbf, 0x30, 0x03, 0x13, 0x9b, 0x66, 0x53, 0xf5, 0x43, 0x81, 0xac, 0xa2, 0xc4, 0x37, 0xcd,
+	0xe6, 0x1a, 0x35, 0x8a, 0x37, 0x87, 0xe7, 0xc6, 0xff, 0x06, 0xe5, 0xc6, 0x72, 0x47, 0x72, 0x7c,
+	0x35, 0x0a, 0x67, 0xd6, 0x4c, 0xc3, 0x39, 0xc9, 0x32, 0xf0, 0x0e, 0x4c, 0x6a, 0x81, 0xad, 0xa1,
+	0x4c, 0xef, 0x69, 0x1e, 0x8d, 0xec, 0x80, 0xd6, 0xf8, 0x4b, 0xd3, 0x5a, 0x2c, 0x0d, 0x07, 0x6c,
+	0x9e, 0x01, 0x36, 0x00, 0x0d, 0x04, 0xa4, 0xbf, 0x00, 0x9c, 0x2c, 0x37, 0x74, 0xfb, 0x1f, 0x01,
+	0x2a, 0x72, 0x64, 0xa0, 0x02, 0x0c, 0xa2, 0x2f, 0x8f, 0xc1, 0x87, 0xc3, 0x31, 0x98, 0xe5, 0x1f,
+	0x8d, 0x3e, 0x51, 0x22, 0x20, 0x7d, 0x13, 0x81, 0x33, 0x37, 0x9a, 0x44, 0x6b, 0xbc, 0xce, 0x6f,
+	0xc6, 0xab, 0x65, 0x57, 0x00, 0x5a, 0xec, 0x18, 0xf5, 0x20, 0x7e, 0xca, 0xe5, 0xff, 0xc8, 0x19,
+	0x3b, 0x80, 0x12, 0x04, 0xa4, 0xcf, 0x47, 0xe1, 0xf9, 0x8d, 0xba, 0x8e, 0x5d, 0x52, 0xf2, 0x0b,
+	0xea, 0xb1, 0xc9, 0x5a, 0x85, 0x29, 0x8b, 0xec, 0xa8, 0xbc, 0x88, 0x73, 0xbe, 0xde, 0x6a, 0x7b,
+	0xe2, 0xf8, 0x2e, 0xae, 0x55, 0x8b, 0xd2, 0x9e, 0x48, 0x1a, 0x4c, 0x88, 0x45, 0x76, 0x98, 0x2b,
+	0xaf, 0x46, 0x63, 0xf1, 0xda, 0x70, 0x4c, 0x10, 0xc3, 0xa4, 0x4f, 0xd4, 0x08, 0x48, 0x3f, 0x00,
+	0x28, 0xac, 0x54, 0x09, 0x76, 0x4e, 0x06, 0x8e, 0x57, 0xda, 0xbf, 0xc5, 0x0f, 0x86, 0xc7, 0x30,
+	0xc3, 0x62, 0xe8, 0xf5, 0x14, 0x01, 0xe9, 0x47, 0x00, 0xc7, 0xd7, 0xf9, 0x99, 0x92, 0x1e, 0x3b,
+	0x80, 0xf7, 0x60, 0x32, 0xa8, 0xb9, 0xfe, 0xf6, 0x8b, 0xe4, 0xa2, 0x72, 0xa6, 0xe5, 0x89, 0x09,
+	0x5e, 0x74, 0x69, 0xdb, 0x13, 0xcf, 0x71, 0x66, 0x43, 0x25, 0x49, 0x49, 0xf0, 0x42, 0x4c, 0x8b,
+	0xc5, 0xe1, 0x51, 0x4c, 0xb1, 0x28, 0xba, 0x9d, 0x45, 0x40, 0xfa, 0x09, 0x40, 0x61, 0xc3, 0xaa,
+	0x77, 0x09, 0x5e, 0x47, 0x14, 0x47, 0xe6, 0xa2, 0xd7, 0x5d, 0x04, 0xa4, 0x5f, 0x63, 0x70, 0xae,
+	0x4c, 0xdc, 0x5b, 0x98, 0xde, 0xe1, 0xd7, 0x03, 0x46, 0xf0, 0x7e, 0x40, 0x0b, 0x07, 0x02, 0x92,
+	0xc7, 0xdb, 0x9e, 0x98, 0xe6, 0xae, 0xb0, 0x69, 0x29, 0x0c, 0xf1, 0x6a, 0x9f, 0x10, 0xe5, 0xe9,
+	0xb6, 0x27, 0x0a, 0x5c, 0xbb, 0x43, 0x28, 0x1d, 0x0c, 0x1d, 0x43, 0x21, 0xcc, 0x2b, 0x15, 0xf3,
+	0x7c, 0x23, 0x1c, 0x84, 0x94, 0xbc, 0xdc, 0xf2, 0xc4, 0x89, 0xd0, 0xa9, 0x52, 0x28, 0x6c, 0x7b,
+	0xe2, 0x6c, 0x08, 0x47, 0xf7, 0x42, 0x49, 0x99, 0xd0, 0xba, 0xf5, 0x85, 0x9b, 0x70, 0x1c, 0x57,
+	0xab, 0xf6, 0x0e, 0xd1, 0xd5, 0x1a, 0xaf, 0x93, 0x14, 0x45, 0xd9, 0x0b, 0xe6, 0xda, 0x9e, 0x38,
+	0xc3, 0x6d, 0x75, 0x6b, 0x48, 0xca, 0xb9, 0x60, 0x2a, 0xa8, 0xad, 0x54, 0xb8, 0x06, 0xcf, 0x90,
+	0x66, 0xdd, 0x74, 0x76, 0xd5, 0x2d, 0x62, 0x1a, 0x5b, 0x6e, 0xf0, 0x95, 0x47, 0x6d, 0x4f, 0x9c,
+	0xe4, 0x46, 0x0e, 0x88, 0x25, 0x25, 0xcd, 0xc7, 0xb7, 0xd9, 0x50, 0x28, 0xc3, 0xa9, 0x1a, 0x6e,
+	0xaa, 0x06, 0xa6, 0xfe, 0x8d, 0x4d, 0x25, 0xac, 0xe6, 0xf9, 0x68, 0xc5, 0x99, 0x99, 0x6c, 0xdb,
+	0x13, 0xe7, 0xb9, 0x99, 0xbe, 0x6a, 0x92, 0x22, 0xd4, 0x70, 0xf3, 0x16, 0xa6, 0xeb, 0xc4, 0xb9,
+	0x11, 0x4e, 0x0a, 0xb7, 0xe0, 0x44, 0xa7, 0x76, 0xa5, 0x6a, 0x6b, 0xf7, 0xd8, 0x99, 0x31, 0x2a,
+	0xcf, 0xb7, 0x3d, 0x11, 0xf5, 0x1a, 0x64, 0x2a, 0x92, 0x72, 0x76, 0xcf, 0x98, 0xec, 0x4f, 0x08,
+	0x9b, 0x70, 0xbe, 0x53, 0x8b, 0x12, 0x4b, 0x27, 0x4e, 0x87, 0xcd, 0x24, 0xb3, 0xf9, 0xff, 0xb6,
+	0x27, 0x5e, 0xea, 0xb5, 0xd9, 0xad, 0x2d, 0x29, 0x33, 0x7b, 0xe6, 0xcb, 0x4c, 0xb6, 0xf7, 0x9e,
+	0xeb, 0x30, 0x41, 0xeb, 0xb6, 0x45, 0x6d, 0x87, 0x5f, 0x23, 0xe5, 0xc5, 0xb6, 0x27, 0x9e, 0xe5,
+	0x26, 0x03, 0xc1, 0xe0, 0xe2, 0x1b, 0x2e, 0x2d, 0x66, 0x0e, 0xcf, 0x7a, 0xe9, 0xd3, 0x51, 0x78,
+	0x71, 0xc3, 0xa2, 0xff, 0xf2, 0xcc, 0x1e, 0x0a, 0xc3, 0x97, 0x00, 0x0a, 0x9d, 0x37, 0x7f, 0xfe,
+	0x4d, 0xe9, 0x3c, 0xa8, 0x82, 0x81, 0x07, 0xd5, 0x8f, 0x07, 0x36, 0x19, 0x46, 0x8f, 0xd2, 0x64,
+	0x90, 0x53, 0xfe, 0x59, 0x82, 0x1f, 0x07, 0xfa, 0xf7, 0x1b, 0xa4, 0x6f, 0x47, 0xa1, 0xc8, 0x3d,
+	0x3a, 0x78, 0xc4, 0xdf, 0x34, 0x8d, 0x53, 0xa4, 0x49, 0x83, 0x53, 0x98, 0xf9, 0xad, 0x6a, 0xec,
+	0xd5, 0x6a, 0x83, 0xb9, 0xc4, 0x99, 0x1a, 0x5b, 0xbe, 0x7c, 0x78, 0x98, 0xdc, 0xff, 0xce, 0x60,
+	0xcf, 0xe3, 0x1e, 0x31, 0x2d, 0xae, 0x0e, 0xaf, 0xd2, 0x97, 0x3b, 0xbe, 0xfa, 0x03, 0xf1, 0x40,
+	0x40, 0xfa, 0x3e, 0x06, 0x2f, 0xb1, 0x96, 0x48, 0xc9, 0xd2, 0xdf, 0x88, 0x16, 0xc8, 0xc9, 0x77,
+	0xb1, 0x62, 0x27, 0xd7, 0xc5, 0x8a, 0x77, 0x77, 0xb1, 0x26, 0xc3, 0x1b, 0x7c, 0x82, 0xc3, 0xc2,
+	0x06, 0xfb, 0x57, 0xf0, 0x64, 0x9f, 0x2b, 0x78, 0xea, 0x18, 0x47, 0x6e, 0x78, 0xca, 0x57, 0xf0,
+	0xfd, 0x26, 0xdd, 0xd8, 0xa0, 0x26, 0x5d, 0xfa, 0x90, 0x26, 0xdd, 0x99, 0xae, 0x26, 0xdd, 0xda,
+	0xf0, 0xbc, 0xcd, 0xed, 0x37, 0xe9, 0x0e, 0xcf, 0x48, 0x04, 0xe4, 0x3b, 0x8f, 0x9f, 0x65, 0x46,
+	0x9e, 0x3e, 0xcb, 0x8c, 0x3c, 0x68, 0x65, 0xc0, 0xe3, 0x56, 0x06, 0x3c, 0x69, 0x65, 0xc0, 0xef,
+	0xad, 0x0c, 0xf8, 0xe2, 0x79, 0x66, 0xe4, 0xc9, 0xf3, 0xcc, 0xc8, 0xd3, 0xe7, 0x99, 0x91, 0x8f,
+	0x16, 0x3a, 0xa0, 0x58, 0xb1, 0x69, 0xed, 0x6e, 0xd8, 0xd1, 0xd5, 0x0b, 0x4d, 0xf6, 0xcb, 0xe1,
+	0xa8, 0xc4, 0x59, 0x13, 0xf7, 0xed, 0xbf, 0x07, 0x00, 0xe2, 0xbb, 0x43, 0xfe, 0x82, 0x16, 0x00,
+	0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -1335,6 +1365,29 @@ func (this *SetGasLessContractsProposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.AllowedMessages) != len(that1.AllowedMessages) {
+		return false
+	}
+	for i := range this.AllowedMessages {
+		if this.AllowedMessages[i] != that1.AllowedMessages[i] {
+			return false
+		}
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if this.MaxGasPerExecution != that1.MaxGasPerExecution {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
+	if this.MaxGasPerSenderPerBlock != that1.MaxGasPerSenderPerBlock {
+		return false
+	}
+	if this.Sponsor != that1.Sponsor {
+		return false
+	}
 	return true
 }
 func (this *UnsetGasLessContractsProposal) Equal(that interface{}) bool {
@@ -2182,6 +2235,42 @@ func (m *SetGasLessContractsProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintProposalLegacy(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxGasPerSenderPerBlock != 0 {
+		i = encodeVarintProposalLegacy(dAtA, i, uint64(m.MaxGasPerSenderPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintProposalLegacy(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxGasPerExecution != 0 {
+		i = encodeVarintProposalLegacy(dAtA, i, uint64(m.MaxGasPerExecution))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintProposalLegacy(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintProposalLegacy(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ContractAddresses) > 0 {
 		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ContractAddresses[iNdEx])
@@ -2820,6 +2909,28 @@ func (m *SetGasLessContractsProposal) Size() (n int) {
 			n += 1 + l + sovProposalLegacy(uint64(l))
 		}
 	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovProposalLegacy(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovProposalLegacy(uint64(m.ExpiryHeight))
+	}
+	if m.MaxGasPerExecution != 0 {
+		n += 1 + sovProposalLegacy(uint64(m.MaxGasPerExecution))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovProposalLegacy(uint64(m.MaxGasPerBlock))
+	}
+	if m.MaxGasPerSenderPerBlock != 0 {
+		n += 1 + sovProposalLegacy(uint64(m.MaxGasPerSenderPerBlock))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovProposalLegacy(uint64(l))
+	}
 	return n
 }
 
@@ -5387,6 +5498,146 @@ func (m *SetGasLessContractsProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposalLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerExecution", wireType)
+			}
+			m.MaxGasPerExecution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerExecution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerSenderPerBlock", wireType)
+			}
+			m.MaxGasPerSenderPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerSenderPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposalLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposalLegacy(dAtA[iNdEx:])
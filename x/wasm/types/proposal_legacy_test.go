@@ -774,6 +774,78 @@ func TestValidateClearAdminProposal(t *testing.T) {
 	}
 }
 
+func TestValidateSetGasLessContractsProposal(t *testing.T) {
+	contract := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	specs := map[string]struct {
+		src    *SetGasLessContractsProposal
+		expErr bool
+	}{
+		"all good": {
+			src: NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{}),
+		},
+		"with allowed messages and expiry": {
+			src: NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{AllowedMessages: []string{"claim", "stake"}, ExpiryHeight: 100}),
+		},
+		"with gas limits and sponsor": {
+			src: NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{
+				MaxGasPerExecution: 1_000, MaxGasPerBlock: 10_000, MaxGasPerSenderPerBlock: 5_000, Sponsor: contract,
+			}),
+		},
+		"base data missing": {
+			src:    NewSetGaslessContractsProposal("", "Bar", []string{contract}, GaslessConfig{}),
+			expErr: true,
+		},
+		"contracts missing": {
+			src:    NewSetGaslessContractsProposal("Foo", "Bar", nil, GaslessConfig{}),
+			expErr: true,
+		},
+		"empty allowed message": {
+			src:    NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{AllowedMessages: []string{""}}),
+			expErr: true,
+		},
+		"duplicate allowed messages": {
+			src:    NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{AllowedMessages: []string{"claim", "claim"}}),
+			expErr: true,
+		},
+		"block limit below execution limit": {
+			src:    NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{MaxGasPerExecution: 1_000, MaxGasPerBlock: 10}),
+			expErr: true,
+		},
+		"invalid sponsor": {
+			src:    NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{Sponsor: "foo"}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetGasLessContractsProposalGaslessConfig(t *testing.T) {
+	contract := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	config := GaslessConfig{
+		MaxGasPerExecution:      1_000,
+		MaxGasPerBlock:          10_000,
+		MaxGasPerSenderPerBlock: 5_000,
+		Sponsor:                 contract,
+		AllowedMessages:         []string{"claim"},
+		ExpiryHeight:            100,
+	}
+	assert.Equal(t, config, NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, config).GaslessConfig())
+
+	// the gas limits left unset are the default ones
+	exp := DefaultGaslessConfig()
+	exp.MaxGasPerSenderPerBlock = 5_000
+	assert.Equal(t, exp, NewSetGaslessContractsProposal("Foo", "Bar", []string{contract}, GaslessConfig{MaxGasPerSenderPerBlock: 5_000}).GaslessConfig())
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src v1beta1.Content
@@ -883,6 +955,23 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Codes:       [3 2 1]
+`,
+		},
+		"set gasless contracts": {
+			src: NewSetGaslessContractsProposal("Foo", "Bar", []string{"cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"}, GaslessConfig{
+				AllowedMessages: []string{"claim"}, ExpiryHeight: 100, MaxGasPerExecution: 1_000, MaxGasPerBlock: 10_000, MaxGasPerSenderPerBlock: 5_000,
+				Sponsor: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			}),
+			exp: `Set Gasless Contracts Proposal:
+  Title:       Foo
+  Description: Bar
+  ContractAddresses:       [cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr]
+  AllowedMessages:       [claim]
+  ExpiryHeight:       100
+  MaxGasPerExecution:       1000
+  MaxGasPerBlock:       10000
+  MaxGasPerSenderPerBlock:       5000
+  Sponsor:       cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du
 `,
 		},
 	}
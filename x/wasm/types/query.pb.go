@@ -202,6 +202,7 @@ var xxx_messageInfo_QueryContractHistoryResponse proto.InternalMessageInfo
 // RPC method
 type QueryContractsByCodeRequest struct {
 	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// grpc-gateway_out does not support Go style CodeID
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -646,7 +647,8 @@ var xxx_messageInfo_QueryCodeInfoResponse proto.InternalMessageInfo
 
 // CodeInfoResponse contains code meta data from CodeInfo
 type CodeInfoResponse struct {
-	CodeID                uint64                                           `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"id"`
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"id"`
+	// id for legacy support
 	Creator               string                                           `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	DataHash              github_com_cometbft_cometbft_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=data_hash,json=dataHash,proto3,casttype=github.com/cometbft/cometbft/libs/bytes.HexBytes" json:"data_hash,omitempty"`
 	InstantiatePermission AccessConfig                                     `protobuf:"bytes,6,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission"`
@@ -965,6 +967,89 @@ func (m *QueryGaslessContractsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGaslessContractsResponse proto.InternalMessageInfo
 
+// QueryGaslessGasUsageRequest is the request type for the
+// Query/GaslessGasUsage RPC method
+type QueryGaslessGasUsageRequest struct {
+	// address is the address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGaslessGasUsageRequest) Reset()         { *m = QueryGaslessGasUsageRequest{} }
+func (m *QueryGaslessGasUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaslessGasUsageRequest) ProtoMessage()    {}
+func (*QueryGaslessGasUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{23}
+}
+func (m *QueryGaslessGasUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaslessGasUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaslessGasUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaslessGasUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaslessGasUsageRequest.Merge(m, src)
+}
+func (m *QueryGaslessGasUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaslessGasUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaslessGasUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaslessGasUsageRequest proto.InternalMessageInfo
+
+// QueryGaslessGasUsageResponse is the response type for the
+// Query/GaslessGasUsage RPC method
+type QueryGaslessGasUsageResponse struct {
+	Usages []GaslessGasUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGaslessGasUsageResponse) Reset()         { *m = QueryGaslessGasUsageResponse{} }
+func (m *QueryGaslessGasUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaslessGasUsageResponse) ProtoMessage()    {}
+func (*QueryGaslessGasUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9677c207036b9f2b, []int{24}
+}
+func (m *QueryGaslessGasUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaslessGasUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaslessGasUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaslessGasUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaslessGasUsageResponse.Merge(m, src)
+}
+func (m *QueryGaslessGasUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaslessGasUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaslessGasUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaslessGasUsageResponse proto.InternalMessageInfo
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -973,7 +1058,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{23}
+	return fileDescriptor_9677c207036b9f2b, []int{25}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1097,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{24}
+	return fileDescriptor_9677c207036b9f2b, []int{26}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1139,7 @@ func (m *QueryContractsByCreatorRequest) Reset()         { *m = QueryContractsBy
 func (m *QueryContractsByCreatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByCreatorRequest) ProtoMessage()    {}
 func (*QueryContractsByCreatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{25}
+	return fileDescriptor_9677c207036b9f2b, []int{27}
 }
 func (m *QueryContractsByCreatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1181,7 @@ func (m *QueryContractsByCreatorResponse) Reset()         { *m = QueryContractsB
 func (m *QueryContractsByCreatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByCreatorResponse) ProtoMessage()    {}
 func (*QueryContractsByCreatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{26}
+	return fileDescriptor_9677c207036b9f2b, []int{28}
 }
 func (m *QueryContractsByCreatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1228,7 @@ func (m *QueryBuildAddressRequest) Reset()         { *m = QueryBuildAddressReque
 func (m *QueryBuildAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBuildAddressRequest) ProtoMessage()    {}
 func (*QueryBuildAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{27}
+	return fileDescriptor_9677c207036b9f2b, []int{29}
 }
 func (m *QueryBuildAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1268,7 @@ func (m *QueryBuildAddressResponse) Reset()         { *m = QueryBuildAddressResp
 func (m *QueryBuildAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBuildAddressResponse) ProtoMessage()    {}
 func (*QueryBuildAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9677c207036b9f2b, []int{28}
+	return fileDescriptor_9677c207036b9f2b, []int{30}
 }
 func (m *QueryBuildAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1236,6 +1321,8 @@ func init() {
 	proto.RegisterType((*QueryPinnedCodesResponse)(nil), "cosmwasm.wasm.v1.QueryPinnedCodesResponse")
 	proto.RegisterType((*QueryGaslessContractsRequest)(nil), "cosmwasm.wasm.v1.QueryGaslessContractsRequest")
 	proto.RegisterType((*QueryGaslessContractsResponse)(nil), "cosmwasm.wasm.v1.QueryGaslessContractsResponse")
+	proto.RegisterType((*QueryGaslessGasUsageRequest)(nil), "cosmwasm.wasm.v1.QueryGaslessGasUsageRequest")
+	proto.RegisterType((*QueryGaslessGasUsageResponse)(nil), "cosmwasm.wasm.v1.QueryGaslessGasUsageResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmwasm.wasm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmwasm.wasm.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryContractsByCreatorRequest)(nil), "cosmwasm.wasm.v1.QueryContractsByCreatorRequest")
@@ -1247,111 +1334,114 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/query.proto", fileDescriptor_9677c207036b9f2b) }
 
 var fileDescriptor_9677c207036b9f2b = []byte{
-	// 1657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xa4, 0x8e, 0xe3, 0x4c, 0xf2, 0xfd, 0xd6, 0x99, 0x6f, 0xda, 0xba, 0x6e, 0x6b, 0xe7,
-	0xbb, 0x6d, 0x93, 0xd4, 0x6d, 0xbc, 0x4d, 0x4a, 0xa9, 0x5a, 0x0e, 0xc8, 0x4e, 0x4b, 0xd3, 0x8a,
-	0xd2, 0x74, 0x2b, 0x81, 0x04, 0x42, 0x66, 0xbc, 0x9e, 0x38, 0x0b, 0xf6, 0xae, 0xbb, 0xb3, 0x69,
-	0x1a, 0x45, 0xe9, 0xa1, 0x27, 0x24, 0x0e, 0x80, 0x38, 0x51, 0x10, 0x70, 0xe0, 0x50, 0x28, 0xa0,
-	0x4a, 0x20, 0x51, 0x21, 0x71, 0xcf, 0xb1, 0x82, 0x0b, 0x27, 0x0b, 0x5c, 0xa4, 0xa2, 0xfe, 0x09,
-	0x3d, 0xa1, 0x9d, 0x9d, 0xf1, 0xae, 0x7f, 0x8c, 0xed, 0xa6, 0x46, 0xe2, 0x62, 0xad, 0x77, 0xde,
-	0x8f, 0xcf, 0x7c, 0xe6, 0xbd, 0x79, 0xef, 0xd9, 0x70, 0xbf, 0x6e, 0xd1, 0xf2, 0x1a, 0xa6, 0x65,
-	0x95, 0x7d, 0x5c, 0x9f, 0x53, 0xaf, 0xad, 0x12, 0x7b, 0x3d, 0x5d, 0xb1, 0x2d, 0xc7, 0x42, 0x51,
-	0xb1, 0x9a, 0x66, 0x1f, 0xd7, 0xe7, 0xe2, 0x13, 0x45, 0xab, 0x68, 0xb1, 0x45, 0xd5, 0x7d, 0xf2,
-	0xe4, 0xe2, 0xad, 0x56, 0x9c, 0xf5, 0x0a, 0xa1, 0x62, 0xb5, 0x68, 0x59, 0xc5, 0x12, 0x51, 0x71,
-	0xc5, 0x50, 0xb1, 0x69, 0x5a, 0x0e, 0x76, 0x0c, 0xcb, 0x14, 0xab, 0x29, 0x57, 0xd7, 0xa2, 0x6a,
-	0x1e, 0x53, 0xe2, 0x39, 0x57, 0xaf, 0xcf, 0xe5, 0x89, 0x83, 0xe7, 0xd4, 0x0a, 0x2e, 0x1a, 0x26,
-	0x13, 0xe6, 0xb2, 0xfb, 0xb8, 0xac, 0x10, 0x0b, 0x82, 0x8d, 0x8f, 0xe3, 0xb2, 0x61, 0x5a, 0x2a,
-	0xfb, 0xe4, 0xaf, 0xf6, 0x7a, 0xf2, 0x39, 0x0f, 0xb0, 0xf7, 0xc5, 0x5b, 0x52, 0x5e, 0x81, 0xb1,
-	0x2b, 0xae, 0xf2, 0x82, 0x65, 0x3a, 0x36, 0xd6, 0x9d, 0x0b, 0xe6, 0xb2, 0xa5, 0x91, 0x6b, 0xab,
-	0x84, 0x3a, 0x68, 0x1e, 0x0e, 0xe3, 0x42, 0xc1, 0x26, 0x94, 0xc6, 0xc0, 0x24, 0x98, 0x19, 0xc9,
-	0xc6, 0x7e, 0xf9, 0x61, 0x76, 0x82, 0xab, 0x67, 0xbc, 0x95, 0xab, 0x8e, 0x6d, 0x98, 0x45, 0x4d,
-	0x08, 0x2a, 0xdf, 0x02, 0xb8, 0xb7, 0x8d, 0x41, 0x5a, 0xb1, 0x4c, 0x4a, 0xb6, 0x63, 0x11, 0xbd,
-	0x0a, 0xff, 0xa3, 0x73, 0x5b, 0x39, 0xc3, 0x5c, 0xb6, 0x62, 0x83, 0x93, 0x60, 0x66, 0x74, 0x3e,
-	0x91, 0x6e, 0x3e, 0x94, 0x74, 0xd0, 0x65, 0x76, 0x7c, 0xab, 0x9a, 0x1c, 0x78, 0x50, 0x4d, 0x82,
-	0xc7, 0xd5, 0xe4, 0xc0, 0x9d, 0x47, 0xf7, 0x52, 0x40, 0x1b, 0xd3, 0x03, 0x02, 0x67, 0x42, 0x7f,
-	0x7d, 0x91, 0x04, 0xca, 0xc7, 0x00, 0xee, 0x6b, 0xc0, 0xbb, 0x68, 0x50, 0xc7, 0xb2, 0xd7, 0x9f,
-	0x81, 0x03, 0xf4, 0x12, 0x84, 0xfe, 0x91, 0x71, 0xb8, 0x53, 0x69, 0xae, 0xe3, 0x9e, 0x6f, 0xda,
-	0x3b, 0x2f, 0x7e, 0xbe, 0xe9, 0x25, 0x5c, 0x24, 0xdc, 0x9f, 0x16, 0xd0, 0x54, 0xee, 0x03, 0xb8,
-	0xbf, 0x3d, 0x36, 0x4e, 0xe7, 0x65, 0x38, 0x4c, 0x4c, 0xc7, 0x36, 0x88, 0x0b, 0x6e, 0xc7, 0xcc,
-	0xe8, 0x7c, 0x4a, 0x4e, 0xca, 0x82, 0x55, 0x20, 0x5c, 0xff, 0x9c, 0xe9, 0xd8, 0xeb, 0xd9, 0x91,
-	0xad, 0x3a, 0x31, 0xc2, 0x0a, 0x3a, 0xdf, 0x06, 0xf9, 0x74, 0x57, 0xe4, 0x1e, 0x9a, 0x06, 0xe8,
-	0x37, 0x9b, 0x58, 0xa5, 0xd9, 0x75, 0x17, 0x80, 0x60, 0x75, 0x0f, 0x1c, 0xd6, 0xad, 0x02, 0xc9,
-	0x19, 0x05, 0xc6, 0x6a, 0x48, 0x0b, 0xbb, 0x5f, 0x2f, 0x14, 0xfa, 0x46, 0xdd, 0xe7, 0xcd, 0xd4,
-	0xd5, 0x01, 0x70, 0xea, 0x9e, 0x87, 0x23, 0x22, 0x1a, 0x3c, 0xf2, 0x3a, 0x9d, 0xac, 0x2f, 0xda,
-	0x3f, 0x86, 0x6e, 0x0b, 0x84, 0x99, 0x52, 0x49, 0x80, 0xbc, 0xea, 0x60, 0x87, 0xfc, 0x1b, 0x22,
-	0xef, 0x4b, 0x00, 0x0f, 0x48, 0xc0, 0x71, 0xfe, 0xce, 0xc0, 0x70, 0xd9, 0x2a, 0x90, 0x92, 0x88,
-	0xbc, 0x3d, 0xad, 0x91, 0x77, 0xc9, 0x5d, 0x0f, 0x86, 0x19, 0xd7, 0xe8, 0x1f, 0x87, 0xd7, 0x38,
-	0x85, 0x1a, 0x5e, 0xeb, 0x1b, 0x85, 0x07, 0x20, 0x64, 0xde, 0x73, 0x05, 0xec, 0x60, 0x06, 0x6e,
-	0x4c, 0x1b, 0x61, 0x6f, 0xce, 0x62, 0x07, 0x2b, 0x27, 0x38, 0x31, 0xad, 0x2e, 0x39, 0x31, 0x08,
-	0x86, 0x98, 0x26, 0x60, 0x9a, 0xec, 0x59, 0xf9, 0x04, 0xc0, 0x04, 0xd3, 0xba, 0x5a, 0xc6, 0xb6,
-	0xd3, 0x37, 0xa8, 0xe7, 0x5a, 0xa1, 0x66, 0xa7, 0x9e, 0x54, 0x93, 0x28, 0x00, 0xee, 0x12, 0xa1,
-	0x14, 0x17, 0xc9, 0xed, 0x47, 0xf7, 0x52, 0xa3, 0x86, 0x59, 0x32, 0x4c, 0x92, 0x7b, 0x9b, 0x5a,
-	0x66, 0x70, 0x4b, 0x6f, 0xc2, 0xa4, 0x14, 0x5c, 0xfd, 0xb4, 0x03, 0x9b, 0xea, 0xd9, 0x87, 0xb7,
-	0xf9, 0xa3, 0x30, 0xca, 0x33, 0xb1, 0x7b, 0xfe, 0x2b, 0x2a, 0x9c, 0xa8, 0x0b, 0x07, 0x4b, 0x91,
-	0x54, 0xe1, 0xeb, 0x41, 0xb8, 0xab, 0x49, 0x83, 0x63, 0x3e, 0xd8, 0xa4, 0x92, 0x85, 0xb5, 0x6a,
-	0x32, 0xcc, 0xc4, 0xce, 0xd6, 0xef, 0x9b, 0x79, 0x38, 0xac, 0xdb, 0x04, 0x3b, 0x96, 0xcd, 0xf8,
-	0xeb, 0x48, 0x3b, 0x17, 0x44, 0x4b, 0x30, 0xa2, 0xaf, 0x10, 0xfd, 0x1d, 0xba, 0x5a, 0x8e, 0xed,
-	0x60, 0x84, 0x3c, 0xf7, 0xa4, 0x9a, 0x3c, 0x5e, 0x34, 0x9c, 0x95, 0xd5, 0x7c, 0x5a, 0xb7, 0xca,
-	0xaa, 0x6e, 0x95, 0x89, 0x93, 0x5f, 0x76, 0xfc, 0x87, 0x92, 0x91, 0xa7, 0x6a, 0x7e, 0xdd, 0x21,
-	0x34, 0xbd, 0x48, 0x6e, 0x64, 0xdd, 0x07, 0xad, 0x6e, 0x05, 0xbd, 0x05, 0x77, 0x1b, 0x26, 0x75,
-	0xb0, 0xe9, 0x18, 0xd8, 0x21, 0xb9, 0x0a, 0xb1, 0xcb, 0x06, 0xa5, 0x6e, 0x72, 0x84, 0x64, 0xb5,
-	0x2e, 0xa3, 0xeb, 0x84, 0xd2, 0x05, 0xcb, 0x5c, 0x36, 0x8a, 0xc1, 0x1c, 0xdb, 0x15, 0x30, 0xb4,
-	0x54, 0xb7, 0xc3, 0x8b, 0xdd, 0xfd, 0x41, 0x18, 0x6d, 0xe1, 0xe9, 0x48, 0x33, 0x4f, 0x51, 0x9f,
-	0xa7, 0xc7, 0xd5, 0xe4, 0xa0, 0x51, 0x78, 0x26, 0xb6, 0xae, 0xc0, 0x11, 0x37, 0x0c, 0x72, 0x2b,
-	0x98, 0xae, 0x3c, 0x1b, 0x5d, 0xae, 0x99, 0x45, 0x4c, 0x57, 0x3a, 0xd0, 0x15, 0xee, 0x27, 0x5d,
-	0x17, 0x43, 0x91, 0x50, 0x74, 0xe8, 0x62, 0x28, 0x32, 0x14, 0x0d, 0x2b, 0xb7, 0x00, 0x1c, 0x0f,
-	0x84, 0x31, 0xe7, 0xee, 0x82, 0x5b, 0x45, 0x5c, 0xee, 0xdc, 0xbe, 0x04, 0x30, 0xe7, 0x4a, 0xbb,
-	0x12, 0xdc, 0x48, 0x79, 0x36, 0x22, 0xfa, 0x12, 0x2d, 0xa2, 0xf3, 0x35, 0xb4, 0x9f, 0xa7, 0x98,
-	0x97, 0xc6, 0x91, 0xc7, 0xd5, 0x24, 0xfb, 0xee, 0x25, 0x11, 0x3f, 0xbf, 0x37, 0x02, 0x18, 0xa8,
-	0x48, 0x8d, 0xc6, 0x3b, 0x1f, 0x6c, 0xfb, 0xce, 0xbf, 0x0b, 0x20, 0x0a, 0x5a, 0xe7, 0x5b, 0x7c,
-	0x19, 0xc2, 0xfa, 0x16, 0xc5, 0x65, 0xdf, 0xcb, 0x1e, 0x03, 0x24, 0x8f, 0x88, 0x4d, 0xf6, 0xf1,
-	0xea, 0xc7, 0x70, 0x0f, 0x03, 0xbb, 0x64, 0x98, 0x26, 0x29, 0x74, 0x20, 0x64, 0xfb, 0x45, 0xf0,
-	0x3d, 0xc0, 0x7b, 0xe3, 0x06, 0x1f, 0x9c, 0x96, 0x29, 0x18, 0xe1, 0x59, 0xe3, 0x91, 0x12, 0xca,
-	0x8e, 0xd6, 0xaa, 0xc9, 0x61, 0x2f, 0x6d, 0xa8, 0x36, 0xec, 0x65, 0x4c, 0x1f, 0x37, 0xbc, 0xcc,
-	0x6b, 0xdd, 0x79, 0x4c, 0x4b, 0x5e, 0x28, 0x7b, 0x1d, 0x49, 0xbf, 0x77, 0xfd, 0x9d, 0x28, 0xfd,
-	0xad, 0x8e, 0xf8, 0xd6, 0xcf, 0x42, 0x54, 0x6f, 0xc8, 0x79, 0x29, 0x22, 0xa2, 0x87, 0xda, 0x55,
-	0xab, 0x26, 0xc7, 0x85, 0x4a, 0x46, 0x2c, 0x6a, 0xe3, 0x7a, 0xf3, 0xab, 0xfe, 0x11, 0x33, 0xc1,
-	0xc3, 0x76, 0x09, 0xdb, 0xb8, 0x2c, 0xe8, 0x50, 0x34, 0xf8, 0xbf, 0x86, 0xb7, 0x1c, 0xfb, 0x0b,
-	0x30, 0x5c, 0x61, 0x6f, 0x78, 0xa2, 0xc4, 0x5a, 0x23, 0xd9, 0xd3, 0x68, 0xe8, 0x5b, 0x3c, 0x15,
-	0x37, 0x43, 0x12, 0x2d, 0x4d, 0xa5, 0x77, 0xcd, 0x89, 0x53, 0xc8, 0xc0, 0x9d, 0xfc, 0xe2, 0xcb,
-	0xf5, 0x5a, 0xce, 0xff, 0xcb, 0x15, 0x32, 0x7d, 0xee, 0xe1, 0xbe, 0x07, 0xbc, 0xae, 0xb7, 0x43,
-	0xcb, 0xe9, 0x38, 0xdf, 0xe1, 0x28, 0xe5, 0x88, 0xff, 0xc9, 0xd3, 0xbc, 0x2b, 0x92, 0x2e, 0xbb,
-	0x6a, 0x94, 0x0a, 0xdc, 0x81, 0x60, 0x77, 0x1f, 0xbf, 0x6e, 0x59, 0x2d, 0x61, 0xbc, 0x7a, 0x17,
-	0x28, 0xab, 0x0a, 0x6d, 0xa8, 0x1f, 0x7c, 0x4a, 0xea, 0x11, 0x0c, 0x51, 0x5c, 0x72, 0x58, 0x99,
-	0x1a, 0xd1, 0xd8, 0xb3, 0xeb, 0xd3, 0x30, 0x0d, 0x27, 0x87, 0xed, 0x22, 0x65, 0xe5, 0x78, 0x4c,
-	0x8b, 0xb8, 0x2f, 0x32, 0x76, 0x91, 0x2a, 0x97, 0xf9, 0xb0, 0xdb, 0x08, 0x76, 0xfb, 0xc3, 0xee,
-	0xfc, 0xd6, 0x38, 0x1c, 0x62, 0x16, 0xd1, 0x6d, 0x00, 0xc7, 0x82, 0x03, 0x2d, 0x6a, 0x33, 0xdb,
-	0xc9, 0x26, 0xf7, 0xf8, 0xd1, 0x9e, 0x64, 0x3d, 0x9c, 0xca, 0xdc, 0xbb, 0x6e, 0x94, 0xdf, 0xfa,
-	0xf5, 0xcf, 0x8f, 0x06, 0xa7, 0xd0, 0x21, 0xb5, 0xe5, 0x37, 0x0c, 0x71, 0xda, 0xea, 0x06, 0x47,
-	0xb9, 0x89, 0xee, 0x02, 0xb8, 0xb3, 0x69, 0x28, 0x45, 0xb3, 0x5d, 0x7c, 0x36, 0x0e, 0xd6, 0xf1,
-	0x74, 0xaf, 0xe2, 0x1c, 0xe5, 0x69, 0x1f, 0x65, 0x1a, 0x1d, 0xeb, 0x05, 0xa5, 0xba, 0xc2, 0x91,
-	0x7d, 0x15, 0x40, 0xcb, 0xe7, 0xc0, 0xae, 0x68, 0x1b, 0x07, 0xd6, 0xae, 0x68, 0x9b, 0xc6, 0x4b,
-	0xe5, 0x94, 0x8f, 0xf6, 0x18, 0x4a, 0xb5, 0x43, 0x5b, 0x20, 0xea, 0x06, 0xaf, 0x20, 0x9b, 0xaa,
-	0x3f, 0x5f, 0x7e, 0x03, 0x60, 0xb4, 0x79, 0xe8, 0x42, 0x32, 0xef, 0x92, 0xd1, 0x31, 0xae, 0xf6,
-	0x2c, 0xdf, 0x33, 0xdc, 0x16, 0x72, 0x29, 0x43, 0xf6, 0x23, 0x80, 0xd1, 0xe6, 0x51, 0x48, 0x0a,
-	0x57, 0x32, 0xa6, 0x49, 0xe1, 0xca, 0x66, 0x2c, 0x25, 0xeb, 0xc3, 0x3d, 0x85, 0x4e, 0xf6, 0x04,
-	0xd7, 0xc6, 0x6b, 0xea, 0x86, 0x3f, 0x2d, 0x6d, 0xa2, 0x9f, 0x00, 0x44, 0xad, 0x13, 0x0f, 0x3a,
-	0x2e, 0xc1, 0x22, 0x9d, 0xdc, 0xe2, 0x73, 0x4f, 0xa1, 0xc1, 0xf1, 0xbf, 0xc8, 0xa0, 0x9f, 0x46,
-	0xa7, 0x7a, 0x63, 0xda, 0x35, 0xd4, 0x08, 0xfe, 0x26, 0x0c, 0xb1, 0x28, 0x56, 0xa4, 0x61, 0xe9,
-	0x87, 0xee, 0xc1, 0x8e, 0x32, 0x1c, 0xd1, 0xac, 0xcf, 0xa8, 0x82, 0x26, 0xbb, 0xc5, 0x2b, 0x5a,
-	0x83, 0x43, 0xac, 0x1d, 0x42, 0x9d, 0x8c, 0x8b, 0x6b, 0x3b, 0x7e, 0xa8, 0xb3, 0x10, 0x87, 0x70,
-	0xd0, 0x87, 0x10, 0x43, 0xbb, 0xdb, 0x43, 0x40, 0xef, 0x03, 0x18, 0x11, 0xad, 0x26, 0x9a, 0xea,
-	0x60, 0x37, 0x78, 0x1b, 0x4e, 0x77, 0x95, 0xe3, 0x10, 0xe6, 0x7d, 0x08, 0xd3, 0xe8, 0x70, 0x7b,
-	0x08, 0xb3, 0x6e, 0x23, 0x1c, 0xa0, 0xe2, 0x43, 0x00, 0x47, 0x03, 0x0d, 0x22, 0x3a, 0x22, 0x71,
-	0xd6, 0xda, 0xa8, 0xc6, 0x53, 0xbd, 0x88, 0x72, 0x68, 0x47, 0x7d, 0x68, 0x93, 0x28, 0xd1, 0x1e,
-	0x1a, 0x55, 0x2b, 0x4c, 0x13, 0x7d, 0x0a, 0x60, 0xb4, 0xb9, 0x7d, 0x93, 0x66, 0xa5, 0xa4, 0xa1,
-	0x94, 0x66, 0xa5, 0xac, 0x2f, 0x54, 0xa6, 0x19, 0xba, 0xff, 0xa3, 0xa4, 0x0c, 0x5d, 0xd1, 0xd3,
-	0x44, 0xb7, 0x00, 0x0c, 0x7b, 0x5d, 0x16, 0x92, 0x85, 0x46, 0x43, 0x33, 0x17, 0x3f, 0xdc, 0x45,
-	0xea, 0xe9, 0x38, 0xf2, 0x3c, 0xff, 0x0c, 0x20, 0x6a, 0xed, 0x8c, 0xa4, 0xf9, 0x2f, 0x6d, 0xf9,
-	0xa4, 0xf9, 0x2f, 0x6f, 0xbb, 0x7a, 0xbe, 0xbf, 0xa8, 0xca, 0x1b, 0x14, 0x75, 0xa3, 0xa9, 0xb5,
-	0xd9, 0x44, 0x9f, 0x01, 0x38, 0x16, 0x6c, 0x3b, 0xa4, 0xfd, 0x41, 0x9b, 0x46, 0x4a, 0xda, 0x1f,
-	0xb4, 0xeb, 0x63, 0x94, 0x93, 0x3e, 0xda, 0x14, 0x9a, 0xe9, 0x70, 0x65, 0xe5, 0x5d, 0x6d, 0x81,
-	0x30, 0xbb, 0xb8, 0xf5, 0x47, 0x62, 0xe0, 0x4e, 0x2d, 0x31, 0xb0, 0x55, 0x4b, 0x80, 0x07, 0xb5,
-	0x04, 0xf8, 0xbd, 0x96, 0x00, 0x1f, 0x3c, 0x4c, 0x0c, 0x3c, 0x78, 0x98, 0x18, 0xf8, 0xed, 0x61,
-	0x62, 0xe0, 0xf5, 0xa9, 0xc0, 0x6f, 0x00, 0x0b, 0x16, 0x2d, 0xbf, 0x26, 0xac, 0x16, 0xd4, 0x1b,
-	0x9e, 0x75, 0xf6, 0xf7, 0x49, 0x3e, 0xcc, 0xfe, 0xaa, 0x38, 0xf1, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xbe, 0x26, 0x73, 0xa0, 0xa5, 0x19, 0x00, 0x00,
+	// 1711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xc7, 0x33, 0x69, 0xea, 0xc4, 0x4f, 0xf2, 0xbe, 0x4d, 0xe6, 0x4d, 0x5b, 0x77, 0x9b, 0xda,
+	0xa9, 0xdb, 0x26, 0x69, 0xd2, 0x78, 0x9b, 0xb4, 0x7d, 0xab, 0x96, 0x03, 0x8a, 0xd3, 0x92, 0x54,
+	0xa2, 0x34, 0x75, 0x05, 0x48, 0x20, 0x64, 0xc6, 0xf6, 0xc4, 0x59, 0xb0, 0x77, 0xdd, 0x9d, 0x4d,
+	0xd3, 0x28, 0x4a, 0x0f, 0x3d, 0x21, 0x71, 0x00, 0xc4, 0x89, 0x82, 0x80, 0x03, 0x87, 0x42, 0x5a,
+	0x54, 0x09, 0x24, 0x10, 0x12, 0xf7, 0x1c, 0x2b, 0xb8, 0x70, 0xb2, 0x20, 0x45, 0x2a, 0xea, 0x3f,
+	0x80, 0xd4, 0x13, 0xda, 0xd9, 0x19, 0xef, 0xfa, 0xc7, 0xda, 0x9b, 0xd4, 0x08, 0x2e, 0xd1, 0x7a,
+	0xe7, 0x79, 0x66, 0x3e, 0xf3, 0x9d, 0x79, 0xe6, 0x79, 0x66, 0x03, 0x43, 0x59, 0x83, 0x15, 0x57,
+	0x08, 0x2b, 0xaa, 0xfc, 0xcf, 0x8d, 0x29, 0xf5, 0xfa, 0x32, 0x35, 0x57, 0x13, 0x25, 0xd3, 0xb0,
+	0x0c, 0xdc, 0x2f, 0x5b, 0x13, 0xfc, 0xcf, 0x8d, 0x29, 0x65, 0x30, 0x6f, 0xe4, 0x0d, 0xde, 0xa8,
+	0xda, 0x4f, 0x8e, 0x9d, 0x52, 0xdf, 0x8b, 0xb5, 0x5a, 0xa2, 0x4c, 0xb6, 0xe6, 0x0d, 0x23, 0x5f,
+	0xa0, 0x2a, 0x29, 0x69, 0x2a, 0xd1, 0x75, 0xc3, 0x22, 0x96, 0x66, 0xe8, 0xb2, 0x75, 0xdc, 0xf6,
+	0x35, 0x98, 0x9a, 0x21, 0x8c, 0x3a, 0x83, 0xab, 0x37, 0xa6, 0x32, 0xd4, 0x22, 0x53, 0x6a, 0x89,
+	0xe4, 0x35, 0x9d, 0x1b, 0x0b, 0xdb, 0x83, 0xc2, 0x56, 0x9a, 0x79, 0x61, 0x95, 0x01, 0x52, 0xd4,
+	0x74, 0x43, 0xe5, 0x7f, 0xc5, 0xab, 0x03, 0x8e, 0x7d, 0xda, 0x01, 0x76, 0x7e, 0x38, 0x4d, 0xf1,
+	0x97, 0x20, 0x72, 0xd5, 0x76, 0x9e, 0x35, 0x74, 0xcb, 0x24, 0x59, 0xeb, 0x92, 0xbe, 0x68, 0xa4,
+	0xe8, 0xf5, 0x65, 0xca, 0x2c, 0x3c, 0x0d, 0xdd, 0x24, 0x97, 0x33, 0x29, 0x63, 0x11, 0x34, 0x8c,
+	0xc6, 0xc2, 0xc9, 0xc8, 0x4f, 0xdf, 0x4e, 0x0e, 0x0a, 0xf7, 0x19, 0xa7, 0xe5, 0x9a, 0x65, 0x6a,
+	0x7a, 0x3e, 0x25, 0x0d, 0xe3, 0xf7, 0x11, 0x1c, 0x68, 0xd0, 0x21, 0x2b, 0x19, 0x3a, 0xa3, 0x3b,
+	0xe9, 0x11, 0xbf, 0x02, 0xff, 0xc9, 0x8a, 0xbe, 0xd2, 0x9a, 0xbe, 0x68, 0x44, 0x3a, 0x87, 0xd1,
+	0x58, 0xef, 0x74, 0x34, 0x51, 0xbb, 0x28, 0x09, 0xef, 0x90, 0xc9, 0x81, 0xcd, 0x72, 0xac, 0xe3,
+	0x61, 0x39, 0x86, 0x9e, 0x94, 0x63, 0x1d, 0x77, 0x1f, 0x3f, 0x18, 0x47, 0xa9, 0xbe, 0xac, 0xc7,
+	0xe0, 0x7c, 0xd7, 0x1f, 0x9f, 0xc7, 0x50, 0xfc, 0x23, 0x04, 0x07, 0xab, 0x78, 0xe7, 0x35, 0x66,
+	0x19, 0xe6, 0xea, 0x33, 0x68, 0x80, 0x5f, 0x00, 0x70, 0x97, 0x4c, 0xe0, 0x8e, 0x24, 0x84, 0x8f,
+	0xbd, 0xbe, 0x09, 0x67, 0xbd, 0xc4, 0xfa, 0x26, 0x16, 0x48, 0x9e, 0x8a, 0xf1, 0x52, 0x1e, 0xcf,
+	0xf8, 0xf7, 0x08, 0x86, 0x1a, 0xb3, 0x09, 0x39, 0xaf, 0x40, 0x37, 0xd5, 0x2d, 0x53, 0xa3, 0x36,
+	0xdc, 0xae, 0xb1, 0xde, 0xe9, 0x71, 0x7f, 0x51, 0x66, 0x8d, 0x1c, 0x15, 0xfe, 0x17, 0x75, 0xcb,
+	0x5c, 0x4d, 0x86, 0x37, 0x2b, 0xc2, 0xc8, 0x5e, 0xf0, 0x5c, 0x03, 0xf2, 0xd1, 0x96, 0xe4, 0x0e,
+	0x4d, 0x15, 0xfa, 0xad, 0x1a, 0x55, 0x59, 0x72, 0xd5, 0x06, 0x90, 0xaa, 0xee, 0x87, 0xee, 0xac,
+	0x91, 0xa3, 0x69, 0x2d, 0xc7, 0x55, 0xed, 0x4a, 0x85, 0xec, 0x9f, 0x97, 0x72, 0x6d, 0x93, 0xee,
+	0xb3, 0x5a, 0xe9, 0x2a, 0x00, 0x42, 0xba, 0xff, 0x43, 0x58, 0xee, 0x06, 0x47, 0xbc, 0x66, 0x2b,
+	0xeb, 0x9a, 0xb6, 0x4f, 0xa1, 0x3b, 0x92, 0x70, 0xa6, 0x50, 0x90, 0x90, 0xd7, 0x2c, 0x62, 0xd1,
+	0x7f, 0xc3, 0xce, 0xfb, 0x02, 0xc1, 0x21, 0x1f, 0x38, 0xa1, 0xdf, 0x79, 0x08, 0x15, 0x8d, 0x1c,
+	0x2d, 0xc8, 0x9d, 0xb7, 0xbf, 0x7e, 0xe7, 0x5d, 0xb6, 0xdb, 0xbd, 0xdb, 0x4c, 0x78, 0xb4, 0x4f,
+	0xc3, 0xeb, 0x42, 0xc2, 0x14, 0x59, 0x69, 0x9b, 0x84, 0x87, 0x00, 0xf8, 0xe8, 0xe9, 0x1c, 0xb1,
+	0x08, 0x87, 0xeb, 0x4b, 0x85, 0xf9, 0x9b, 0x0b, 0xc4, 0x22, 0xf1, 0x53, 0x70, 0xc8, 0x67, 0x48,
+	0x21, 0x0c, 0x86, 0x2e, 0xee, 0x89, 0xb8, 0x27, 0x7f, 0x8e, 0x7f, 0x8c, 0x20, 0xca, 0xbd, 0xae,
+	0x15, 0x89, 0x69, 0xb5, 0x0d, 0xf5, 0x62, 0x3d, 0x6a, 0x72, 0xe4, 0x69, 0x39, 0x86, 0x3d, 0x70,
+	0x97, 0x29, 0x63, 0x24, 0x4f, 0xef, 0x3c, 0x7e, 0x30, 0xde, 0xab, 0xe9, 0x05, 0x4d, 0xa7, 0xe9,
+	0xb7, 0x98, 0xa1, 0x7b, 0xa7, 0xf4, 0x06, 0xc4, 0x7c, 0xe1, 0x2a, 0xab, 0xed, 0x99, 0x54, 0xe0,
+	0x31, 0x9c, 0xc9, 0x4f, 0x40, 0xbf, 0x88, 0xc4, 0xd6, 0xf1, 0x1f, 0x57, 0x61, 0xb0, 0x62, 0xec,
+	0x4d, 0x45, 0xbe, 0x0e, 0x5f, 0x75, 0xc2, 0xde, 0x1a, 0x0f, 0xc1, 0x7c, 0xa4, 0xc6, 0x25, 0x09,
+	0x5b, 0xe5, 0x58, 0x88, 0x9b, 0x5d, 0xa8, 0x9c, 0x37, 0xd3, 0xd0, 0x9d, 0x35, 0x29, 0xb1, 0x0c,
+	0x33, 0xd2, 0xd9, 0x4a, 0x76, 0x61, 0x88, 0x17, 0xa0, 0x27, 0xbb, 0x44, 0xb3, 0x6f, 0xb3, 0xe5,
+	0x62, 0x64, 0x17, 0x17, 0xe4, 0xf4, 0xd3, 0x72, 0xec, 0x64, 0x5e, 0xb3, 0x96, 0x96, 0x33, 0x89,
+	0xac, 0x51, 0x54, 0xb3, 0x46, 0x91, 0x5a, 0x99, 0x45, 0xcb, 0x7d, 0x28, 0x68, 0x19, 0xa6, 0x66,
+	0x56, 0x2d, 0xca, 0x12, 0xf3, 0xf4, 0x66, 0xd2, 0x7e, 0x48, 0x55, 0x7a, 0xc1, 0x6f, 0xc2, 0x3e,
+	0x4d, 0x67, 0x16, 0xd1, 0x2d, 0x8d, 0x58, 0x34, 0x5d, 0xa2, 0x66, 0x51, 0x63, 0xcc, 0x0e, 0x8e,
+	0x2e, 0xbf, 0x5c, 0x37, 0x93, 0xcd, 0x52, 0xc6, 0x66, 0x0d, 0x7d, 0x51, 0xcb, 0x7b, 0x63, 0x6c,
+	0xaf, 0xa7, 0xa3, 0x85, 0x4a, 0x3f, 0x22, 0xd9, 0xdd, 0xeb, 0x84, 0xfe, 0x3a, 0x9d, 0x8e, 0xd7,
+	0xea, 0xd4, 0xef, 0xea, 0xf4, 0xa4, 0x1c, 0xeb, 0xd4, 0x72, 0xcf, 0xa4, 0xd6, 0x55, 0x08, 0xdb,
+	0xdb, 0x20, 0xbd, 0x44, 0xd8, 0xd2, 0xb3, 0xc9, 0x65, 0x77, 0x33, 0x4f, 0xd8, 0x52, 0x13, 0xb9,
+	0x42, 0x6d, 0x95, 0xeb, 0x36, 0x82, 0x01, 0xcf, 0xd6, 0x15, 0x7a, 0x5d, 0x82, 0xb0, 0xa3, 0x97,
+	0x5d, 0x8b, 0x20, 0x3e, 0x60, 0xbc, 0x51, 0xda, 0xad, 0x96, 0x39, 0xd9, 0x23, 0x6b, 0x91, 0x54,
+	0x4f, 0x56, 0xb4, 0xe1, 0x21, 0x11, 0x56, 0x4e, 0xe8, 0xf6, 0x3c, 0x29, 0xc7, 0xf8, 0x6f, 0x27,
+	0x70, 0x04, 0xc4, 0xeb, 0x1e, 0x06, 0x26, 0xc3, 0xa1, 0xfa, 0x9c, 0x47, 0x3b, 0x3e, 0xe7, 0x37,
+	0x10, 0x60, 0x6f, 0xef, 0x62, 0x8a, 0x2f, 0x02, 0x54, 0xa6, 0x28, 0x0f, 0xf8, 0x20, 0x73, 0xf4,
+	0x08, 0x1b, 0x96, 0x93, 0x6c, 0xe3, 0x71, 0x4f, 0x60, 0x3f, 0x87, 0x5d, 0xd0, 0x74, 0x9d, 0xe6,
+	0x9a, 0x08, 0xb2, 0xf3, 0xc4, 0xf7, 0x2e, 0x82, 0x48, 0xfd, 0x18, 0x42, 0x96, 0x11, 0xe8, 0x11,
+	0x91, 0xe2, 0x88, 0xd2, 0x95, 0xec, 0xdd, 0x2a, 0xc7, 0xba, 0x9d, 0x50, 0x61, 0xa9, 0x6e, 0x27,
+	0x4a, 0xda, 0x38, 0xe1, 0x45, 0x91, 0xdf, 0xe6, 0x08, 0x2b, 0x38, 0xdb, 0xd7, 0xa9, 0x42, 0xda,
+	0x3d, 0xeb, 0xaf, 0x65, 0xba, 0xaf, 0x1f, 0x48, 0x4c, 0xfd, 0x02, 0xe0, 0x4a, 0x11, 0x2e, 0xd2,
+	0x0f, 0x95, 0x75, 0xd3, 0xde, 0xad, 0x72, 0x6c, 0x40, 0xba, 0xcc, 0xc8, 0xc6, 0xd4, 0x40, 0xb6,
+	0xf6, 0x55, 0xfb, 0x84, 0xa9, 0x54, 0xed, 0x02, 0x78, 0x8e, 0xb0, 0x97, 0x99, 0x3b, 0xb9, 0x7f,
+	0xb4, 0x76, 0xba, 0x8f, 0x60, 0xa8, 0x31, 0x5b, 0x45, 0xcb, 0xd0, 0xb2, 0xfd, 0x42, 0x46, 0xd6,
+	0xe1, 0xfa, 0xc8, 0xaa, 0x71, 0xad, 0x2a, 0xa2, 0x1c, 0xdf, 0xf6, 0x69, 0x39, 0x28, 0x8e, 0x80,
+	0x05, 0x62, 0x92, 0xa2, 0xdc, 0x5a, 0xf1, 0x14, 0xfc, 0xaf, 0xea, 0xad, 0x60, 0x7f, 0x0e, 0x42,
+	0x25, 0xfe, 0x46, 0x1c, 0x3a, 0x91, 0x7a, 0x76, 0xc7, 0xa3, 0x0a, 0xd9, 0x71, 0x89, 0x6f, 0xc8,
+	0x32, 0xc8, 0x5b, 0x94, 0x3b, 0x69, 0x42, 0x2e, 0xdc, 0x0c, 0xec, 0x11, 0x89, 0x23, 0x1d, 0x74,
+	0x01, 0xff, 0x2b, 0x1c, 0x66, 0xda, 0xbc, 0x8e, 0xdf, 0x20, 0x88, 0xf9, 0xd2, 0x0a, 0x39, 0xe6,
+	0x9a, 0x84, 0x85, 0x3f, 0xf1, 0xdf, 0x19, 0x19, 0x1b, 0xf2, 0x00, 0x4b, 0x2e, 0x6b, 0x85, 0x9c,
+	0x18, 0x40, 0xaa, 0x7b, 0x50, 0xa4, 0x2e, 0x9e, 0x8b, 0xb9, 0xae, 0x4e, 0x32, 0xe2, 0x59, 0xb5,
+	0x81, 0xf4, 0x9d, 0xdb, 0x94, 0x1e, 0x43, 0x17, 0x23, 0x05, 0x8b, 0xa7, 0xf9, 0x70, 0x8a, 0x3f,
+	0xdb, 0x63, 0x6a, 0xba, 0x66, 0xa5, 0x89, 0x99, 0x67, 0xbc, 0x9c, 0xe9, 0x4b, 0xf5, 0xd8, 0x2f,
+	0x66, 0xcc, 0x3c, 0x8b, 0x5f, 0x81, 0x03, 0x0d, 0x60, 0x77, 0xfe, 0xb1, 0x60, 0xfa, 0x4f, 0x0c,
+	0xbb, 0x79, 0x8f, 0xf8, 0x0e, 0x82, 0x3e, 0xef, 0x07, 0x01, 0xdc, 0xe0, 0x6e, 0xec, 0xf7, 0xe5,
+	0x43, 0x99, 0x08, 0x64, 0xeb, 0x70, 0xc6, 0xa7, 0xde, 0xb1, 0x77, 0xf9, 0xed, 0x9f, 0x7f, 0xff,
+	0xb0, 0x73, 0x04, 0x1f, 0x55, 0xeb, 0xbe, 0x01, 0xc9, 0xd5, 0x56, 0xd7, 0x04, 0xe5, 0x3a, 0xde,
+	0x40, 0xb0, 0xa7, 0xe6, 0x52, 0x8f, 0x27, 0x5b, 0x8c, 0x59, 0xfd, 0x61, 0x42, 0x49, 0x04, 0x35,
+	0x17, 0x94, 0xe7, 0x5c, 0xca, 0x04, 0x3e, 0x11, 0x84, 0x52, 0x5d, 0x12, 0x64, 0x5f, 0x7a, 0x68,
+	0xc5, 0x3d, 0xba, 0x25, 0x6d, 0xf5, 0x85, 0x5f, 0x49, 0x04, 0x35, 0x17, 0xb4, 0x67, 0x5d, 0xda,
+	0x13, 0x78, 0xbc, 0x11, 0x6d, 0x8e, 0xaa, 0x6b, 0x22, 0x1b, 0xaf, 0xab, 0xee, 0xfd, 0xfc, 0x1e,
+	0x82, 0xfe, 0xda, 0x4b, 0x2b, 0xf6, 0x1b, 0xdd, 0xe7, 0xea, 0xad, 0xa8, 0x81, 0xed, 0x03, 0xe3,
+	0xd6, 0x89, 0xcb, 0x38, 0xd9, 0x77, 0x08, 0xfa, 0x6b, 0xaf, 0x92, 0xbe, 0xb8, 0x3e, 0xd7, 0x5c,
+	0x45, 0x0d, 0x6c, 0x2f, 0x70, 0x93, 0x2e, 0xee, 0x59, 0x7c, 0x26, 0x10, 0xae, 0x49, 0x56, 0xd4,
+	0x35, 0xf7, 0xb6, 0xb9, 0x8e, 0x7f, 0x40, 0x80, 0xeb, 0x6f, 0x8c, 0xf8, 0xa4, 0x0f, 0x8b, 0xef,
+	0xcd, 0x57, 0x99, 0xda, 0x86, 0x87, 0xe0, 0x7f, 0x9e, 0xa3, 0x9f, 0xc3, 0x67, 0x83, 0x29, 0x6d,
+	0x77, 0x54, 0x0d, 0x7f, 0x0b, 0xba, 0xf8, 0x2e, 0x8e, 0xfb, 0x6e, 0x4b, 0x77, 0xeb, 0x1e, 0x69,
+	0x6a, 0x23, 0x88, 0x26, 0x5d, 0x45, 0xe3, 0x78, 0xb8, 0xd5, 0x7e, 0xc5, 0x2b, 0xb0, 0xdb, 0x76,
+	0x67, 0xb8, 0x59, 0xe7, 0xf2, 0xd8, 0x56, 0x8e, 0x36, 0x37, 0x12, 0x08, 0x47, 0x5c, 0x84, 0x08,
+	0xde, 0xd7, 0x18, 0x01, 0xbf, 0x87, 0xa0, 0x47, 0x96, 0xed, 0x78, 0xa4, 0x49, 0xbf, 0xde, 0xd3,
+	0x70, 0xb4, 0xa5, 0x9d, 0x40, 0x98, 0x76, 0x11, 0x46, 0xf1, 0xb1, 0xc6, 0x08, 0x93, 0xf6, 0xa5,
+	0xc2, 0x23, 0xc5, 0x07, 0x08, 0x7a, 0x3d, 0xc5, 0x36, 0x3e, 0xee, 0x33, 0x58, 0x7d, 0xd1, 0xaf,
+	0x8c, 0x07, 0x31, 0x15, 0x68, 0x13, 0x2e, 0xda, 0x30, 0x8e, 0x36, 0x46, 0x63, 0x6a, 0x89, 0x7b,
+	0xe2, 0x4f, 0x10, 0xf4, 0xd7, 0x96, 0xc2, 0xbe, 0x51, 0xe9, 0x53, 0x9c, 0x2b, 0x6a, 0x60, 0x7b,
+	0x81, 0x38, 0xca, 0xe9, 0x0e, 0xe3, 0x98, 0x1f, 0x5d, 0xde, 0xf1, 0xb4, 0xcf, 0xb8, 0x3d, 0x35,
+	0x15, 0xa2, 0xef, 0x79, 0xdc, 0xb8, 0x40, 0x56, 0x12, 0x41, 0xcd, 0x05, 0xdb, 0x79, 0xce, 0x76,
+	0x1a, 0x4f, 0x07, 0x8a, 0x38, 0x01, 0x3a, 0xc9, 0x4b, 0x55, 0x7c, 0x1b, 0x41, 0xc8, 0x29, 0x0a,
+	0xb1, 0xdf, 0x4e, 0xae, 0xaa, 0x3d, 0x95, 0x63, 0x2d, 0xac, 0xb6, 0xb7, 0xa4, 0xce, 0xc8, 0x3f,
+	0x22, 0xc0, 0xf5, 0x85, 0x9c, 0xef, 0x71, 0xe5, 0x5b, 0xa1, 0x2a, 0x53, 0xdb, 0xf0, 0xd8, 0xe6,
+	0x71, 0xcb, 0x54, 0x51, 0x4f, 0xa9, 0x6b, 0x35, 0x95, 0xd8, 0x3a, 0xfe, 0x14, 0x41, 0x9f, 0xb7,
+	0x4a, 0xf2, 0x2d, 0x67, 0x1a, 0xd4, 0x7d, 0xca, 0x44, 0x20, 0x5b, 0x41, 0x7b, 0xc6, 0xa5, 0x1d,
+	0xc7, 0x63, 0x4d, 0xd6, 0x3b, 0x63, 0x7b, 0x4b, 0xc2, 0xe4, 0xfc, 0xe6, 0x6f, 0xd1, 0x8e, 0xbb,
+	0x5b, 0xd1, 0x8e, 0xcd, 0xad, 0x28, 0x7a, 0xb8, 0x15, 0x45, 0xbf, 0x6e, 0x45, 0xd1, 0xfb, 0x8f,
+	0xa2, 0x1d, 0x0f, 0x1f, 0x45, 0x3b, 0x7e, 0x79, 0x14, 0xed, 0x78, 0x6d, 0xc4, 0xf3, 0xc9, 0x67,
+	0xd6, 0x60, 0xc5, 0x57, 0x65, 0xaf, 0x39, 0xf5, 0xa6, 0xd3, 0x3b, 0xff, 0x6f, 0x59, 0x26, 0xc4,
+	0xff, 0x33, 0x75, 0xea, 0xaf, 0x01, 0x00, 0x3f, 0x35, 0x24, 0xf4, 0x94, 0x1b, 0x00, 0x00,
 }
 
 func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
@@ -1509,6 +1599,9 @@ type QueryClient interface {
 	PinnedCodes(ctx context.Context, in *QueryPinnedCodesRequest, opts ...grpc.CallOption) (*QueryPinnedCodesResponse, error)
 	// GaslessContracts gets the gasless contract addresses
 	GaslessContracts(ctx context.Context, in *QueryGaslessContractsRequest, opts ...grpc.CallOption) (*QueryGaslessContractsResponse, error)
+	// GaslessGasUsage gets the gas used by the gasless executions of a contract
+	// in each epoch
+	GaslessGasUsage(ctx context.Context, in *QueryGaslessGasUsageRequest, opts ...grpc.CallOption) (*QueryGaslessGasUsageResponse, error)
 	// Params gets the module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ContractsByCreator gets the contracts by creator
@@ -1624,6 +1717,15 @@ func (c *queryClient) GaslessContracts(ctx context.Context, in *QueryGaslessCont
 	return out, nil
 }
 
+func (c *queryClient) GaslessGasUsage(ctx context.Context, in *QueryGaslessGasUsageRequest, opts ...grpc.CallOption) (*QueryGaslessGasUsageResponse, error) {
+	out := new(QueryGaslessGasUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1.Query/GaslessGasUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1.Query/Params", in, out, opts...)
@@ -1675,6 +1777,9 @@ type QueryServer interface {
 	PinnedCodes(context.Context, *QueryPinnedCodesRequest) (*QueryPinnedCodesResponse, error)
 	// GaslessContracts gets the gasless contract addresses
 	GaslessContracts(context.Context, *QueryGaslessContractsRequest) (*QueryGaslessContractsResponse, error)
+	// GaslessGasUsage gets the gas used by the gasless executions of a contract
+	// in each epoch
+	GaslessGasUsage(context.Context, *QueryGaslessGasUsageRequest) (*QueryGaslessGasUsageResponse, error)
 	// Params gets the module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ContractsByCreator gets the contracts by creator
@@ -1720,6 +1825,9 @@ func (*UnimplementedQueryServer) PinnedCodes(ctx context.Context, req *QueryPinn
 func (*UnimplementedQueryServer) GaslessContracts(ctx context.Context, req *QueryGaslessContractsRequest) (*QueryGaslessContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GaslessContracts not implemented")
 }
+func (*UnimplementedQueryServer) GaslessGasUsage(ctx context.Context, req *QueryGaslessGasUsageRequest) (*QueryGaslessGasUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaslessGasUsage not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1932,6 +2040,24 @@ func _Query_GaslessContracts_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GaslessGasUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaslessGasUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaslessGasUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1.Query/GaslessGasUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaslessGasUsage(ctx, req.(*QueryGaslessGasUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1986,6 +2112,7 @@ func _Query_BuildAddress_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2034,6 +2161,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GaslessContracts",
 			Handler:    _Query_GaslessContracts_Handler,
 		},
+		{
+			MethodName: "GaslessGasUsage",
+			Handler:    _Query_GaslessGasUsage_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2974,6 +3105,97 @@ func (m *QueryGaslessContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGaslessGasUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaslessGasUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaslessGasUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaslessGasUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaslessGasUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaslessGasUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3585,6 +3807,42 @@ func (m *QueryGaslessContractsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGaslessGasUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaslessGasUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6233,6 +6491,244 @@ func (m *QueryGaslessContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGaslessGasUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaslessGasUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaslessGasUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaslessGasUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaslessGasUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaslessGasUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, GaslessGasUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -574,5 +574,21 @@ func (msg MsgSetGaslessContracts) ValidateBasic() error {
 	if hasDuplicates(msg.Contracts) {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicate contract addresses")
 	}
+	if err := msg.GaslessConfig().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "config")
+	}
 	return nil
 }
+
+// GaslessConfig returns the configuration the message sets, with the default gas limits in
+// place of the unset ones, like SetGasLessContractsProposal.GaslessConfig.
+func (msg MsgSetGaslessContracts) GaslessConfig() GaslessConfig {
+	config := msg.Config
+	if config.MaxGasPerExecution == 0 {
+		config.MaxGasPerExecution = DefaultGaslessConfig().MaxGasPerExecution
+	}
+	if config.MaxGasPerBlock == 0 {
+		config.MaxGasPerBlock = DefaultGaslessConfig().MaxGasPerBlock
+	}
+	return config
+}
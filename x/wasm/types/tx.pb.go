@@ -1508,6 +1508,8 @@ type MsgSetGaslessContracts struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// Contracts are the addresses of the smart contracts
 	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty" yaml:"contracts"`
+	// Config bounds the gas the contracts can use without being charged
+	Config GaslessConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
 func (m *MsgSetGaslessContracts) Reset()         { *m = MsgSetGaslessContracts{} }
@@ -1622,120 +1624,120 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/tx.proto", fileDescriptor_4f74d82755520264) }
 
 var fileDescriptor_4f74d82755520264 = []byte{
-	// 1803 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4b, 0x6f, 0xdb, 0x46,
-	0xda, 0xb4, 0xde, 0x63, 0x6d, 0xec, 0x30, 0x4e, 0x2c, 0xd3, 0x89, 0xe4, 0x30, 0x89, 0xad, 0x78,
-	0x1d, 0xc9, 0xd6, 0x66, 0xb3, 0x89, 0x76, 0x2f, 0x96, 0xb3, 0x0f, 0x07, 0x2b, 0xc0, 0x90, 0xe1,
-	0x0d, 0x76, 0x11, 0x40, 0xa0, 0xc5, 0x31, 0xcd, 0x8d, 0x44, 0xaa, 0x1a, 0xca, 0x8f, 0x43, 0x81,
-	0x22, 0x28, 0x0a, 0xb4, 0xe8, 0xa1, 0x97, 0x5c, 0xda, 0x73, 0x81, 0xb6, 0x97, 0xfa, 0xd0, 0x9f,
-	0x50, 0x14, 0x46, 0xd1, 0x43, 0x50, 0xf4, 0x90, 0x93, 0xdb, 0x3a, 0x07, 0x9f, 0x7a, 0xc9, 0xb1,
-	0x87, 0xa2, 0xe0, 0x0c, 0x39, 0xa2, 0xa8, 0x21, 0xf5, 0x32, 0x92, 0x1e, 0x7a, 0xb1, 0xc9, 0xf9,
-	0xde, 0x4f, 0x7e, 0xdf, 0x08, 0x4c, 0x57, 0x74, 0x54, 0xdb, 0x93, 0x50, 0x2d, 0x8b, 0xff, 0xec,
-	0x2e, 0x67, 0x8d, 0xfd, 0x4c, 0xbd, 0xa1, 0x1b, 0x3a, 0x3f, 0x61, 0x83, 0x32, 0xf8, 0xcf, 0xee,
-	0xb2, 0x90, 0x34, 0x4f, 0x74, 0x94, 0xdd, 0x92, 0x10, 0xcc, 0xee, 0x2e, 0x6f, 0x41, 0x43, 0x5a,
-	0xce, 0x56, 0x74, 0x55, 0x23, 0x14, 0xc2, 0x94, 0x05, 0xaf, 0x21, 0xc5, 0xe4, 0x54, 0x43, 0x8a,
-	0x05, 0x98, 0x54, 0x74, 0x45, 0xc7, 0x8f, 0x59, 0xf3, 0xc9, 0x3a, 0xbd, 0xdc, 0x29, 0xfb, 0xa0,
-	0x0e, 0x91, 0x05, 0x9d, 0x26, 0xcc, 0xca, 0x84, 0x8c, 0xbc, 0x58, 0xa0, 0xf3, 0x52, 0x4d, 0xd5,
-	0xf4, 0x2c, 0xfe, 0x4b, 0x8e, 0xc4, 0x5f, 0x38, 0x10, 0x2f, 0x22, 0x65, 0xc3, 0xd0, 0x1b, 0x70,
-	0x55, 0x97, 0x21, 0xbf, 0x04, 0xc2, 0x08, 0x6a, 0x32, 0x6c, 0x24, 0xb8, 0x59, 0x2e, 0x1d, 0x2b,
-	0x24, 0xbe, 0xfd, 0xe2, 0xd6, 0xa4, 0xc5, 0x65, 0x45, 0x96, 0x1b, 0x10, 0xa1, 0x0d, 0xa3, 0xa1,
-	0x6a, 0x4a, 0xc9, 0xc2, 0xe3, 0xef, 0x80, 0x73, 0xa6, 0x1e, 0xe5, 0xad, 0x03, 0x03, 0x96, 0x2b,
-	0xba, 0x0c, 0x13, 0xa3, 0xb3, 0x5c, 0x3a, 0x5e, 0x98, 0x38, 0x39, 0x4e, 0xc5, 0x1f, 0xae, 0x6c,
-	0x14, 0x0b, 0x07, 0x06, 0xe6, 0x5d, 0x8a, 0x9b, 0x78, 0xf6, 0x1b, 0xbf, 0x09, 0x2e, 0xa9, 0x1a,
-	0x32, 0x24, 0xcd, 0x50, 0x25, 0x03, 0x96, 0xeb, 0xb0, 0x51, 0x53, 0x11, 0x52, 0x75, 0x2d, 0x11,
-	0x9a, 0xe5, 0xd2, 0x63, 0xb9, 0x64, 0xc6, 0xed, 0xc8, 0xcc, 0x4a, 0xa5, 0x02, 0x11, 0x5a, 0xd5,
-	0xb5, 0x6d, 0x55, 0x29, 0x5d, 0x74, 0x50, 0xaf, 0x53, 0xe2, 0xfc, 0xd5, 0x27, 0xa7, 0x87, 0x0b,
-	0x96, 0x6e, 0xef, 0x9d, 0x1e, 0x2e, 0x9c, 0xc7, 0x4e, 0x72, 0xda, 0xf8, 0x20, 0x18, 0x0d, 0x4c,
-	0x04, 0x1f, 0x04, 0xa3, 0xc1, 0x89, 0x90, 0xf8, 0x10, 0x4c, 0x3a, 0x61, 0x25, 0x88, 0xea, 0xba,
-	0x86, 0x20, 0x7f, 0x0d, 0x44, 0x4c, 0x5b, 0xca, 0xaa, 0x8c, 0x1d, 0x11, 0x2c, 0x80, 0x93, 0xe3,
-	0x54, 0xd8, 0x44, 0x59, 0xbb, 0x5f, 0x0a, 0x9b, 0xa0, 0x35, 0x99, 0x17, 0x40, 0xb4, 0xb2, 0x03,
-	0x2b, 0x8f, 0x51, 0xb3, 0x46, 0x8c, 0x2e, 0xd1, 0x77, 0xf1, 0x69, 0x00, 0x5c, 0x2a, 0x22, 0x65,
-	0xad, 0xa5, 0xe4, 0xaa, 0xae, 0x19, 0x0d, 0xa9, 0x62, 0x0c, 0xe0, 0xe3, 0x0c, 0x08, 0x49, 0x72,
-	0x4d, 0xd5, 0xb0, 0x14, 0x3f, 0x02, 0x82, 0xe6, 0xd4, 0x3e, 0xe0, 0xa9, 0xfd, 0x24, 0x08, 0x55,
-	0xa5, 0x2d, 0x58, 0x4d, 0x04, 0x4d, 0xa6, 0x25, 0xf2, 0xc2, 0xdf, 0x05, 0x81, 0x1a, 0x52, 0x70,
-	0x0c, 0xe2, 0x85, 0xb9, 0x9f, 0x8f, 0x53, 0x7c, 0x49, 0xda, 0xb3, 0x55, 0x2f, 0x42, 0x84, 0x24,
-	0x05, 0x7e, 0x78, 0x7a, 0xb8, 0x30, 0xa6, 0x6a, 0x55, 0x55, 0x83, 0xe5, 0xff, 0x23, 0x5d, 0x2b,
-	0x99, 0x24, 0xfc, 0x1e, 0x08, 0x6d, 0x37, 0x35, 0x19, 0x25, 0xc2, 0xb3, 0x81, 0xf4, 0x58, 0x6e,
-	0x3a, 0x63, 0x69, 0x68, 0xa6, 0x7d, 0xc6, 0x4a, 0xfb, 0xcc, 0xaa, 0xae, 0x6a, 0x85, 0x7f, 0x1c,
-	0x1d, 0xa7, 0x46, 0x3e, 0xfb, 0x3e, 0x95, 0x56, 0x54, 0x63, 0xa7, 0xb9, 0x95, 0xa9, 0xe8, 0x35,
-	0x2b, 0x53, 0xad, 0x7f, 0xb7, 0x90, 0xfc, 0xd8, 0xca, 0x6a, 0x93, 0x00, 0x99, 0x02, 0xe3, 0x55,
-	0xa8, 0x48, 0x95, 0x83, 0xb2, 0x59, 0x38, 0xe8, 0x93, 0xd3, 0xc3, 0x05, 0xae, 0x44, 0xe4, 0xe5,
-	0xff, 0xe8, 0x0a, 0xf9, 0x8c, 0x1d, 0x72, 0x86, 0xf3, 0xc5, 0x1d, 0x90, 0x64, 0x43, 0x68, 0xe8,
-	0x73, 0x20, 0x22, 0x11, 0xa7, 0x76, 0x8d, 0x8f, 0x8d, 0xc8, 0xf3, 0x20, 0x28, 0x4b, 0x86, 0x64,
-	0x65, 0x01, 0x7e, 0x16, 0xbf, 0x0c, 0x80, 0x29, 0xb6, 0xa8, 0xdc, 0xef, 0x29, 0x70, 0xb6, 0x29,
-	0x60, 0xfa, 0x1f, 0x49, 0x55, 0x23, 0x11, 0x21, 0xfe, 0x37, 0x9f, 0xf9, 0x29, 0x10, 0xd9, 0x56,
-	0xf7, 0xcb, 0xa6, 0x29, 0xd1, 0x59, 0x2e, 0x1d, 0x2d, 0x85, 0xb7, 0xd5, 0xfd, 0x22, 0x52, 0xf2,
-	0x8b, 0xae, 0x7c, 0xb9, 0xec, 0x93, 0x2f, 0x39, 0x51, 0x05, 0x29, 0x0f, 0xd0, 0x99, 0x67, 0xcc,
-	0xf3, 0x51, 0xc0, 0x17, 0x91, 0xf2, 0xf7, 0x7d, 0x58, 0x69, 0x0e, 0xd5, 0x2f, 0x6e, 0x83, 0x68,
-	0xc5, 0xa2, 0xee, 0x9a, 0x2f, 0x14, 0xd3, 0x8e, 0x7b, 0x60, 0x88, 0xb8, 0x87, 0x5e, 0x71, 0xe9,
-	0xcf, 0xbb, 0x42, 0x39, 0x65, 0x87, 0xd2, 0xe5, 0x43, 0x71, 0x09, 0x08, 0x9d, 0xa7, 0x34, 0x80,
-	0x76, 0x30, 0x38, 0x47, 0x30, 0xde, 0x26, 0xc1, 0x28, 0xaa, 0x4a, 0x43, 0x7a, 0x0d, 0xc1, 0xe8,
-	0xa9, 0x7e, 0xad, 0x88, 0x05, 0xfb, 0x8e, 0x98, 0xb7, 0xe3, 0x5c, 0xf6, 0x5a, 0x8e, 0x73, 0x9d,
-	0xfa, 0x3a, 0xee, 0x3b, 0x0e, 0x9c, 0x2b, 0x22, 0x65, 0xb3, 0x2e, 0x4b, 0x06, 0x5c, 0xc1, 0xcd,
-	0xa8, 0x7f, 0xa7, 0xfd, 0x19, 0xc4, 0x34, 0xb8, 0x57, 0xee, 0xad, 0xe5, 0x45, 0x35, 0xb8, 0x47,
-	0x04, 0x39, 0x7d, 0x1d, 0xe8, 0xd5, 0xd7, 0xf9, 0x6b, 0x2e, 0x67, 0x5c, 0xb0, 0x9d, 0xe1, 0xb0,
-	0x41, 0x4c, 0xe0, 0xef, 0xb9, 0xe3, 0xc4, 0x76, 0x82, 0xf8, 0x11, 0x07, 0xfe, 0x50, 0x44, 0xca,
-	0x6a, 0x15, 0x4a, 0x8d, 0x41, 0xed, 0x1d, 0x4c, 0x71, 0xd1, 0xa5, 0x38, 0x6f, 0x2b, 0xde, 0xd2,
-	0x45, 0x9c, 0x02, 0x17, 0xdb, 0x0e, 0xa8, 0xda, 0x4f, 0x46, 0x71, 0x68, 0x89, 0x45, 0xed, 0xfd,
-	0x6d, 0x5b, 0x55, 0x06, 0xb0, 0xc1, 0x91, 0xb2, 0xa3, 0x9e, 0x29, 0xfb, 0x08, 0x08, 0x66, 0x60,
-	0x3d, 0x46, 0xbf, 0x40, 0x4f, 0xa3, 0x5f, 0x42, 0x83, 0x7b, 0x6b, 0xcc, 0xe9, 0x2f, 0xeb, 0x72,
-	0x48, 0xaa, 0x3d, 0x92, 0x1d, 0x56, 0x8a, 0xd7, 0x81, 0xe8, 0x0d, 0xa5, 0xae, 0xfa, 0x9c, 0x03,
-	0xe3, 0x14, 0x6d, 0x5d, 0x6a, 0x48, 0x35, 0xc4, 0xdf, 0x01, 0x31, 0xa9, 0x69, 0xec, 0xe8, 0x0d,
-	0xd5, 0x38, 0xe8, 0xea, 0xa2, 0x16, 0x2a, 0xff, 0x57, 0x10, 0xae, 0x63, 0x0e, 0xd8, 0x49, 0x63,
-	0xb9, 0x44, 0xa7, 0xb1, 0x44, 0x42, 0x21, 0x66, 0xf6, 0x4a, 0xd2, 0xee, 0x2c, 0x12, 0x52, 0xb6,
-	0x2d, 0x66, 0xa6, 0x89, 0x93, 0xed, 0x26, 0x12, 0x5a, 0x71, 0x1a, 0xcf, 0x1e, 0xce, 0x23, 0x6a,
-	0xcc, 0x09, 0x31, 0x66, 0xa3, 0x29, 0xeb, 0xb4, 0xab, 0x0d, 0x6a, 0xcc, 0x2b, 0xfe, 0xd0, 0xf8,
-	0xda, 0xef, 0x34, 0x48, 0xbc, 0x85, 0xed, 0x77, 0x1e, 0xf9, 0xf6, 0xac, 0x8f, 0x39, 0x30, 0x56,
-	0x44, 0xca, 0xba, 0xaa, 0x99, 0xe9, 0x3a, 0x78, 0x70, 0xef, 0x99, 0xfe, 0xc0, 0x25, 0x60, 0x86,
-	0x37, 0x90, 0x0e, 0x16, 0x92, 0x27, 0xc7, 0xa9, 0x08, 0xa9, 0x01, 0xf4, 0xf2, 0x38, 0x35, 0x7e,
-	0x20, 0xd5, 0xaa, 0x79, 0xd1, 0x46, 0x12, 0x4b, 0x11, 0x52, 0x17, 0x88, 0x34, 0xa1, 0x76, 0xd3,
-	0x26, 0x6c, 0xd3, 0x6c, 0xbd, 0xc4, 0x8b, 0xe0, 0x82, 0xe3, 0x95, 0x86, 0xf4, 0x53, 0xd2, 0x81,
-	0x36, 0xb5, 0xfa, 0x6b, 0x34, 0xe0, 0x46, 0xa7, 0x01, 0xb4, 0x1f, 0xb5, 0x34, 0xb3, 0xfa, 0x51,
-	0xeb, 0x80, 0x1a, 0xf1, 0x4e, 0x08, 0x8f, 0xe6, 0x78, 0x17, 0x5b, 0xd1, 0x64, 0xd6, 0xe6, 0x34,
-	0xa8, 0x55, 0x9d, 0x3b, 0x6a, 0x60, 0xc8, 0x1d, 0x35, 0x38, 0xc4, 0x8e, 0xca, 0x5f, 0x01, 0xa0,
-	0x69, 0xda, 0x4f, 0x54, 0x09, 0xe1, 0xe1, 0x34, 0xd6, 0xb4, 0x3d, 0xd2, 0x1a, 0xf5, 0xc3, 0xbd,
-	0x8d, 0xfa, 0x74, 0x8a, 0x8f, 0x30, 0xa6, 0xf8, 0xe8, 0x10, 0xd3, 0x5c, 0xec, 0x15, 0x4f, 0xf1,
-	0x97, 0x40, 0x18, 0xe9, 0xcd, 0x46, 0x05, 0x26, 0x00, 0xb6, 0xc4, 0x7a, 0xe3, 0x13, 0x20, 0xb2,
-	0xd5, 0x54, 0xab, 0xe6, 0xb7, 0x68, 0x0c, 0x03, 0xec, 0x57, 0x7e, 0x06, 0xc4, 0x70, 0x26, 0xee,
-	0x48, 0x68, 0x27, 0x11, 0xb7, 0x56, 0x70, 0x5d, 0x86, 0xff, 0x92, 0xd0, 0x4e, 0xfe, 0x4e, 0x67,
-	0x42, 0x5e, 0x6b, 0xbb, 0x0d, 0x60, 0x67, 0x99, 0x58, 0x07, 0x73, 0xfe, 0x18, 0x67, 0x3e, 0xf8,
-	0x7f, 0xc5, 0xe1, 0x25, 0x63, 0x45, 0x96, 0xcd, 0x04, 0xd8, 0xac, 0x57, 0x75, 0x49, 0x26, 0x5d,
-	0xdb, 0x62, 0x32, 0x44, 0x45, 0xe7, 0x40, 0x4c, 0xb2, 0x99, 0xe0, 0x92, 0x8e, 0x15, 0x26, 0x5f,
-	0x1e, 0xa7, 0x26, 0x48, 0x1d, 0x53, 0x90, 0x58, 0x6a, 0xa1, 0xe5, 0xff, 0xd2, 0xe9, 0xb9, 0xeb,
-	0xb6, 0xe7, 0xfc, 0x94, 0x14, 0x6f, 0x82, 0xf9, 0x2e, 0x28, 0xb4, 0xdc, 0xbf, 0xe1, 0xf0, 0xa7,
-	0xb7, 0x04, 0x6b, 0xfa, 0x2e, 0xfc, 0x6d, 0x98, 0x9d, 0xef, 0x34, 0x7b, 0xde, 0x36, 0xbb, 0x8b,
-	0x9e, 0xe2, 0x22, 0x58, 0xe8, 0x8e, 0x45, 0x8d, 0xff, 0x89, 0xcc, 0x5e, 0x76, 0x8e, 0xb9, 0x97,
-	0x8c, 0xb3, 0xeb, 0x73, 0xc3, 0xde, 0xc5, 0x05, 0x86, 0xe9, 0x73, 0x82, 0x63, 0x3a, 0x20, 0x37,
-	0x0c, 0x1d, 0x33, 0x40, 0xff, 0x97, 0x0c, 0xf9, 0x5c, 0x67, 0x94, 0x52, 0xee, 0xb2, 0x76, 0x6f,
-	0x31, 0x07, 0x38, 0xd7, 0x3c, 0xa0, 0x67, 0x76, 0xe9, 0x47, 0x6b, 0x3b, 0xe0, 0xa8, 0xed, 0xaf,
-	0x39, 0xc7, 0xe2, 0x60, 0x8b, 0xfc, 0x37, 0x6e, 0xd1, 0xfd, 0x8f, 0xd8, 0x33, 0x64, 0x2d, 0x22,
-	0xed, 0x7e, 0x94, 0xb8, 0x54, 0x83, 0x7b, 0x84, 0xdd, 0x60, 0x3b, 0x84, 0xe7, 0xed, 0x19, 0x43,
-	0x63, 0x71, 0x16, 0x7f, 0xa2, 0x19, 0x10, 0x67, 0x59, 0x9b, 0xe6, 0x6e, 0x40, 0xe3, 0x9f, 0x12,
-	0xaa, 0x92, 0x14, 0xc1, 0x68, 0x83, 0x97, 0xf2, 0x03, 0xb3, 0xc9, 0x5b, 0x4c, 0xac, 0x52, 0x5e,
-	0x6c, 0x95, 0x32, 0x05, 0x89, 0xde, 0xbc, 0x28, 0x4e, 0x3e, 0xd3, 0x99, 0x3c, 0xd4, 0x60, 0x86,
-	0xce, 0x96, 0xc1, 0x0c, 0x88, 0x6d, 0x70, 0xee, 0x68, 0x1c, 0x04, 0x8a, 0x48, 0xe1, 0x37, 0x40,
-	0xac, 0x75, 0x8d, 0xce, 0x28, 0x18, 0xe7, 0x35, 0xb3, 0x30, 0xe7, 0x0f, 0xa7, 0x19, 0xf9, 0x06,
-	0xb8, 0xc0, 0x9a, 0x83, 0xd2, 0x4c, 0x72, 0x06, 0xa6, 0xb0, 0xd4, 0x2b, 0x26, 0x15, 0x69, 0x80,
-	0x49, 0xe6, 0x95, 0xe5, 0xcd, 0x5e, 0x39, 0xe5, 0x84, 0xe5, 0x9e, 0x51, 0xa9, 0x54, 0x08, 0xc6,
-	0xdd, 0xd7, 0x5e, 0xd7, 0x99, 0x5c, 0x5c, 0x58, 0xc2, 0x62, 0x2f, 0x58, 0x4e, 0x31, 0xee, 0x5e,
-	0xcb, 0x16, 0xe3, 0xc2, 0xf2, 0x10, 0xe3, 0xd5, 0x48, 0xfe, 0x0b, 0xc6, 0x9c, 0xd7, 0x1f, 0xb3,
-	0x4c, 0x62, 0x07, 0x86, 0x90, 0xee, 0x86, 0x41, 0x59, 0xff, 0x07, 0x00, 0xc7, 0x45, 0x43, 0x8a,
-	0x49, 0xd7, 0x42, 0x10, 0xe6, 0xbb, 0x20, 0x50, 0xbe, 0x6f, 0x82, 0x29, 0xaf, 0x9b, 0x80, 0x45,
-	0x1f, 0xe5, 0x3a, 0xb0, 0x85, 0xdb, 0xfd, 0x60, 0x53, 0xf1, 0x8f, 0x40, 0xbc, 0x6d, 0xbb, 0xbe,
-	0xea, 0xc3, 0x85, 0xa0, 0x08, 0x37, 0xbb, 0xa2, 0x38, 0xb9, 0xb7, 0xad, 0xbb, 0x6c, 0xee, 0x4e,
-	0x14, 0x0f, 0xee, 0xcc, 0x85, 0x72, 0x1d, 0x44, 0xe9, 0xe2, 0x78, 0x85, 0x49, 0x66, 0x83, 0x85,
-	0x1b, 0xbe, 0x60, 0x67, 0x90, 0x1d, 0xbb, 0x1c, 0x3b, 0xc8, 0x2d, 0x04, 0x8f, 0x20, 0x77, 0xae,
-	0x58, 0xfc, 0xbb, 0x1c, 0x98, 0xf1, 0xdb, 0xaf, 0x96, 0xbc, 0xdb, 0x12, 0x9b, 0x42, 0xb8, 0xdb,
-	0x2f, 0x05, 0xd5, 0xe5, 0x29, 0x07, 0x52, 0xdd, 0x86, 0x3f, 0x76, 0x2e, 0x75, 0xa1, 0x12, 0xfe,
-	0x36, 0x08, 0x15, 0xd5, 0xeb, 0x7d, 0x0e, 0x5c, 0xf6, 0x1d, 0xc4, 0xd9, 0xdd, 0xcd, 0x8f, 0x44,
-	0xb8, 0xd7, 0x37, 0x89, 0xb3, 0x2e, 0xbd, 0xa6, 0xc4, 0x45, 0x5f, 0xdf, 0xbb, 0x3b, 0xd8, 0xed,
-	0x7e, 0xb0, 0x9d, 0x1f, 0x20, 0xd6, 0xe4, 0xe2, 0xd7, 0xaf, 0xda, 0x30, 0x3d, 0x3e, 0x40, 0x3e,
-	0x13, 0x84, 0x29, 0x92, 0x35, 0x3d, 0xb0, 0x45, 0x32, 0x30, 0x3d, 0x44, 0xfa, 0x7c, 0xc3, 0x85,
-	0xd0, 0x5b, 0xe6, 0x1a, 0x5a, 0xb8, 0x7f, 0xf4, 0x63, 0x72, 0xe4, 0xe8, 0x24, 0xc9, 0x3d, 0x3b,
-	0x49, 0x72, 0x3f, 0x9c, 0x24, 0xb9, 0x0f, 0x5e, 0x24, 0x47, 0x9e, 0xbd, 0x48, 0x8e, 0x3c, 0x7f,
-	0x91, 0x1c, 0xf9, 0xdf, 0x9c, 0x63, 0xc9, 0x5d, 0xd5, 0x51, 0xed, 0xa1, 0xfd, 0x13, 0xbc, 0x9c,
-	0xdd, 0x27, 0x3f, 0xc5, 0xe3, 0x45, 0x77, 0x2b, 0x8c, 0x7f, 0x5a, 0xff, 0xd3, 0xaf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa4, 0x88, 0x8b, 0xf7, 0x24, 0x20, 0x00, 0x00,
+	// 1805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xbd, 0x6f, 0xdb, 0xd6,
+	0x16, 0x37, 0x2d, 0xeb, 0xeb, 0x58, 0x2f, 0x76, 0x18, 0xc7, 0x92, 0xe9, 0x44, 0x72, 0x98, 0xc4,
+	0x96, 0xfd, 0x1c, 0xc9, 0xd6, 0xcb, 0xcb, 0x4b, 0xf4, 0xba, 0x58, 0x4e, 0x3f, 0x5c, 0x54, 0x80,
+	0x21, 0xc3, 0x0d, 0x5a, 0x04, 0x10, 0x68, 0xf1, 0x9a, 0x66, 0x23, 0x91, 0xaa, 0x2e, 0xe5, 0x8f,
+	0xa1, 0x40, 0x11, 0x14, 0x05, 0x5a, 0x74, 0xc8, 0x92, 0xa5, 0x9d, 0x0b, 0xb4, 0x5d, 0xea, 0xa1,
+	0x7f, 0x42, 0x51, 0x18, 0x45, 0x87, 0xa0, 0xe8, 0x90, 0xc9, 0x6d, 0x9d, 0xc1, 0x53, 0x97, 0x8c,
+	0x9d, 0x0a, 0xf2, 0x92, 0x57, 0x14, 0x45, 0x51, 0x5f, 0x46, 0xd2, 0xa1, 0x8b, 0x2c, 0xf2, 0xfc,
+	0xce, 0xb9, 0xe7, 0x5b, 0xe7, 0x5c, 0xc3, 0x54, 0x49, 0xc5, 0x95, 0x3d, 0x01, 0x57, 0xd2, 0xc6,
+	0xc7, 0xee, 0x72, 0x5a, 0xdb, 0x4f, 0x55, 0x6b, 0xaa, 0xa6, 0xb2, 0xe3, 0x16, 0x29, 0x65, 0x7c,
+	0xec, 0x2e, 0x73, 0x71, 0xfd, 0x8d, 0x8a, 0xd3, 0x5b, 0x02, 0x46, 0xe9, 0xdd, 0xe5, 0x2d, 0xa4,
+	0x09, 0xcb, 0xe9, 0x92, 0x2a, 0x2b, 0x84, 0x83, 0x8b, 0x9a, 0xf4, 0x0a, 0x96, 0x74, 0x49, 0x15,
+	0x2c, 0x99, 0x84, 0x09, 0x49, 0x95, 0x54, 0xe3, 0x6b, 0x5a, 0xff, 0x66, 0xbe, 0xbd, 0xd4, 0x7a,
+	0xf6, 0x41, 0x15, 0x61, 0x93, 0x3a, 0x45, 0x84, 0x15, 0x09, 0x1b, 0x79, 0x30, 0x49, 0xe7, 0x85,
+	0x8a, 0xac, 0xa8, 0x69, 0xe3, 0x93, 0xbc, 0xe2, 0x9f, 0x33, 0x10, 0xc9, 0x63, 0x69, 0x43, 0x53,
+	0x6b, 0x68, 0x55, 0x15, 0x11, 0xbb, 0x04, 0x01, 0x8c, 0x14, 0x11, 0xd5, 0x62, 0xcc, 0x0c, 0x93,
+	0x0c, 0xe7, 0x62, 0x3f, 0x7f, 0x77, 0x63, 0xc2, 0x94, 0xb2, 0x22, 0x8a, 0x35, 0x84, 0xf1, 0x86,
+	0x56, 0x93, 0x15, 0xa9, 0x60, 0xe2, 0xd8, 0x5b, 0x70, 0x4e, 0xd7, 0xa3, 0xb8, 0x75, 0xa0, 0xa1,
+	0x62, 0x49, 0x15, 0x51, 0x6c, 0x78, 0x86, 0x49, 0x46, 0x72, 0xe3, 0x27, 0xc7, 0x89, 0xc8, 0xbd,
+	0x95, 0x8d, 0x7c, 0xee, 0x40, 0x33, 0x64, 0x17, 0x22, 0x3a, 0xce, 0x7a, 0x62, 0x37, 0x61, 0x52,
+	0x56, 0xb0, 0x26, 0x28, 0x9a, 0x2c, 0x68, 0xa8, 0x58, 0x45, 0xb5, 0x8a, 0x8c, 0xb1, 0xac, 0x2a,
+	0x31, 0xff, 0x0c, 0x93, 0x1c, 0xcd, 0xc4, 0x53, 0x4e, 0x47, 0xa6, 0x56, 0x4a, 0x25, 0x84, 0xf1,
+	0xaa, 0xaa, 0x6c, 0xcb, 0x52, 0xe1, 0xa2, 0x8d, 0x7b, 0x9d, 0x32, 0x67, 0xaf, 0x3c, 0x3c, 0x3d,
+	0x5c, 0x30, 0x75, 0xfb, 0xf4, 0xf4, 0x70, 0xe1, 0xbc, 0xe1, 0x24, 0xbb, 0x8d, 0xfc, 0x3d, 0x98,
+	0xb0, 0x3f, 0x17, 0x10, 0xae, 0xaa, 0x0a, 0x46, 0xec, 0x55, 0x08, 0xea, 0xfa, 0x17, 0x65, 0xd1,
+	0x30, 0x7e, 0x24, 0x07, 0x27, 0xc7, 0x89, 0x80, 0x0e, 0x59, 0xbb, 0x5b, 0x08, 0xe8, 0xa4, 0x35,
+	0x91, 0xe5, 0x20, 0x54, 0xda, 0x41, 0xa5, 0x07, 0xb8, 0x5e, 0x21, 0x86, 0x16, 0xe8, 0x33, 0xff,
+	0xd8, 0x07, 0x93, 0x79, 0x2c, 0xad, 0x35, 0x14, 0x5b, 0x55, 0x15, 0xad, 0x26, 0x94, 0xb4, 0x3e,
+	0xfc, 0x9a, 0x02, 0xbf, 0x20, 0x56, 0x64, 0x25, 0x36, 0xdc, 0x81, 0x81, 0xc0, 0xec, 0xda, 0xfb,
+	0xda, 0x6a, 0x3f, 0x01, 0xfe, 0xb2, 0xb0, 0x85, 0xca, 0xb1, 0x11, 0x5d, 0x68, 0x81, 0x3c, 0xb0,
+	0xb7, 0xc1, 0x57, 0xc1, 0x92, 0xe1, 0xf7, 0x48, 0x6e, 0xf6, 0xcf, 0xe3, 0x04, 0x5b, 0x10, 0xf6,
+	0x2c, 0xd5, 0xf3, 0x08, 0x63, 0x41, 0x42, 0x9f, 0x9f, 0x1e, 0x2e, 0x8c, 0xca, 0x4a, 0x59, 0x56,
+	0x50, 0xf1, 0x3d, 0xac, 0x2a, 0x05, 0x9d, 0x85, 0xdd, 0x03, 0xff, 0x76, 0x5d, 0x11, 0x71, 0x2c,
+	0x30, 0xe3, 0x4b, 0x8e, 0x66, 0xa6, 0x52, 0xa6, 0x86, 0x7a, 0xaa, 0xa7, 0xcc, 0x54, 0x4f, 0xad,
+	0xaa, 0xb2, 0x92, 0x7b, 0xed, 0xe8, 0x38, 0x31, 0xf4, 0xcd, 0xaf, 0x89, 0xa4, 0x24, 0x6b, 0x3b,
+	0xf5, 0xad, 0x54, 0x49, 0xad, 0x98, 0xd9, 0x69, 0xfe, 0xb9, 0x81, 0xc5, 0x07, 0x66, 0x26, 0xeb,
+	0x0c, 0x58, 0x3f, 0x30, 0x52, 0x46, 0x92, 0x50, 0x3a, 0x28, 0xea, 0xc5, 0x82, 0xbf, 0x3a, 0x3d,
+	0x5c, 0x60, 0x0a, 0xe4, 0xbc, 0xec, 0xbf, 0x1d, 0x61, 0x9e, 0xb6, 0xc2, 0xec, 0xe2, 0x7c, 0x7e,
+	0x07, 0xe2, 0xee, 0x14, 0x1a, 0xfa, 0x0c, 0x04, 0x05, 0xe2, 0xd4, 0x8e, 0xf1, 0xb1, 0x80, 0x2c,
+	0x0b, 0x23, 0xa2, 0xa0, 0x09, 0x66, 0x16, 0x18, 0xdf, 0xf9, 0xef, 0x7d, 0x10, 0x75, 0x3f, 0x2a,
+	0xf3, 0x4f, 0x0a, 0x9c, 0x6d, 0x0a, 0xe8, 0xfe, 0xc7, 0x42, 0x59, 0x8b, 0x05, 0x89, 0xff, 0xf5,
+	0xef, 0x6c, 0x14, 0x82, 0xdb, 0xf2, 0x7e, 0x51, 0x37, 0x25, 0x34, 0xc3, 0x24, 0x43, 0x85, 0xc0,
+	0xb6, 0xbc, 0x9f, 0xc7, 0x52, 0x76, 0xd1, 0x91, 0x2f, 0x97, 0x3c, 0xf2, 0x25, 0xc3, 0xcb, 0x90,
+	0x68, 0x43, 0x3a, 0xf3, 0x8c, 0x79, 0x3a, 0x0c, 0x6c, 0x1e, 0x4b, 0xaf, 0xee, 0xa3, 0x52, 0x7d,
+	0xa0, 0x7e, 0x71, 0x13, 0x42, 0x25, 0x93, 0xbb, 0x63, 0xbe, 0x50, 0xa4, 0x15, 0x77, 0xdf, 0x00,
+	0x71, 0xf7, 0xbf, 0xe0, 0xd2, 0x9f, 0x73, 0x84, 0x32, 0x6a, 0x85, 0xd2, 0xe1, 0x43, 0x7e, 0x09,
+	0xb8, 0xd6, 0xb7, 0x34, 0x80, 0x56, 0x30, 0x18, 0x5b, 0x30, 0x3e, 0x22, 0xc1, 0xc8, 0xcb, 0x52,
+	0x4d, 0x78, 0x09, 0xc1, 0xe8, 0xaa, 0x7e, 0xcd, 0x88, 0x8d, 0xf4, 0x1c, 0xb1, 0xf6, 0x8e, 0x73,
+	0xd8, 0x6b, 0x3a, 0xce, 0xf1, 0xd6, 0xd3, 0x71, 0xbf, 0x30, 0x70, 0x2e, 0x8f, 0xa5, 0xcd, 0xaa,
+	0x28, 0x68, 0x68, 0xc5, 0x68, 0x46, 0xbd, 0x3b, 0xed, 0xbf, 0x10, 0x56, 0xd0, 0x5e, 0xb1, 0xbb,
+	0x96, 0x17, 0x52, 0xd0, 0x1e, 0x39, 0xc8, 0xee, 0x6b, 0x5f, 0xb7, 0xbe, 0xce, 0x5e, 0x75, 0x38,
+	0xe3, 0x82, 0xe5, 0x0c, 0x9b, 0x0d, 0x7c, 0x0c, 0x26, 0x9b, 0xdf, 0x58, 0x4e, 0xe0, 0xbf, 0x60,
+	0xe0, 0x5f, 0x79, 0x2c, 0xad, 0x96, 0x91, 0x50, 0xeb, 0xd7, 0xde, 0xfe, 0x14, 0xe7, 0x1d, 0x8a,
+	0xb3, 0x96, 0xe2, 0x0d, 0x5d, 0xf8, 0x28, 0x5c, 0x6c, 0x7a, 0x41, 0xd5, 0x7e, 0x38, 0x0c, 0x1c,
+	0xb5, 0xa8, 0xb9, 0xbf, 0x6d, 0xcb, 0x52, 0x1f, 0x36, 0xd8, 0x52, 0x76, 0xb8, 0x6d, 0xca, 0xde,
+	0x07, 0x4e, 0x0f, 0x6c, 0x9b, 0x71, 0xcf, 0xd7, 0xd5, 0xb8, 0x17, 0x53, 0xd0, 0xde, 0x9a, 0xeb,
+	0xc4, 0x97, 0x76, 0x38, 0x24, 0xd1, 0x1c, 0xc9, 0x16, 0x2b, 0xf9, 0x6b, 0xc0, 0xb7, 0xa7, 0x52,
+	0x57, 0x7d, 0xcb, 0xc0, 0x18, 0x85, 0xad, 0x0b, 0x35, 0xa1, 0x82, 0xd9, 0x5b, 0x10, 0x16, 0xea,
+	0xda, 0x8e, 0x5a, 0x93, 0xb5, 0x83, 0x8e, 0x2e, 0x6a, 0x40, 0xd9, 0xff, 0x43, 0xa0, 0x6a, 0x48,
+	0x30, 0x9c, 0x34, 0x9a, 0x89, 0xb5, 0x1a, 0x4b, 0x4e, 0xc8, 0x85, 0xf5, 0x5e, 0x49, 0xda, 0x9d,
+	0xc9, 0x42, 0xca, 0xb6, 0x21, 0x4c, 0x37, 0x71, 0xa2, 0xd9, 0x44, 0xc2, 0xcb, 0x4f, 0x41, 0xd4,
+	0xf1, 0x8a, 0x1a, 0x73, 0x42, 0x8c, 0xd9, 0xa8, 0x8b, 0x2a, 0xed, 0x6a, 0xfd, 0x1a, 0xf3, 0x82,
+	0x7f, 0x68, 0x3c, 0xed, 0xb7, 0x1b, 0xc4, 0xdf, 0x80, 0xa8, 0xe3, 0x95, 0x67, 0xcf, 0xfa, 0x92,
+	0x81, 0xd1, 0x3c, 0x96, 0xd6, 0x65, 0x45, 0x4f, 0xd7, 0xfe, 0x83, 0x7b, 0x07, 0x42, 0x66, 0x09,
+	0xe8, 0xe1, 0xf5, 0x25, 0x47, 0x72, 0xf1, 0x93, 0xe3, 0x44, 0x90, 0xd4, 0x00, 0x7e, 0x7e, 0x9c,
+	0x18, 0x3b, 0x10, 0x2a, 0xe5, 0x2c, 0x6f, 0x81, 0xf8, 0x42, 0x90, 0xd4, 0x05, 0x26, 0x4d, 0xa8,
+	0xd9, 0xb4, 0x71, 0xcb, 0x34, 0x4b, 0x2f, 0xfe, 0x22, 0x5c, 0xb0, 0x3d, 0xd2, 0x90, 0x7e, 0x4d,
+	0x3a, 0xd0, 0xa6, 0x52, 0x7d, 0x89, 0x06, 0x5c, 0x6f, 0x35, 0x80, 0xf6, 0xa3, 0x86, 0x66, 0x66,
+	0x3f, 0x6a, 0xbc, 0xa0, 0x46, 0x7c, 0xec, 0x87, 0xb8, 0xb5, 0x8b, 0xad, 0x28, 0xa2, 0xdb, 0xe6,
+	0xd4, 0xaf, 0x55, 0xad, 0x7b, 0xa9, 0x6f, 0xc0, 0xbd, 0x74, 0x64, 0x80, 0xbd, 0x94, 0xbd, 0x0c,
+	0x50, 0xd7, 0xed, 0x27, 0xaa, 0xf8, 0x8d, 0xe1, 0x34, 0x5c, 0xb7, 0x3c, 0xd2, 0x18, 0xf5, 0x03,
+	0xdd, 0x8d, 0xfa, 0x74, 0x8a, 0x0f, 0xba, 0x4c, 0xf1, 0xa1, 0x01, 0xa6, 0xb9, 0xf0, 0x0b, 0x9e,
+	0xe2, 0x27, 0x21, 0x80, 0xd5, 0x7a, 0xad, 0x84, 0x62, 0x60, 0x58, 0x62, 0x3e, 0xb1, 0x31, 0x08,
+	0x6e, 0xd5, 0xe5, 0xb2, 0xfe, 0x5b, 0x34, 0x6a, 0x10, 0xac, 0x47, 0x76, 0x1a, 0xc2, 0x46, 0x26,
+	0xee, 0x08, 0x78, 0x27, 0x16, 0x31, 0x57, 0x70, 0x55, 0x44, 0x6f, 0x08, 0x78, 0x27, 0x7b, 0xab,
+	0x35, 0x21, 0xaf, 0x36, 0xdd, 0x00, 0xb8, 0x67, 0x19, 0x5f, 0x85, 0x59, 0x6f, 0xc4, 0x99, 0x0f,
+	0xfe, 0x3f, 0x30, 0xc6, 0x92, 0xb1, 0x22, 0x8a, 0x7a, 0x02, 0x6c, 0x56, 0xcb, 0xaa, 0x20, 0x92,
+	0xae, 0x6d, 0x0a, 0x19, 0xa0, 0xa2, 0x33, 0x10, 0x16, 0x2c, 0x21, 0x46, 0x49, 0x87, 0x73, 0x13,
+	0xcf, 0x8f, 0x13, 0xe3, 0xa4, 0x8e, 0x29, 0x89, 0x2f, 0x34, 0x60, 0xd9, 0xff, 0xb5, 0x7a, 0xee,
+	0x9a, 0xe5, 0x39, 0x2f, 0x25, 0xf9, 0x79, 0x98, 0xeb, 0x00, 0xa1, 0xe5, 0xfe, 0x13, 0x63, 0xfc,
+	0xf4, 0x16, 0x50, 0x45, 0xdd, 0x45, 0x7f, 0x0f, 0xb3, 0xb3, 0xad, 0x66, 0xcf, 0x59, 0x66, 0x77,
+	0xd0, 0x93, 0x5f, 0x84, 0x85, 0xce, 0x28, 0x6a, 0xfc, 0x1f, 0x64, 0xf6, 0xb2, 0x72, 0xcc, 0xb9,
+	0x64, 0x9c, 0x5d, 0x9f, 0x1b, 0xf4, 0xfe, 0xcd, 0x37, 0x48, 0x9f, 0xe3, 0x6c, 0xd3, 0x01, 0xb9,
+	0x61, 0x68, 0x99, 0x01, 0x7a, 0xbf, 0x64, 0xc8, 0x66, 0x5a, 0xa3, 0x94, 0x70, 0x96, 0xb5, 0x73,
+	0x8b, 0x39, 0x00, 0xbe, 0x3d, 0xf5, 0xcc, 0x2e, 0xfd, 0x68, 0x6d, 0xfb, 0x6c, 0xb5, 0xfd, 0x23,
+	0x63, 0x5b, 0x1c, 0xac, 0x23, 0xdf, 0x32, 0x5a, 0x74, 0xef, 0x23, 0xf6, 0x34, 0x59, 0x8b, 0x48,
+	0xbb, 0x1f, 0x26, 0x2e, 0x55, 0xd0, 0x1e, 0x11, 0xd7, 0xdf, 0x0e, 0xd1, 0xf6, 0xf6, 0xcc, 0x45,
+	0x63, 0x7e, 0x06, 0xe2, 0xee, 0x14, 0x9a, 0xd9, 0x8f, 0x86, 0x0d, 0x73, 0x37, 0x90, 0xf6, 0xba,
+	0x80, 0xcb, 0x24, 0x45, 0x0c, 0x58, 0xff, 0xa5, 0xfc, 0xa6, 0xde, 0xe4, 0x4d, 0x21, 0x66, 0x29,
+	0x2f, 0x36, 0x4a, 0x99, 0x92, 0xf8, 0xf6, 0xb2, 0x28, 0x86, 0xcd, 0x41, 0xa0, 0x64, 0xe4, 0xac,
+	0x99, 0xd9, 0x89, 0xd6, 0xcc, 0x6e, 0xe8, 0xbd, 0x2d, 0x4b, 0x4d, 0x43, 0x38, 0xe1, 0xcc, 0xa6,
+	0x5a, 0x13, 0x90, 0x3a, 0xcd, 0xc5, 0x6e, 0xd3, 0x69, 0x2e, 0x14, 0xcb, 0x69, 0x99, 0xa3, 0x31,
+	0xf0, 0xe5, 0xb1, 0xc4, 0x6e, 0x40, 0xb8, 0x71, 0xfd, 0xee, 0x52, 0x74, 0xf6, 0xab, 0x6a, 0x6e,
+	0xd6, 0x9b, 0x4e, 0xb3, 0xfa, 0x7d, 0xb8, 0xe0, 0x36, 0x4b, 0x25, 0x5d, 0xd9, 0x5d, 0x90, 0xdc,
+	0x52, 0xb7, 0x48, 0x7a, 0xa4, 0x06, 0x13, 0xae, 0xd7, 0x9e, 0xf3, 0xdd, 0x4a, 0xca, 0x70, 0xcb,
+	0x5d, 0x43, 0xe9, 0xa9, 0x08, 0xc6, 0x9c, 0x57, 0x67, 0xd7, 0x5c, 0xa5, 0x38, 0x50, 0xdc, 0x62,
+	0x37, 0x28, 0xfb, 0x31, 0xce, 0x7e, 0xed, 0x7e, 0x8c, 0x03, 0xc5, 0x2d, 0x76, 0x83, 0xa2, 0xc7,
+	0xbc, 0x03, 0xa3, 0xf6, 0x2b, 0x94, 0x19, 0x57, 0x66, 0x1b, 0x82, 0x4b, 0x76, 0x42, 0x50, 0xd1,
+	0x6f, 0x03, 0xd8, 0x2e, 0x2b, 0x12, 0xae, 0x7c, 0x0d, 0x00, 0x37, 0xd7, 0x01, 0x40, 0xe5, 0x7e,
+	0x00, 0xd1, 0x76, 0xb7, 0x09, 0x8b, 0x1e, 0xca, 0xb5, 0xa0, 0xb9, 0x9b, 0xbd, 0xa0, 0xe9, 0xf1,
+	0xf7, 0x21, 0xd2, 0xb4, 0xa1, 0x5f, 0xf1, 0x90, 0x42, 0x20, 0xdc, 0x7c, 0x47, 0x88, 0x5d, 0x7a,
+	0xd3, 0xca, 0xec, 0x2e, 0xdd, 0x0e, 0xe1, 0xe6, 0x3b, 0x42, 0xa8, 0xf4, 0x75, 0x08, 0xd1, 0xe5,
+	0xf3, 0xb2, 0x2b, 0x9b, 0x45, 0xe6, 0xae, 0x7b, 0x92, 0xed, 0x41, 0xb6, 0xed, 0x83, 0xee, 0x41,
+	0x6e, 0x00, 0xb8, 0xb9, 0x0e, 0x00, 0x2a, 0xf7, 0x13, 0x06, 0xa6, 0xbd, 0x76, 0xb4, 0xa5, 0xf6,
+	0x6d, 0xc9, 0x9d, 0x83, 0xbb, 0xdd, 0x2b, 0x07, 0xd5, 0xe5, 0x31, 0x03, 0x89, 0x4e, 0x03, 0xa4,
+	0x7b, 0x2e, 0x75, 0xe0, 0xe2, 0x5e, 0xe9, 0x87, 0x8b, 0xea, 0xf5, 0x19, 0x03, 0x97, 0x3c, 0x87,
+	0x79, 0xf7, 0xee, 0xe6, 0xc5, 0xc2, 0xdd, 0xe9, 0x99, 0xc5, 0x5e, 0x97, 0xed, 0x26, 0xcd, 0x45,
+	0x4f, 0xdf, 0x3b, 0x3b, 0xd8, 0xcd, 0x5e, 0xd0, 0xf6, 0x1f, 0x20, 0xb7, 0xe9, 0xc7, 0xab, 0x5f,
+	0x35, 0x21, 0xb9, 0xa5, 0x6e, 0x91, 0xf6, 0x23, 0xdd, 0x26, 0x10, 0xf7, 0x23, 0x5d, 0x90, 0xdc,
+	0x52, 0xb7, 0x48, 0xeb, 0x48, 0xce, 0xff, 0xa1, 0x3e, 0x24, 0xe4, 0xee, 0x1e, 0xfd, 0x1e, 0x1f,
+	0x3a, 0x3a, 0x89, 0x33, 0x4f, 0x4e, 0xe2, 0xcc, 0x6f, 0x27, 0x71, 0xe6, 0xd1, 0xb3, 0xf8, 0xd0,
+	0x93, 0x67, 0xf1, 0xa1, 0xa7, 0xcf, 0xe2, 0x43, 0xef, 0xce, 0xda, 0x16, 0xe5, 0x55, 0x15, 0x57,
+	0xee, 0x59, 0xff, 0xba, 0x17, 0xd3, 0xfb, 0xc6, 0x5f, 0xb2, 0x2c, 0x6f, 0x05, 0x8c, 0x7f, 0xc9,
+	0xff, 0xe7, 0xaf, 0x01, 0x00, 0x54, 0x22, 0xa9, 0xcb, 0x5c, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2437,6 +2439,7 @@ func _Msg_SetGaslessContracts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -3944,6 +3947,16 @@ func (m *MsgSetGaslessContracts) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Contracts[iNdEx])
@@ -4643,6 +4656,8 @@ func (m *MsgSetGaslessContracts) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -9035,6 +9050,39 @@ func (m *MsgSetGaslessContracts) Unmarshal(dAtA []byte) error {
 			}
 			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
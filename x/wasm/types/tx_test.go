@@ -993,6 +993,145 @@ func TestMsgPinCodesValidation(t *testing.T) {
 	}
 }
 
+func TestMsgSetGaslessContractsValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	contract := sdk.AccAddress(make([]byte, 32)).String()
+	specs := map[string]struct {
+		src    MsgSetGaslessContracts
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    DefaultGaslessConfig(),
+			},
+		},
+		"with sender limit": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, MaxGasPerSenderPerBlock: 10},
+			},
+		},
+		"with sponsor": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, Sponsor: goodAddress},
+			},
+		},
+		"with allowed messages and expiry": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, AllowedMessages: []string{"claim", "stake"}, ExpiryHeight: 100},
+			},
+		},
+		"empty allowed message": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, AllowedMessages: []string{""}},
+			},
+			expErr: true,
+		},
+		"duplicate allowed messages": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, AllowedMessages: []string{"claim", "claim"}},
+			},
+			expErr: true,
+		},
+		"bad sponsor": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, Sponsor: badAddress},
+			},
+			expErr: true,
+		},
+		"bad authority": {
+			src: MsgSetGaslessContracts{
+				Authority: badAddress,
+				Contracts: []string{contract},
+				Config:    DefaultGaslessConfig(),
+			},
+			expErr: true,
+		},
+		"duplicate contracts": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract, contract},
+				Config:    DefaultGaslessConfig(),
+			},
+			expErr: true,
+		},
+		"empty config": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+			},
+		},
+		"block limit below default execution limit": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerBlock: 100},
+			},
+			expErr: true,
+		},
+		"block limit below execution limit": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 9},
+			},
+			expErr: true,
+		},
+		"sender limit below execution limit": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, MaxGasPerSenderPerBlock: 9},
+			},
+			expErr: true,
+		},
+		"sender limit above block limit": {
+			src: MsgSetGaslessContracts{
+				Authority: goodAddress,
+				Contracts: []string{contract},
+				Config:    GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100, MaxGasPerSenderPerBlock: 101},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetGaslessContractsGaslessConfig(t *testing.T) {
+	// unset gas limits fall back to the defaults like for the legacy proposal
+	msg := MsgSetGaslessContracts{Config: GaslessConfig{MaxGasPerSenderPerBlock: 5, ExpiryHeight: 7}}
+	exp := DefaultGaslessConfig()
+	exp.MaxGasPerSenderPerBlock, exp.ExpiryHeight = 5, 7
+	assert.Equal(t, exp, msg.GaslessConfig())
+	assert.Equal(t, SetGasLessContractsProposal{ExpiryHeight: 7}.GaslessConfig(), MsgSetGaslessContracts{Config: GaslessConfig{ExpiryHeight: 7}}.GaslessConfig())
+
+	config := GaslessConfig{MaxGasPerExecution: 10, MaxGasPerBlock: 100}
+	assert.Equal(t, config, MsgSetGaslessContracts{Config: config}.GaslessConfig())
+}
+
 func TestMsgUnpinCodesValidation(t *testing.T) {
 	// proper address size
 	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
@@ -432,6 +432,103 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// GaslessConfig is the configuration of a gasless contract, which bounds the
+// gas its executions can use without being charged for it.
+type GaslessConfig struct {
+	// MaxGasPerExecution is the gas limit of a single execution
+	MaxGasPerExecution uint64 `protobuf:"varint,1,opt,name=max_gas_per_execution,json=maxGasPerExecution,proto3" json:"max_gas_per_execution,omitempty"`
+	// MaxGasPerBlock is the gas all executions of the contract can use in a
+	// block
+	MaxGasPerBlock uint64 `protobuf:"varint,2,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty"`
+	// MaxGasPerSenderPerBlock is the gas the executions of a single sender can
+	// use in a block, zero for no limit
+	MaxGasPerSenderPerBlock uint64 `protobuf:"varint,3,opt,name=max_gas_per_sender_per_block,json=maxGasPerSenderPerBlock,proto3" json:"max_gas_per_sender_per_block,omitempty"`
+	// Sponsor is the account charged the fees of transactions that only execute
+	// the contract. When empty, the min fee of these transactions is waived.
+	Sponsor string `protobuf:"bytes,4,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// AllowedMessages are the top-level keys of the execute messages that are
+	// gasless, all messages when empty
+	AllowedMessages []string `protobuf:"bytes,5,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// ExpiryHeight is the last block height in which executions are gasless,
+	// zero for no expiry. Later executions are metered as usual.
+	ExpiryHeight uint64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *GaslessConfig) Reset()         { *m = GaslessConfig{} }
+func (m *GaslessConfig) String() string { return proto.CompactTextString(m) }
+func (*GaslessConfig) ProtoMessage()    {}
+func (*GaslessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{8}
+}
+func (m *GaslessConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessConfig.Merge(m, src)
+}
+func (m *GaslessConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessConfig proto.InternalMessageInfo
+
+// GaslessGasUsage is the gas used by the gasless executions of a contract in
+// an epoch
+type GaslessGasUsage struct {
+	// Epoch is the block height divided by the epoch length
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// GasUsed is the gas the executions used, including failed ones
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *GaslessGasUsage) Reset()         { *m = GaslessGasUsage{} }
+func (m *GaslessGasUsage) String() string { return proto.CompactTextString(m) }
+func (*GaslessGasUsage) ProtoMessage()    {}
+func (*GaslessGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{9}
+}
+func (m *GaslessGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaslessGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaslessGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaslessGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaslessGasUsage.Merge(m, src)
+}
+func (m *GaslessGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaslessGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaslessGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaslessGasUsage proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
@@ -443,87 +540,98 @@ func init() {
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*GaslessConfig)(nil), "cosmwasm.wasm.v1.GaslessConfig")
+	proto.RegisterType((*GaslessGasUsage)(nil), "cosmwasm.wasm.v1.GaslessGasUsage")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0xf2, 0xfd, 0xb2, 0x1d, 0x52, 0xd5, 0x31, 0x91, 0x6d, 0x96,
-	0x12, 0xda, 0xb4, 0xb5, 0xdb, 0x80, 0x2a, 0xd4, 0x43, 0x25, 0xff, 0xd8, 0x36, 0x5b, 0x29, 0xb6,
-	0x35, 0x76, 0x29, 0x41, 0x2a, 0xab, 0xf5, 0xee, 0xd8, 0x19, 0x6a, 0xef, 0x58, 0x3b, 0xe3, 0xd4,
-	0xbe, 0x72, 0x42, 0x46, 0x48, 0x1c, 0x38, 0x20, 0x24, 0x4b, 0x48, 0x20, 0xe8, 0xb1, 0x87, 0xfe,
-	0x03, 0xdc, 0x2a, 0x4e, 0x15, 0x27, 0x4e, 0x16, 0xa4, 0x87, 0x72, 0xce, 0x81, 0x43, 0x4f, 0x68,
-	0x67, 0x62, 0x76, 0x45, 0x9b, 0xc6, 0x70, 0x59, 0xcd, 0xbc, 0xf7, 0x3e, 0x9f, 0xf7, 0xde, 0x67,
-	0x66, 0xdf, 0x2e, 0x58, 0xb7, 0x29, 0xeb, 0xdd, 0xb7, 0x58, 0xaf, 0x20, 0x1e, 0xfb, 0x57, 0x0a,
-	0x7c, 0xd4, 0xc7, 0x2c, 0xdf, 0xf7, 0x28, 0xa7, 0x50, 0x9d, 0x79, 0xf3, 0xe2, 0xb1, 0x7f, 0x25,
-	0xbd, 0xe6, 0x5b, 0x28, 0x33, 0x85, 0xbf, 0x20, 0x37, 0x32, 0x38, 0xbd, 0xda, 0xa1, 0x1d, 0x2a,
-	0xed, 0xfe, 0xea, 0xc8, 0xba, 0xd6, 0xa1, 0xb4, 0xd3, 0xc5, 0x05, 0xb1, 0x6b, 0x0d, 0xda, 0x05,
-	0xcb, 0x1d, 0x1d, 0xb9, 0x4e, 0x59, 0x3d, 0xe2, 0xd2, 0x82, 0x78, 0x4a, 0x93, 0x76, 0x17, 0xbc,
-	0x56, 0xb4, 0x6d, 0xcc, 0x58, 0x73, 0xd4, 0xc7, 0x75, 0xcb, 0xb3, 0x7a, 0xb0, 0x02, 0x16, 0xf6,
-	0xad, 0xee, 0x00, 0xa7, 0x94, 0x9c, 0x72, 0xee, 0xff, 0x5b, 0xeb, 0xf9, 0x7f, 0xd6, 0x94, 0x0f,
-	0x10, 0x25, 0xf5, 0x70, 0x9a, 0x5d, 0x19, 0x59, 0xbd, 0xee, 0x35, 0x4d, 0x80, 0x34, 0x24, 0xc1,
-	0xd7, 0xe2, 0x5f, 0x7f, 0x9b, 0x55, 0xb4, 0x1f, 0x15, 0xb0, 0x22, 0xa3, 0xcb, 0xd4, 0x6d, 0x93,
-	0x0e, 0x6c, 0x00, 0xd0, 0xc7, 0x5e, 0x8f, 0x30, 0x46, 0xa8, 0x3b, 0x57, 0x86, 0xd3, 0x87, 0xd3,
-	0xec, 0x29, 0x99, 0x21, 0x40, 0x6a, 0x28, 0x44, 0x03, 0xaf, 0x82, 0xa4, 0xe5, 0x38, 0x1e, 0x66,
-	0x0c, 0xb3, 0x54, 0x2c, 0x17, 0x3b, 0x97, 0x2c, 0xa5, 0x7e, 0x79, 0x74, 0x69, 0xf5, 0x48, 0xad,
-	0xa2, 0xf4, 0x35, 0xb8, 0x47, 0xdc, 0x0e, 0x0a, 0x42, 0x65, 0x8d, 0xb7, 0xe2, 0x89, 0xa8, 0x1a,
-	0xd3, 0xbe, 0x8a, 0x82, 0x45, 0xd1, 0x3f, 0x83, 0x1c, 0x40, 0x9b, 0x3a, 0xd8, 0x1c, 0xf4, 0xbb,
-	0xd4, 0x72, 0x4c, 0x4b, 0xd4, 0x22, 0x6a, 0x5d, 0xde, 0xca, 0x1c, 0x57, 0xab, 0xec, 0xaf, 0xb4,
-	0xf1, 0x78, 0x9a, 0x8d, 0x1c, 0x4e, 0xb3, 0x6b, 0xb2, 0xe2, 0x17, 0x79, 0xb4, 0x07, 0xcf, 0x1e,
-	0x6e, 0x2a, 0x48, 0xf5, 0x3d, 0xb7, 0x85, 0x43, 0xe2, 0xe1, 0x17, 0x0a, 0xc8, 0x10, 0x97, 0x71,
-	0xcb, 0xe5, 0xc4, 0xe2, 0xd8, 0x74, 0x70, 0xdb, 0x1a, 0x74, 0xb9, 0x19, 0x92, 0x2b, 0x3a, 0x87,
-	0x5c, 0xe7, 0x0f, 0xa7, 0xd9, 0xb7, 0x65, 0xf2, 0x57, 0xb3, 0x69, 0x68, 0x3d, 0x14, 0x50, 0x91,
-	0xfe, 0xfa, 0xdf, 0x6e, 0x21, 0x4e, 0x44, 0xfb, 0x49, 0x01, 0x89, 0x32, 0x75, 0xb0, 0xe1, 0xb6,
-	0x29, 0x7c, 0x03, 0x24, 0x45, 0x43, 0x7b, 0x16, 0xdb, 0x13, 0x7a, 0xac, 0xa0, 0x84, 0x6f, 0xd8,
-	0xb6, 0xd8, 0x1e, 0xdc, 0x02, 0x4b, 0xb6, 0x87, 0x2d, 0x4e, 0x3d, 0x51, 0xe7, 0xab, 0x8e, 0x60,
-	0x16, 0x08, 0x3f, 0x04, 0x30, 0x5c, 0xa4, 0x2d, 0x34, 0x4c, 0x2d, 0xcc, 0xa5, 0x74, 0xd2, 0x57,
-	0x5a, 0x8a, 0x79, 0x2a, 0x44, 0x22, 0xbd, 0xb7, 0xe2, 0x89, 0x98, 0x1a, 0xbf, 0x15, 0x4f, 0xc4,
-	0xd5, 0x05, 0xed, 0xd3, 0x18, 0x58, 0x29, 0x53, 0x97, 0x7b, 0x96, 0xcd, 0x45, 0x1f, 0x6f, 0x81,
-	0x25, 0xd1, 0x07, 0x71, 0x44, 0x17, 0xf1, 0x12, 0x38, 0x98, 0x66, 0x17, 0x45, 0x9b, 0x15, 0xb4,
-	0xe8, 0xbb, 0x0c, 0xe7, 0x3f, 0xf5, 0x93, 0x07, 0x0b, 0x96, 0xd3, 0x23, 0x6e, 0x2a, 0x76, 0x02,
-	0x42, 0x86, 0xc1, 0x55, 0xb0, 0xd0, 0xb5, 0x5a, 0xb8, 0x9b, 0x8a, 0xfb, 0xf1, 0x48, 0x6e, 0xe0,
-	0xf5, 0xa3, 0xcc, 0xd8, 0x39, 0x92, 0xe2, 0xec, 0x4b, 0xa4, 0x68, 0x31, 0xda, 0x1d, 0x70, 0xdc,
-	0x1c, 0xd6, 0x29, 0x23, 0x9c, 0x50, 0x17, 0xcd, 0x40, 0xf0, 0x12, 0x58, 0x26, 0x2d, 0xdb, 0xec,
-	0x53, 0x8f, 0xfb, 0x2d, 0x2e, 0x8a, 0x5a, 0xfe, 0x77, 0x30, 0xcd, 0x26, 0x8d, 0x52, 0xb9, 0x4e,
-	0x3d, 0x6e, 0x54, 0x50, 0x92, 0xb4, 0x6c, 0xb1, 0x74, 0xe0, 0xc7, 0x20, 0x89, 0x87, 0x1c, 0xbb,
-	0xe2, 0x8a, 0x2d, 0x89, 0x84, 0xab, 0x79, 0x39, 0x44, 0xf2, 0xb3, 0x21, 0x92, 0x2f, 0xba, 0xa3,
-	0xd2, 0xe6, 0xcf, 0x8f, 0x2e, 0x6d, 0xbc, 0x50, 0x49, 0x58, 0x59, 0x7d, 0xc6, 0x83, 0x02, 0xca,
-	0x6b, 0xf1, 0x3f, 0xfc, 0x49, 0xf0, 0x79, 0x14, 0xa4, 0x66, 0xa1, 0xbe, 0xd2, 0xdb, 0x84, 0x71,
-	0xea, 0x8d, 0x74, 0x97, 0x7b, 0x23, 0x58, 0x07, 0x49, 0xda, 0xc7, 0x9e, 0xc5, 0x83, 0xa1, 0xb0,
-	0x95, 0x3f, 0x36, 0x53, 0x08, 0x5e, 0x9b, 0xa1, 0xfc, 0xbb, 0x8f, 0x02, 0x92, 0xf0, 0x11, 0x47,
-	0x8f, 0x3d, 0xe2, 0xeb, 0x60, 0x69, 0xd0, 0x77, 0x84, 0xd0, 0xb1, 0x7f, 0x23, 0xf4, 0x11, 0x08,
-	0xbe, 0x0f, 0x62, 0x3d, 0xd6, 0x11, 0x87, 0xb7, 0x52, 0xda, 0x78, 0x3e, 0xcd, 0x42, 0x64, 0xdd,
-	0x9f, 0x55, 0xb9, 0x83, 0x19, 0xb3, 0x3a, 0xf8, 0x9b, 0x67, 0x0f, 0x37, 0x97, 0x89, 0xdb, 0x25,
-	0x2e, 0x36, 0x3f, 0x61, 0xd4, 0x45, 0x3e, 0x44, 0x43, 0x00, 0xbe, 0x48, 0x0c, 0xdf, 0x04, 0x2b,
-	0xad, 0x2e, 0xb5, 0xef, 0x99, 0x7b, 0x98, 0x74, 0xf6, 0xb8, 0xbc, 0x9c, 0x68, 0x59, 0xd8, 0xb6,
-	0x85, 0x09, 0xae, 0x81, 0x04, 0x1f, 0x9a, 0xc4, 0x75, 0xf0, 0x50, 0x36, 0x86, 0x96, 0xf8, 0xd0,
-	0xf0, 0xb7, 0x1a, 0x06, 0x0b, 0x3b, 0xd4, 0xc1, 0x5d, 0x78, 0x03, 0xc4, 0xee, 0xe1, 0x91, 0x7c,
-	0x41, 0x4b, 0xef, 0x3d, 0x9f, 0x66, 0x2f, 0x77, 0x08, 0xdf, 0x1b, 0xb4, 0xf2, 0x36, 0xed, 0x15,
-	0x6c, 0xda, 0xc3, 0xbc, 0xd5, 0xe6, 0xc1, 0xa2, 0x4b, 0x5a, 0xac, 0xd0, 0x1a, 0x71, 0xcc, 0xf2,
-	0xdb, 0x78, 0x58, 0xf2, 0x17, 0xc8, 0x27, 0xf0, 0x6f, 0xa7, 0xfc, 0x10, 0x44, 0xc5, 0xab, 0x2e,
-	0x37, 0x9b, 0x7f, 0x2a, 0x00, 0x04, 0xf3, 0x06, 0x5e, 0x05, 0x67, 0x8a, 0xe5, 0xb2, 0xde, 0x68,
-	0x98, 0xcd, 0xdd, 0xba, 0x6e, 0xde, 0xae, 0x36, 0xea, 0x7a, 0xd9, 0xb8, 0x61, 0xe8, 0x15, 0x35,
-	0x92, 0x5e, 0x1b, 0x4f, 0x72, 0xa7, 0x83, 0xe0, 0xdb, 0x2e, 0xeb, 0x63, 0x9b, 0xb4, 0x09, 0x76,
-	0xe0, 0x45, 0x00, 0xc3, 0xb8, 0x6a, 0xad, 0x54, 0xab, 0xec, 0xaa, 0x4a, 0x7a, 0x75, 0x3c, 0xc9,
-	0xa9, 0x01, 0xa4, 0x4a, 0x5b, 0xd4, 0x19, 0xc1, 0x2d, 0x70, 0x3a, 0x1c, 0xad, 0x7f, 0xa0, 0xa3,
-	0x5d, 0x01, 0x88, 0xa5, 0xcf, 0x8c, 0x27, 0xb9, 0xd7, 0x03, 0x80, 0xbe, 0x8f, 0xbd, 0x91, 0xc0,
-	0x5c, 0x07, 0xeb, 0x61, 0x4c, 0xb1, 0xba, 0x6b, 0xd6, 0x6e, 0x98, 0xc5, 0x4a, 0x05, 0xe9, 0x8d,
-	0x86, 0xde, 0x50, 0xe3, 0xe9, 0xf5, 0xf1, 0x24, 0x97, 0x0a, 0xa0, 0x45, 0x77, 0x54, 0x6b, 0x17,
-	0x67, 0x5f, 0x87, 0x74, 0xe2, 0xb3, 0xef, 0x32, 0x91, 0x07, 0xdf, 0x67, 0x22, 0x9a, 0xff, 0x85,
-	0x88, 0x6e, 0xfe, 0x10, 0x03, 0xb9, 0x93, 0xae, 0x20, 0xc4, 0xe0, 0x72, 0xb9, 0x56, 0x6d, 0xa2,
-	0x62, 0xb9, 0x69, 0x96, 0x6b, 0x15, 0xdd, 0xdc, 0x36, 0x1a, 0xcd, 0x1a, 0xda, 0x35, 0x6b, 0x75,
-	0x1d, 0x15, 0x9b, 0x46, 0xad, 0xfa, 0x32, 0x9d, 0x0a, 0xe3, 0x49, 0xee, 0xc2, 0x49, 0xdc, 0x61,
-	0xf5, 0xee, 0x80, 0xf3, 0x73, 0xa5, 0x31, 0xaa, 0x46, 0x53, 0x55, 0xd2, 0xe7, 0xc6, 0x93, 0xdc,
-	0xd9, 0x93, 0xf8, 0x0d, 0x97, 0x70, 0x78, 0x17, 0x5c, 0x9c, 0x8b, 0x78, 0xc7, 0xb8, 0x89, 0x8a,
-	0x4d, 0x5d, 0x8d, 0xa6, 0x2f, 0x8c, 0x27, 0xb9, 0x77, 0x4e, 0xe2, 0xde, 0x21, 0x1d, 0xcf, 0xe2,
-	0x78, 0x6e, 0xfa, 0x9b, 0x7a, 0x55, 0x6f, 0x18, 0x0d, 0x35, 0x36, 0x1f, 0xfd, 0x4d, 0xec, 0x62,
-	0x46, 0x58, 0x3a, 0xee, 0x1f, 0x59, 0x69, 0xfb, 0xf1, 0xef, 0x99, 0xc8, 0x83, 0x83, 0x8c, 0xf2,
-	0xf8, 0x20, 0xa3, 0x3c, 0x39, 0xc8, 0x28, 0xbf, 0x1d, 0x64, 0x94, 0x2f, 0x9f, 0x66, 0x22, 0x4f,
-	0x9e, 0x66, 0x22, 0xbf, 0x3e, 0xcd, 0x44, 0x3e, 0xda, 0x08, 0xbd, 0x10, 0x65, 0xca, 0x7a, 0x77,
-	0x66, 0xff, 0x63, 0x4e, 0x61, 0x28, 0xff, 0xcb, 0xc4, 0x4f, 0x59, 0x6b, 0x51, 0xcc, 0xbf, 0x77,
-	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xba, 0x63, 0x50, 0xbd, 0xb5, 0x09, 0x00, 0x00,
+	// 1337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x17, 0x2d, 0xf9, 0x43, 0x6b, 0x25, 0x91, 0xf7, 0xd9, 0x88, 0xac, 0x67, 0x48, 0x7a, 0x4a,
+	0x9e, 0x5f, 0xec, 0x24, 0x52, 0xe2, 0x57, 0x04, 0x45, 0x80, 0x06, 0xd0, 0x07, 0x63, 0xf3, 0x60,
+	0x49, 0xa0, 0xe4, 0xa6, 0x2e, 0x90, 0x12, 0x2b, 0x72, 0x25, 0xb1, 0x21, 0xb9, 0x02, 0x77, 0xe5,
+	0x48, 0xd7, 0x9e, 0x0a, 0x15, 0x05, 0x7a, 0xe8, 0xa1, 0x28, 0x20, 0xa0, 0x45, 0x8b, 0x22, 0xc7,
+	0x1c, 0xd2, 0xff, 0x21, 0xe8, 0x29, 0xe8, 0xa9, 0x27, 0xa1, 0x75, 0x0e, 0xe9, 0xb1, 0xf0, 0x31,
+	0xa7, 0x82, 0xbb, 0xa2, 0x45, 0x34, 0x1f, 0x56, 0x7b, 0xa1, 0xb8, 0x33, 0xf3, 0x9b, 0xfd, 0xcd,
+	0x6f, 0x76, 0x87, 0x02, 0x1b, 0x3a, 0xa1, 0xf6, 0x43, 0x44, 0xed, 0x3c, 0x7f, 0x1c, 0xdd, 0xcc,
+	0xb3, 0x41, 0x17, 0xd3, 0x5c, 0xd7, 0x25, 0x8c, 0xc0, 0xb8, 0xef, 0xcd, 0xf1, 0xc7, 0xd1, 0xcd,
+	0xe4, 0xba, 0x67, 0x21, 0x54, 0xe3, 0xfe, 0xbc, 0x58, 0x88, 0xe0, 0xe4, 0x6a, 0x9b, 0xb4, 0x89,
+	0xb0, 0x7b, 0x6f, 0x13, 0xeb, 0x7a, 0x9b, 0x90, 0xb6, 0x85, 0xf3, 0x7c, 0xd5, 0xec, 0xb5, 0xf2,
+	0xc8, 0x19, 0x4c, 0x5c, 0x2b, 0xc8, 0x36, 0x1d, 0x92, 0xe7, 0x4f, 0x61, 0xca, 0xde, 0x07, 0x17,
+	0x0a, 0xba, 0x8e, 0x29, 0x6d, 0x0c, 0xba, 0xb8, 0x86, 0x5c, 0x64, 0xc3, 0x32, 0x98, 0x3f, 0x42,
+	0x56, 0x0f, 0x27, 0xa4, 0x8c, 0x74, 0xe5, 0xfc, 0xce, 0x46, 0xee, 0xaf, 0x9c, 0x72, 0x53, 0x44,
+	0x31, 0x7e, 0x32, 0x4e, 0xc7, 0x06, 0xc8, 0xb6, 0x6e, 0x67, 0x39, 0x28, 0xab, 0x0a, 0xf0, 0xed,
+	0xc8, 0x57, 0xdf, 0xa4, 0xa5, 0xec, 0xb7, 0x12, 0x88, 0x89, 0xe8, 0x12, 0x71, 0x5a, 0x66, 0x1b,
+	0xd6, 0x01, 0xe8, 0x62, 0xd7, 0x36, 0x29, 0x35, 0x89, 0x33, 0xd3, 0x0e, 0x6b, 0x27, 0xe3, 0xf4,
+	0x8a, 0xd8, 0x61, 0x8a, 0xcc, 0xaa, 0x81, 0x34, 0xf0, 0x16, 0x88, 0x22, 0xc3, 0x70, 0x31, 0xa5,
+	0x98, 0x26, 0xc2, 0x99, 0xf0, 0x95, 0x68, 0x31, 0xf1, 0xf3, 0x93, 0xeb, 0xab, 0x13, 0xb5, 0x0a,
+	0xc2, 0x57, 0x67, 0xae, 0xe9, 0xb4, 0xd5, 0x69, 0xe8, 0x84, 0xe3, 0x97, 0x73, 0x60, 0x81, 0x57,
+	0x4e, 0x21, 0x03, 0x50, 0x27, 0x06, 0xd6, 0x7a, 0x5d, 0x8b, 0x20, 0x43, 0x43, 0x9c, 0x05, 0x67,
+	0xb9, 0xbc, 0x93, 0x7a, 0x13, 0x4b, 0x51, 0x59, 0x71, 0xf3, 0xe9, 0x38, 0x1d, 0x3a, 0x19, 0xa7,
+	0xd7, 0x05, 0xd7, 0x57, 0xf3, 0x64, 0x1f, 0xbd, 0x78, 0xbc, 0x2d, 0xa9, 0x71, 0xcf, 0x73, 0xc0,
+	0x1d, 0x02, 0x0f, 0x3f, 0x97, 0x40, 0xca, 0x74, 0x28, 0x43, 0x0e, 0x33, 0x11, 0xc3, 0x9a, 0x81,
+	0x5b, 0xa8, 0x67, 0x31, 0x2d, 0x20, 0xd4, 0xdc, 0x0c, 0x42, 0x6d, 0x9d, 0x8c, 0xd3, 0xff, 0x15,
+	0x9b, 0xbf, 0x3d, 0x5b, 0x56, 0xdd, 0x08, 0x04, 0x94, 0x85, 0xbf, 0x76, 0xea, 0xe6, 0xb2, 0x84,
+	0xb2, 0x3f, 0x4a, 0x60, 0xa9, 0x44, 0x0c, 0xac, 0x38, 0x2d, 0x02, 0xff, 0x0d, 0xa2, 0xbc, 0xa0,
+	0x0e, 0xa2, 0x1d, 0xae, 0x47, 0x4c, 0x5d, 0xf2, 0x0c, 0x7b, 0x88, 0x76, 0xe0, 0x0e, 0x58, 0xd4,
+	0x5d, 0x8c, 0x18, 0x71, 0x39, 0xcf, 0xb7, 0x89, 0xef, 0x07, 0xc2, 0x0f, 0x00, 0x0c, 0x92, 0xd4,
+	0xb9, 0x86, 0x89, 0xf9, 0x99, 0x94, 0x8e, 0x7a, 0x4a, 0x0b, 0x31, 0x57, 0x02, 0x49, 0x84, 0x37,
+	0xfb, 0x49, 0x18, 0xc4, 0x4a, 0xc4, 0x61, 0x2e, 0xd2, 0x19, 0xe7, 0x7e, 0x09, 0x2c, 0x72, 0xee,
+	0xa6, 0xc1, 0x99, 0x47, 0x8a, 0xe0, 0x78, 0x9c, 0x5e, 0xe0, 0xa5, 0x95, 0xd5, 0x05, 0xcf, 0xa5,
+	0x18, 0xff, 0xa8, 0x86, 0x1c, 0x98, 0x47, 0x86, 0x6d, 0x3a, 0x89, 0xf0, 0x19, 0x08, 0x11, 0x06,
+	0x57, 0xc1, 0xbc, 0x85, 0x9a, 0xd8, 0x4a, 0x44, 0xbc, 0x78, 0x55, 0x2c, 0xe0, 0x9d, 0xc9, 0xce,
+	0xd8, 0x98, 0x94, 0x7f, 0xf9, 0x35, 0xe5, 0x37, 0x29, 0xb1, 0x7a, 0x0c, 0x37, 0xfa, 0x35, 0x42,
+	0x4d, 0x66, 0x12, 0x47, 0xf5, 0x41, 0xf0, 0x3a, 0x58, 0x36, 0x9b, 0xba, 0xd6, 0x25, 0x2e, 0xf3,
+	0x4a, 0x5c, 0xe0, 0x5c, 0xce, 0x1d, 0x8f, 0xd3, 0x51, 0xa5, 0x58, 0xaa, 0x11, 0x97, 0x29, 0x65,
+	0x35, 0x6a, 0x36, 0x75, 0xfe, 0x6a, 0xc0, 0x8f, 0x40, 0x14, 0xf7, 0x19, 0x76, 0xf8, 0xb1, 0x5a,
+	0xe4, 0x1b, 0xae, 0xe6, 0xc4, 0xc8, 0xc8, 0xf9, 0x23, 0x23, 0x57, 0x70, 0x06, 0xc5, 0xed, 0x9f,
+	0x9e, 0x5c, 0xdf, 0x7c, 0x85, 0x49, 0x50, 0x59, 0xd9, 0xcf, 0xa3, 0x4e, 0x53, 0xde, 0x8e, 0xfc,
+	0xee, 0xdd, 0xa9, 0xcf, 0xe6, 0x40, 0xc2, 0x0f, 0xf5, 0x94, 0xde, 0x33, 0x29, 0x23, 0xee, 0x40,
+	0x76, 0x98, 0x3b, 0x80, 0x35, 0x10, 0x25, 0x5d, 0xec, 0x22, 0x36, 0x1d, 0x01, 0x3b, 0xb9, 0x37,
+	0xee, 0x14, 0x80, 0x57, 0x7d, 0x94, 0x77, 0xde, 0xd5, 0x69, 0x92, 0x60, 0x8b, 0xe7, 0xde, 0xd8,
+	0xe2, 0x3b, 0x60, 0xb1, 0xd7, 0x35, 0xb8, 0xd0, 0xe1, 0xbf, 0x23, 0xf4, 0x04, 0x04, 0xdf, 0x05,
+	0x61, 0x9b, 0xb6, 0x79, 0xf3, 0x62, 0xc5, 0xcd, 0x97, 0xe3, 0x34, 0x54, 0xd1, 0x43, 0x9f, 0xe5,
+	0x3e, 0xa6, 0x14, 0xb5, 0xf1, 0xd7, 0x2f, 0x1e, 0x6f, 0x2f, 0x9b, 0x8e, 0x65, 0x3a, 0x58, 0xfb,
+	0x98, 0x12, 0x47, 0xf5, 0x20, 0x59, 0x15, 0xc0, 0x57, 0x13, 0xc3, 0xff, 0x80, 0x58, 0xd3, 0x22,
+	0xfa, 0x03, 0xad, 0x83, 0xcd, 0x76, 0x87, 0x89, 0xc3, 0xa9, 0x2e, 0x73, 0xdb, 0x1e, 0x37, 0xc1,
+	0x75, 0xb0, 0xc4, 0xfa, 0x9a, 0xe9, 0x18, 0xb8, 0x2f, 0x0a, 0x53, 0x17, 0x59, 0x5f, 0xf1, 0x96,
+	0x59, 0x0c, 0xe6, 0xf7, 0x89, 0x81, 0x2d, 0x78, 0x17, 0x84, 0x1f, 0xe0, 0x81, 0xb8, 0x94, 0xc5,
+	0x77, 0x5e, 0x8e, 0xd3, 0x37, 0xda, 0x26, 0xeb, 0xf4, 0x9a, 0x39, 0x9d, 0xd8, 0x79, 0x9d, 0xd8,
+	0x98, 0x35, 0x5b, 0x6c, 0xfa, 0x62, 0x99, 0x4d, 0x9a, 0x6f, 0x0e, 0x18, 0xa6, 0xb9, 0x3d, 0xdc,
+	0x2f, 0x7a, 0x2f, 0xaa, 0x97, 0xc0, 0x3b, 0x9d, 0x62, 0xec, 0xcf, 0xf1, 0xeb, 0x2d, 0x16, 0xd9,
+	0xc7, 0x73, 0xe0, 0xdc, 0x2e, 0xa2, 0xd6, 0x74, 0x82, 0xdf, 0x04, 0x6b, 0x36, 0xea, 0x6b, 0x6d,
+	0x44, 0xbd, 0x91, 0xa2, 0xe1, 0x3e, 0xd6, 0x7b, 0xa7, 0x9d, 0x8c, 0xa8, 0xd0, 0x46, 0xfd, 0x5d,
+	0x44, 0x6b, 0xd8, 0x95, 0x7d, 0x0f, 0xdc, 0x02, 0x2b, 0x41, 0x08, 0xaf, 0x70, 0x52, 0xcf, 0xf9,
+	0xd3, 0xf0, 0xa2, 0x67, 0x85, 0xef, 0x81, 0x8d, 0x60, 0x28, 0xc5, 0x8e, 0x81, 0xdd, 0x00, 0x2a,
+	0xcc, 0x51, 0x17, 0x4f, 0x51, 0x75, 0x1e, 0x70, 0x0a, 0xdf, 0x01, 0x8b, 0xb4, 0x4b, 0x1c, 0x4a,
+	0xdc, 0x44, 0xe4, 0x8c, 0x4b, 0xe9, 0x07, 0xc2, 0x2d, 0x10, 0x47, 0x96, 0x45, 0x1e, 0x62, 0x43,
+	0xb3, 0x45, 0x17, 0x69, 0x62, 0xde, 0xfb, 0x88, 0xa8, 0x17, 0x26, 0xf6, 0x49, 0x73, 0x29, 0xbc,
+	0x04, 0xce, 0xe1, 0x7e, 0xd7, 0x74, 0x07, 0x7e, 0xcf, 0x16, 0x38, 0x9d, 0x98, 0x30, 0x8a, 0xa6,
+	0x65, 0x8b, 0xe0, 0xc2, 0x44, 0xb1, 0x5d, 0x44, 0x0f, 0x3c, 0xa0, 0xa7, 0x2d, 0xee, 0x12, 0xbd,
+	0x33, 0xd1, 0x48, 0x2c, 0xbc, 0xee, 0x7a, 0x75, 0xf6, 0x28, 0x36, 0xfc, 0xee, 0xb6, 0x3d, 0x04,
+	0x36, 0xb6, 0xff, 0x90, 0x00, 0x98, 0x8e, 0x76, 0x78, 0x0b, 0x5c, 0x2c, 0x94, 0x4a, 0x72, 0xbd,
+	0xae, 0x35, 0x0e, 0x6b, 0xb2, 0x76, 0x50, 0xa9, 0xd7, 0xe4, 0x92, 0x72, 0x57, 0x91, 0xcb, 0xf1,
+	0x50, 0x72, 0x7d, 0x38, 0xca, 0xac, 0x4d, 0x83, 0x0f, 0x1c, 0xda, 0xc5, 0xba, 0xd9, 0x32, 0xb1,
+	0x01, 0xaf, 0x01, 0x18, 0xc4, 0x55, 0xaa, 0xc5, 0x6a, 0xf9, 0x30, 0x2e, 0x25, 0x57, 0x87, 0xa3,
+	0x4c, 0x7c, 0x0a, 0xa9, 0x90, 0x26, 0x31, 0x06, 0x70, 0x07, 0xac, 0x05, 0xa3, 0xe5, 0xf7, 0x65,
+	0xf5, 0x90, 0x03, 0xc2, 0xc9, 0x8b, 0xc3, 0x51, 0xe6, 0x5f, 0x53, 0x80, 0x7c, 0x84, 0xdd, 0x01,
+	0xc7, 0xdc, 0x01, 0x1b, 0x41, 0x4c, 0xa1, 0x72, 0xa8, 0x55, 0xef, 0x6a, 0x85, 0x72, 0x59, 0x95,
+	0xeb, 0x75, 0xb9, 0x1e, 0x8f, 0x24, 0x37, 0x86, 0xa3, 0x4c, 0x62, 0x0a, 0x2d, 0x38, 0x83, 0x6a,
+	0xab, 0xe0, 0x7f, 0x82, 0x93, 0x4b, 0x9f, 0x7e, 0x97, 0x0a, 0x3d, 0xfa, 0x3e, 0x15, 0xda, 0xfe,
+	0x21, 0x0c, 0x32, 0x67, 0xdd, 0x79, 0x88, 0xc1, 0x8d, 0x52, 0xb5, 0xd2, 0x50, 0x0b, 0xa5, 0x86,
+	0x56, 0xaa, 0x96, 0x65, 0x6d, 0x4f, 0xa9, 0x37, 0xaa, 0xea, 0xa1, 0x56, 0xad, 0xc9, 0x6a, 0xa1,
+	0xa1, 0x54, 0x2b, 0xaf, 0x53, 0x28, 0x3f, 0x1c, 0x65, 0xae, 0x9e, 0x95, 0x3b, 0xa8, 0xdb, 0x3d,
+	0xb0, 0x35, 0xd3, 0x36, 0x4a, 0x45, 0x69, 0xc4, 0xa5, 0xe4, 0x95, 0xe1, 0x28, 0x73, 0xf9, 0xac,
+	0xfc, 0x8a, 0x63, 0x32, 0x78, 0x1f, 0x5c, 0x9b, 0x29, 0xf1, 0xbe, 0xb2, 0xab, 0x16, 0x1a, 0x72,
+	0x7c, 0x2e, 0x79, 0x75, 0x38, 0xca, 0xfc, 0xef, 0xac, 0xdc, 0xfb, 0x66, 0xdb, 0x45, 0x0c, 0xcf,
+	0x9c, 0x7e, 0x57, 0xae, 0xc8, 0x75, 0xa5, 0x1e, 0x0f, 0xcf, 0x96, 0x7e, 0x17, 0x3b, 0x98, 0x9a,
+	0x34, 0x19, 0xf1, 0x9a, 0x55, 0xdc, 0x7b, 0xfa, 0x5b, 0x2a, 0xf4, 0xe8, 0x38, 0x25, 0x3d, 0x3d,
+	0x4e, 0x49, 0xcf, 0x8e, 0x53, 0xd2, 0xaf, 0xc7, 0x29, 0xe9, 0x8b, 0xe7, 0xa9, 0xd0, 0xb3, 0xe7,
+	0xa9, 0xd0, 0x2f, 0xcf, 0x53, 0xa1, 0x0f, 0x37, 0x03, 0x13, 0xa8, 0x44, 0xa8, 0x7d, 0xcf, 0xff,
+	0xbb, 0x6b, 0xe4, 0xfb, 0xfc, 0x57, 0xfc, 0xe7, 0x6d, 0x2e, 0xf0, 0x0f, 0xce, 0xff, 0xff, 0x1c,
+	0x00, 0x71, 0xb2, 0xbf, 0x03, 0x14, 0x0b, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -768,6 +876,77 @@ func (this *Model) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GaslessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GaslessConfig)
+	if !ok {
+		that2, ok := that.(GaslessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxGasPerExecution != that1.MaxGasPerExecution {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
+	if this.MaxGasPerSenderPerBlock != that1.MaxGasPerSenderPerBlock {
+		return false
+	}
+	if this.Sponsor != that1.Sponsor {
+		return false
+	}
+	if len(this.AllowedMessages) != len(that1.AllowedMessages) {
+		return false
+	}
+	for i := range this.AllowedMessages {
+		if this.AllowedMessages[i] != that1.AllowedMessages[i] {
+			return false
+		}
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	return true
+}
+func (this *GaslessGasUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GaslessGasUsage)
+	if !ok {
+		that2, ok := that.(GaslessGasUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	return true
+}
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1120,6 +1299,98 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GaslessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxGasPerSenderPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGasPerSenderPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxGasPerExecution != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGasPerExecution))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaslessGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaslessGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaslessGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1285,6 +1556,52 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *GaslessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGasPerExecution != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasPerExecution))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasPerBlock))
+	}
+	if m.MaxGasPerSenderPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasPerSenderPerBlock))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *GaslessGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2345,6 +2662,284 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GaslessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaslessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaslessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerExecution", wireType)
+			}
+			m.MaxGasPerExecution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerExecution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerSenderPerBlock", wireType)
+			}
+			m.MaxGasPerSenderPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerSenderPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaslessGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaslessGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaslessGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0